@@ -0,0 +1,97 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type archiveTodoRequest struct {
+	getTodoRequest
+}
+
+// archiveTodo godoc
+//
+//	@Summary		Archives a Todo
+//	@Description	Moves a completed todo to the archive, out of the default todo list; archived todos keep their attachments and are listed with 'archived' set. Reopening the todo unarchives it
+//	@Tags			todos
+//	@Produce		json
+//	@Param			todoId	path		int	true	"Todo ID"	minimum(1)
+//	@Success		200		{object}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/todos/{todoId}/archive [post]
+func (server *Server) archiveTodo(ctx *gin.Context) {
+	var req archiveTodoRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	if todo.CompletedAt == nil {
+		NewHTTPError(ctx, http.StatusConflict, archiveIncompleteTodoError)
+		return
+	}
+
+	archivedTodo, err := server.store.ArchiveTodo(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, archivedTodo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type unarchiveTodoRequest struct {
+	getTodoRequest
+}
+
+// unarchiveTodo godoc
+//
+//	@Summary		Unarchives a Todo
+//	@Description	Moves the todo out of the archive, back to the default todo list
+//	@Tags			todos
+//	@Produce		json
+//	@Param			todoId	path		int	true	"Todo ID"	minimum(1)
+//	@Success		200		{object}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/unarchive [post]
+func (server *Server) unarchiveTodo(ctx *gin.Context) {
+	var req unarchiveTodoRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	unarchivedTodo, err := server.store.UnarchiveTodo(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, unarchivedTodo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,149 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveTodoAPI(t *testing.T) {
+	todo := RandomTodo()
+	completedAt := time.Now().UTC().Truncate(time.Second)
+	todo.CompletedAt = &completedAt
+
+	archivedTodo := todo
+	archivedAt := completedAt.Add(time.Minute)
+	archivedTodo.ArchivedAt = &archivedAt
+
+	incompleteTodo := RandomTodo()
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().ArchiveTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(archivedTodo, nil)
+				expectTodoRollups(store, archivedTodo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, archivedTodo)
+			},
+		},
+		{
+			name:   "Incomplete",
+			todoID: incompleteTodo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(incompleteTodo.ID)).Times(1).Return(incompleteTodo, nil)
+				store.EXPECT().ArchiveTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: archiveIncompleteTodoError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "NotFound",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().ArchiveTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().ArchiveTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/archive", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestUnarchiveTodoAPI(t *testing.T) {
+	todo := RandomTodo()
+	archivedTodo := todo
+	archivedAt := time.Now().UTC().Truncate(time.Second)
+	archivedTodo.ArchivedAt = &archivedAt
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(archivedTodo, nil)
+	store.EXPECT().UnarchiveTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	expectTodoRollups(store, todo)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/unarchive", todo.ID)
+	request, err := http.NewRequest(http.MethodPost, url, nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assertBodyMatchTodo(t, recorder.Body, todo)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/storage"
 )
 
 type uploadTodoAttachmentsRequest struct {
@@ -25,6 +26,7 @@ type uploadTodoAttachmentsRequest struct {
 //	@Param			attachments	formData	[]file	true	"attachments"
 //	@Success		200
 //	@Failure		403
+//	@Failure		409
 //	@Failure		413
 //	@Failure		415
 //	@Failure		404
@@ -50,35 +52,68 @@ func (server *Server) uploadTodoAttachments(ctx *gin.Context) {
 		return
 	}
 
+	// The files of a merged todo live with the todo it was merged into
+	if todo.MergedIntoID != nil {
+		NewHTTPError(ctx, http.StatusConflict, newTodoMergedError(todo.ID, *todo.MergedIntoID))
+		return
+	}
+
 	// Return error if already number of attachments capped
 	if todo.FileCount >= TodoAttachmentLimit {
 		NewHTTPError(ctx, http.StatusForbidden, newTodoAttachmentLimitReachedError(TodoAttachmentLimit))
 		return
 	}
 
+	fileContents, filenames := readAttachmentFilesAndHandleErrors(ctx, int(todo.FileCount))
+	if fileContents == nil {
+		return
+	}
+
+	err := server.store.UploadAttachmentTx(ctx, db.UploadAttachmentTxParams{
+		Todo:         *todo,
+		FileContents: fileContents,
+		Storage:      server.storage,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	server.notifyTodoChange(ctx, todo.ID,
+		fmt.Sprintf("Attachments added: %s", todo.Title),
+		fmt.Sprintf("Attachments %s were added to todo '%s'", quoteAll(filenames), todo.Title),
+	)
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+// readAttachmentFilesAndHandleErrors validates the files uploaded along with the fileCount files already present
+// and reads their contents keyed by filename; returns the filenames in upload order as well.
+// Writes the error response and returns nil on failure
+func readAttachmentFilesAndHandleErrors(ctx *gin.Context, fileCount int) (storage.FileContents, []string) {
 	// Check form data is less than maximum specified bytes
 	if ctx.Request.ContentLength > MaxContentLength {
 		NewHTTPError(ctx, http.StatusRequestEntityTooLarge, uploadAttachmentAPIContentLengthLimitError)
-		return
+		return nil, nil
 	}
 
 	form, err := ctx.MultipartForm()
 	if err != nil {
 		NewHTTPError(ctx, http.StatusInternalServerError, err)
-		return
+		return nil, nil
 	}
 
 	files, ok := form.File[UploadAttachmentFormFileKey]
 	if !ok {
 		NewHTTPError(ctx, http.StatusBadRequest, attachmentKeyEmptyError)
-		return
+		return nil, nil
 	}
 
-	// Validate length of files; should be less than 5 - todo's already uploaded items
+	// Validate length of files; should be less than 5 - already uploaded items
 	l := len(files)
-	if l+int(todo.FileCount) > 5 {
+	if l+fileCount > 5 {
 		NewHTTPError(ctx, http.StatusRequestEntityTooLarge, newTodoAttachmentLimitReachedError(l))
-		return
+		return nil, nil
 	}
 
 	// validate individual file type
@@ -88,43 +123,35 @@ func (server *Server) uploadTodoAttachments(ctx *gin.Context) {
 	for _, file := range files {
 		if err := validateMimeType(file.Filename, file.Header); err != nil {
 			NewHTTPError(ctx, http.StatusUnsupportedMediaType, err)
-			return
+			return nil, nil
 		}
 
 		if err := validateFileSize(file.Filename, file.Size); err != nil {
 			NewHTTPError(ctx, http.StatusRequestEntityTooLarge, err)
-			return
+			return nil, nil
 		}
 	}
 
-	fileContents := make(map[string][]byte)
+	fileContents := make(storage.FileContents)
+	filenames := make([]string, 0, len(files))
 	for _, file := range files {
 		multiPartFile, err := file.Open()
 		if err != nil {
 			NewHTTPError(ctx, http.StatusInternalServerError, err)
-			return
+			return nil, nil
 		}
 
 		b := make([]byte, file.Size)
 		if _, err = multiPartFile.Read(b); err != nil {
 			NewHTTPError(ctx, http.StatusInternalServerError, err)
-			return
+			return nil, nil
 		}
 
 		fileContents[filepath.Base(file.Filename)] = b
+		filenames = append(filenames, filepath.Base(file.Filename))
 	}
 
-	err = server.store.UploadAttachmentTx(ctx, db.UploadAttachmentTxParams{
-		Todo:         *todo,
-		FileContents: fileContents,
-		Storage:      server.storage,
-	})
-	if err != nil {
-		NewHTTPError(ctx, http.StatusInternalServerError, err)
-		return
-	}
-
-	ctx.JSON(http.StatusOK, nil)
+	return fileContents, filenames
 }
 
 type getTodoAttachmentRequest struct {
@@ -297,6 +324,11 @@ func (server *Server) deleteTodoAttachment(ctx *gin.Context) {
 		return
 	}
 
+	server.notifyTodoChange(ctx, todo.ID,
+		fmt.Sprintf("Attachment deleted: %s", todo.Title),
+		fmt.Sprintf("Attachment '%s' was deleted from todo '%s'", attachment.OriginalFilename, todo.Title),
+	)
+
 	ctx.JSON(http.StatusOK, nil)
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/golang/mock/gomock"
 	mockdb "github.com/jaingounchained/todo/db/mock"
 	db "github.com/jaingounchained/todo/db/sqlc"
+	mockNotification "github.com/jaingounchained/todo/notification/mock"
 	"github.com/jaingounchained/todo/storage"
 	mockStorage "github.com/jaingounchained/todo/storage/mock"
 	"github.com/jaingounchained/todo/util"
@@ -80,6 +81,10 @@ func TestUploadTodoAttachmentsAPI(t *testing.T) {
 	todoWithFileCount4 := RandomTodo()
 	todoWithFileCount4.FileCount = 4
 
+	mergedTodo := RandomTodo()
+	mergedIntoID := mergedTodo.ID + 1
+	mergedTodo.MergedIntoID = &mergedIntoID
+
 	randomMimeType := util.RandomString(10)
 
 	type File struct {
@@ -144,6 +149,20 @@ func TestUploadTodoAttachmentsAPI(t *testing.T) {
 				assertBodyMatchError(t, recorder.Body, err)
 			},
 		},
+		{
+			name:   "MergedTodo",
+			todoID: mergedTodo.ID,
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage, fileContents storage.FileContents) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(mergedTodo.ID)).Times(1).Return(mergedTodo, nil)
+				store.EXPECT().UploadAttachmentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newTodoMergedError(mergedTodo.ID, mergedIntoID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
 		{
 			name:   "MaxTodoFileCount",
 			todoID: todoWithMaxFileCount.ID,
@@ -359,8 +378,11 @@ func TestUploadTodoAttachmentsAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildDBStub(store, mockStorage, expectedFleContents)
 
+			dispatcher := mockNotification.NewMockDispatcher(ctrl)
+			dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
 			// start test server and send request
-			server := NewGinHandler(store, mockStorage, nil)
+			server := NewGinHandler(util.Config{}, store, mockStorage, dispatcher, nil)
 			recorder := httptest.NewRecorder()
 
 			// Create a buffer to hold the multipart form data
@@ -411,7 +433,7 @@ func TestUploadTodoAttachmentsAPI(t *testing.T) {
 		store.EXPECT().UploadAttachmentTx(gomock.Any(), gomock.Any()).Times(0)
 
 		// start test server and send request
-		server := NewGinHandler(store, mockStorage, nil)
+		server := NewGinHandler(util.Config{}, store, mockStorage, nil, nil)
 		recorder := httptest.NewRecorder()
 
 		// Marshal body data to JSON
@@ -624,7 +646,7 @@ func TestGetTodoAttachmentAPI(t *testing.T) {
 			tc.buildStorageStub(mockStorage)
 
 			// start test server and send request
-			server := NewGinHandler(store, mockStorage, nil)
+			server := NewGinHandler(util.Config{}, store, mockStorage, nil, nil)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("/todos/%d/attachments/%d", tc.todoID, tc.attachmentID)
@@ -758,7 +780,7 @@ func TestGetTodoAttachmentMetadataAPI(t *testing.T) {
 			tc.buildDBStub(store)
 
 			// start test server and send request
-			server := NewGinHandler(store, nil, nil)
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("/todos/%d/attachments", tc.todoID)
@@ -938,8 +960,11 @@ func TestDeleteTodoAttachmentAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildDBStub(store, mockStorage)
 
+			dispatcher := mockNotification.NewMockDispatcher(ctrl)
+			dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
 			// start test server and send request
-			server := NewGinHandler(store, mockStorage, nil)
+			server := NewGinHandler(util.Config{}, store, mockStorage, dispatcher, nil)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("/todos/%d/attachments/%d", tc.todoID, tc.attachmentID)
@@ -0,0 +1,334 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type listTodoChecklistRequest struct {
+	getTodoRequest
+}
+
+// listTodoChecklist godoc
+//
+//	@Summary		List checklist items of a todo
+//	@Description	List the checklist items of the todo in their order
+//	@Tags			checklist
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	db.ChecklistItem
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/checklist [get]
+func (server *Server) listTodoChecklist(ctx *gin.Context) {
+	var req listTodoChecklistRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	items, err := server.store.ListChecklistItems(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, items)
+}
+
+type createTodoChecklistItemRequestURIParams struct {
+	getTodoRequest
+}
+
+type createTodoChecklistItemRequestBody struct {
+	Text string `json:"text" binding:"required,max=255"`
+}
+
+// createTodoChecklistItem godoc
+//
+//	@Summary		Adds a checklist item to a todo
+//	@Description	Adds an unchecked item at the end of the todo's checklist
+//	@Tags			checklist
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path		int									true	"Todo ID"	minimum(1)
+//	@Param			item	body		createTodoChecklistItemRequestBody	true	"Checklist item text"
+//	@Success		200		{object}	db.ChecklistItem
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/checklist [post]
+func (server *Server) createTodoChecklistItem(ctx *gin.Context) {
+	var reqURIParams createTodoChecklistItemRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody createTodoChecklistItemRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	item, err := server.store.CreateChecklistItem(ctx, db.CreateChecklistItemParams{
+		TodoID: reqURIParams.TodoID,
+		Text:   reqBody.Text,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, item)
+}
+
+type getTodoChecklistItemRequest struct {
+	getTodoRequest
+	ItemID int64 `uri:"itemId" binding:"required,min=1"`
+}
+
+type updateTodoChecklistItemRequestURIParams struct {
+	getTodoChecklistItemRequest
+}
+
+type updateTodoChecklistItemRequestBody struct {
+	Text    *string `json:"text" binding:"omitempty,min=1,max=255"`
+	Checked *bool   `json:"checked"`
+}
+
+// updateTodoChecklistItem godoc
+//
+//	@Summary		Edits or toggles a checklist item
+//	@Description	Updates the text and/or the checked flag of the checklist item
+//	@Tags			checklist
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path		int									true	"Todo ID"			minimum(1)
+//	@Param			itemId	path		int									true	"Checklist item ID"	minimum(1)
+//	@Param			item	body		updateTodoChecklistItemRequestBody	true	"Checklist item text/checked flag"
+//	@Success		200		{object}	db.ChecklistItem
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/checklist/{itemId} [patch]
+func (server *Server) updateTodoChecklistItem(ctx *gin.Context) {
+	var reqURIParams updateTodoChecklistItemRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	var reqBody updateTodoChecklistItemRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if reqBody.Text == nil && reqBody.Checked == nil {
+		NewHTTPError(ctx, http.StatusBadRequest, updateChecklistItemInvalidBodyError)
+		return
+	}
+
+	item := server.fetchChecklistItemAndHandleErrors(ctx, reqURIParams.TodoID, reqURIParams.ItemID)
+	if item == nil {
+		return
+	}
+
+	updatedItem, err := server.store.UpdateChecklistItem(ctx, db.UpdateChecklistItemParams{
+		ID:      reqURIParams.ItemID,
+		Text:    reqBody.Text,
+		Checked: reqBody.Checked,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedItem)
+}
+
+type reorderTodoChecklistRequestURIParams struct {
+	getTodoRequest
+}
+
+type reorderTodoChecklistRequestBody struct {
+	ItemIDs []int64 `json:"itemIds" binding:"required,dive,min=1"`
+}
+
+// reorderTodoChecklist godoc
+//
+//	@Summary		Reorders the checklist of a todo
+//	@Description	Puts the checklist items in the given order, which must list every item of the todo's checklist exactly once
+//	@Tags			checklist
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path	int								true	"Todo ID"	minimum(1)
+//	@Param			order	body	reorderTodoChecklistRequestBody	true	"Checklist item IDs in the new order"
+//	@Success		200		{array}	db.ChecklistItem
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/checklist/order [put]
+func (server *Server) reorderTodoChecklist(ctx *gin.Context) {
+	var reqURIParams reorderTodoChecklistRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody reorderTodoChecklistRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	result, err := server.store.ReorderChecklistItemsTx(ctx, db.ReorderChecklistItemsTxParams{
+		TodoID:  reqURIParams.TodoID,
+		ItemIDs: reqBody.ItemIDs,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrChecklistOrderMismatch) {
+			NewHTTPError(ctx, http.StatusBadRequest, checklistOrderMismatchError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result.Items)
+}
+
+type promoteTodoChecklistItemRequest struct {
+	getTodoChecklistItemRequest
+}
+
+// promoteTodoChecklistItem godoc
+//
+//	@Summary		Promotes a checklist item to a subtask
+//	@Description	Replaces the checklist item with a subtask of the todo titled after the item's text; the subtask starts in the first state of its workflow
+//	@Tags			checklist
+//	@Produce		json
+//	@Param			todoId	path		int	true	"Todo ID"			minimum(1)
+//	@Param			itemId	path		int	true	"Checklist item ID"	minimum(1)
+//	@Success		200		{object}	todoResponse
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/checklist/{itemId}/promote [post]
+func (server *Server) promoteTodoChecklistItem(ctx *gin.Context) {
+	var req promoteTodoChecklistItemRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	item := server.fetchChecklistItemAndHandleErrors(ctx, req.TodoID, req.ItemID)
+	if item == nil {
+		return
+	}
+
+	result, err := server.store.PromoteChecklistItemTx(ctx, db.PromoteChecklistItemTxParams{
+		ItemID:  req.ItemID,
+		Storage: server.storage,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type deleteTodoChecklistItemRequest struct {
+	getTodoChecklistItemRequest
+}
+
+// deleteTodoChecklistItem godoc
+//
+//	@Summary		Deletes a checklist item
+//	@Description	Delete checklist item by ItemID
+//	@Tags			checklist
+//	@Param			todoId	path	int	true	"Todo ID"			minimum(1)
+//	@Param			itemId	path	int	true	"Checklist item ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/checklist/{itemId} [delete]
+func (server *Server) deleteTodoChecklistItem(ctx *gin.Context) {
+	var req deleteTodoChecklistItemRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	item := server.fetchChecklistItemAndHandleErrors(ctx, req.TodoID, req.ItemID)
+	if item == nil {
+		return
+	}
+
+	if err := server.store.DeleteChecklistItem(ctx, req.ItemID); err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+// fetchChecklistItemAndHandleErrors fetches the checklist item, making sure the todo exists and the item belongs to it
+func (server *Server) fetchChecklistItemAndHandleErrors(ctx *gin.Context, todoID, itemID int64) *db.ChecklistItem {
+	todo := server.fetchTodoAndHandleErrors(ctx, todoID)
+	if todo == nil {
+		return nil
+	}
+
+	item, err := server.store.GetChecklistItem(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceChecklistItem,
+				id:           itemID,
+			})
+			return nil
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	if item.TodoID != todoID {
+		NewHTTPError(ctx, http.StatusForbidden, newChecklistItemNotAssociatedWithTodoError(todoID, itemID))
+		return nil
+	}
+
+	return &item
+}
@@ -0,0 +1,412 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomChecklistItemOfTodo(todo db.Todo) db.ChecklistItem {
+	return db.ChecklistItem{
+		ID:       util.RandomInt(1, 1000),
+		TodoID:   todo.ID,
+		Text:     util.RandomString(10),
+		Position: util.RandomInt(1, 1000) * db.TodoPositionGap,
+	}
+}
+
+func TestListTodoChecklistAPI(t *testing.T) {
+	todo := RandomTodo()
+	items := []db.ChecklistItem{RandomChecklistItemOfTodo(todo), RandomChecklistItemOfTodo(todo)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	store.EXPECT().ListChecklistItems(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(items, nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/checklist", todo.ID)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var gotItems []db.ChecklistItem
+	err = json.Unmarshal(recorder.Body.Bytes(), &gotItems)
+	assert.NoError(t, err)
+	assert.Equal(t, items, gotItems)
+}
+
+func TestCreateTodoChecklistItemAPI(t *testing.T) {
+	todo := RandomTodo()
+	item := RandomChecklistItemOfTodo(todo)
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"text": item.Text},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.CreateChecklistItemParams{
+					TodoID: todo.ID,
+					Text:   item.Text,
+				}
+				store.EXPECT().CreateChecklistItem(gomock.Any(), gomock.Eq(arg)).Times(1).Return(item, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotItem db.ChecklistItem
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotItem)
+				assert.NoError(t, err)
+				assert.Equal(t, item, gotItem)
+			},
+		},
+		{
+			name: "MissingText",
+			body: gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateChecklistItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TodoNotFound",
+			body: gin.H{"text": item.Text},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateChecklistItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/checklist", todo.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestUpdateTodoChecklistItemAPI(t *testing.T) {
+	todo := RandomTodo()
+	item := RandomChecklistItemOfTodo(todo)
+	otherItem := RandomChecklistItemOfTodo(todo)
+	otherItem.TodoID = todo.ID + 1
+
+	checked := true
+	checkedItem := item
+	checkedItem.Checked = checked
+
+	tcs := []struct {
+		name               string
+		itemID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OKToggle",
+			itemID: item.ID,
+			body:   gin.H{"checked": checked},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetChecklistItem(gomock.Any(), gomock.Eq(item.ID)).Times(1).Return(item, nil)
+				arg := db.UpdateChecklistItemParams{
+					ID:      item.ID,
+					Checked: &checked,
+				}
+				store.EXPECT().UpdateChecklistItem(gomock.Any(), gomock.Eq(arg)).Times(1).Return(checkedItem, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotItem db.ChecklistItem
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotItem)
+				assert.NoError(t, err)
+				assert.Equal(t, checkedItem, gotItem)
+			},
+		},
+		{
+			name:   "EmptyBody",
+			itemID: item.ID,
+			body:   gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetChecklistItem(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateChecklistItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: updateChecklistItemInvalidBodyError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "ItemOfOtherTodo",
+			itemID: otherItem.ID,
+			body:   gin.H{"checked": checked},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetChecklistItem(gomock.Any(), gomock.Eq(otherItem.ID)).Times(1).Return(otherItem, nil)
+				store.EXPECT().UpdateChecklistItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newChecklistItemNotAssociatedWithTodoError(todo.ID, otherItem.ID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "ItemNotFound",
+			itemID: item.ID,
+			body:   gin.H{"checked": checked},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetChecklistItem(gomock.Any(), gomock.Eq(item.ID)).Times(1).Return(db.ChecklistItem{}, db.ErrRecordNotFound)
+				store.EXPECT().UpdateChecklistItem(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceChecklistItem,
+				id:           item.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/checklist/%d", todo.ID, tc.itemID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestReorderTodoChecklistAPI(t *testing.T) {
+	todo := RandomTodo()
+	first := RandomChecklistItemOfTodo(todo)
+	second := RandomChecklistItemOfTodo(todo)
+	second.ID = first.ID + 1
+	itemIDs := []int64{second.ID, first.ID}
+
+	tcs := []struct {
+		name               string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.ReorderChecklistItemsTxParams{
+					TodoID:  todo.ID,
+					ItemIDs: itemIDs,
+				}
+				store.EXPECT().
+					ReorderChecklistItemsTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ReorderChecklistItemsTxResult{Items: []db.ChecklistItem{second, first}}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotItems []db.ChecklistItem
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotItems)
+				assert.NoError(t, err)
+				assert.Equal(t, []db.ChecklistItem{second, first}, gotItems)
+			},
+		},
+		{
+			name: "OrderMismatch",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().
+					ReorderChecklistItemsTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReorderChecklistItemsTxResult{}, db.ErrChecklistOrderMismatch)
+			},
+			errorExpected: true,
+			expectedError: checklistOrderMismatchError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{"itemIds": itemIDs})
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/checklist/order", todo.ID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestPromoteTodoChecklistItemAPI(t *testing.T) {
+	todo := RandomTodo()
+	item := RandomChecklistItemOfTodo(todo)
+	subtask := RandomTodo()
+	subtask.ParentID = &todo.ID
+	subtask.Title = item.Text
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	store.EXPECT().GetChecklistItem(gomock.Any(), gomock.Eq(item.ID)).Times(1).Return(item, nil)
+	arg := db.PromoteChecklistItemTxParams{
+		ItemID: item.ID,
+	}
+	store.EXPECT().
+		PromoteChecklistItemTx(gomock.Any(), gomock.Eq(arg)).
+		Times(1).
+		Return(db.PromoteChecklistItemTxResult{Todo: subtask}, nil)
+	expectTodoRollups(store, subtask)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/checklist/%d/promote", todo.ID, item.ID)
+	request, err := http.NewRequest(http.MethodPost, url, nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assertBodyMatchTodo(t, recorder.Body, subtask)
+}
+
+func TestDeleteTodoChecklistItemAPI(t *testing.T) {
+	todo := RandomTodo()
+	item := RandomChecklistItemOfTodo(todo)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	store.EXPECT().GetChecklistItem(gomock.Any(), gomock.Eq(item.ID)).Times(1).Return(item, nil)
+	store.EXPECT().DeleteChecklistItem(gomock.Any(), gomock.Eq(item.ID)).Times(1).Return(nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/checklist/%d", todo.ID, item.ID)
+	request, err := http.NewRequest(http.MethodDelete, url, nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
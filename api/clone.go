@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type cloneTodoRequestURIParams struct {
+	getTodoRequest
+}
+
+type cloneTodoRequestBody struct {
+	Title              *string `json:"title" binding:"omitempty,min=1,max=255"`
+	IncludeAttachments *bool   `json:"includeAttachments"`
+	IncludeComments    bool    `json:"includeComments"`
+	IncludeSubtasks    bool    `json:"includeSubtasks"`
+}
+
+// cloneTodo godoc
+//
+//	@Summary		Clones a Todo
+//	@Description	Creates a copy of the todo next to it in a single transaction, with the same or the given title, along with its due date, priority, project, description, tags, reminders and checklist.
+//	@Description	Its attachments (included by default), comments and subtasks get copied as well when included; references to the copied attachments are rewritten and the ones to attachments left behind are removed. The copies start in the first state of their workflow with an unchecked checklist
+//	@Tags			todos
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path		int						true	"Todo ID"	minimum(1)
+//	@Param			clone	body		cloneTodoRequestBody	false	"Title/included attachments, comments and subtasks"
+//	@Success		200		{object}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/clone [post]
+func (server *Server) cloneTodo(ctx *gin.Context) {
+	var reqURIParams cloneTodoRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	// The body is optional
+	var reqBody cloneTodoRequestBody
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			NewHTTPError(ctx, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	arg := db.CloneTodoTxParams{
+		Todo:               *todo,
+		Title:              todo.Title,
+		IncludeAttachments: reqBody.IncludeAttachments == nil || *reqBody.IncludeAttachments,
+		IncludeComments:    reqBody.IncludeComments,
+		IncludeSubtasks:    reqBody.IncludeSubtasks,
+		Storage:            server.storage,
+	}
+	if reqBody.Title != nil {
+		arg.Title = *reqBody.Title
+	}
+
+	result, err := server.store.CloneTodoTx(ctx, arg)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneTodoAPI(t *testing.T) {
+	todo := RandomTodo()
+	clone := RandomTodo()
+	clone.Title = todo.Title
+	newTitle := util.RandomString(10)
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.CloneTodoTxParams{
+					Todo:               todo,
+					Title:              todo.Title,
+					IncludeAttachments: true,
+					Storage:            mockStorage,
+				}
+				store.EXPECT().
+					CloneTodoTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CloneTodoTxResult{Todo: clone, Subtasks: []db.Todo{}}, nil)
+				expectTodoRollups(store, clone)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, clone)
+			},
+		},
+		{
+			name:   "OKWithOptions",
+			todoID: todo.ID,
+			body: gin.H{
+				"title":              newTitle,
+				"includeAttachments": false,
+				"includeComments":    true,
+				"includeSubtasks":    true,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.CloneTodoTxParams{
+					Todo:            todo,
+					Title:           newTitle,
+					IncludeComments: true,
+					IncludeSubtasks: true,
+					Storage:         mockStorage,
+				}
+				store.EXPECT().
+					CloneTodoTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CloneTodoTxResult{Todo: clone, Subtasks: []db.Todo{}}, nil)
+				expectTodoRollups(store, clone)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, clone)
+			},
+		},
+		{
+			name:   "EmptyTitle",
+			todoID: todo.ID,
+			body: gin.H{
+				"title": "",
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CloneTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "InvalidTodoID",
+			todoID: 0,
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CloneTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: todoIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "TodoNotFound",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().CloneTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: "todo",
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().
+					CloneTodoTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CloneTodoTxResult{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mockStorage.NewMockStorage(ctrl)
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store, mockStorage)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, mockStorage, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// The body is optional
+			var body io.Reader
+			if tc.body != nil {
+				data, err := json.Marshal(tc.body)
+				assert.NoError(t, err)
+				body = bytes.NewReader(data)
+			}
+
+			url := fmt.Sprintf("/todos/%d/clone", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, body)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
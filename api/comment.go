@@ -0,0 +1,345 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/markdown"
+)
+
+// commentResponse is a comment along with its body rendered to sanitized HTML and the attachments it references
+type commentResponse struct {
+	db.Comment
+	HTML          string  `json:"html"`
+	AttachmentIDs []int64 `json:"attachmentIds"`
+}
+
+func newCommentResponse(comment db.Comment, attachmentIDs []int64) commentResponse {
+	if attachmentIDs == nil {
+		attachmentIDs = []int64{}
+	}
+
+	return commentResponse{
+		Comment:       comment,
+		HTML:          markdown.ToHTML(comment.Body),
+		AttachmentIDs: attachmentIDs,
+	}
+}
+
+// buildCommentResponsesAndHandleErrors fetches the attachments of the comments in a single query
+// and builds their responses; writes the error response and returns nil on failure
+func (server *Server) buildCommentResponsesAndHandleErrors(ctx *gin.Context, comments []db.Comment) []commentResponse {
+	commentIDs := make([]int64, 0, len(comments))
+	for _, comment := range comments {
+		commentIDs = append(commentIDs, comment.ID)
+	}
+
+	attachments, err := server.store.ListCommentAttachments(ctx, commentIDs)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	attachmentIDsByCommentID := make(map[int64][]int64, len(comments))
+	for _, attachment := range attachments {
+		attachmentIDsByCommentID[attachment.CommentID] = append(attachmentIDsByCommentID[attachment.CommentID], attachment.AttachmentID)
+	}
+
+	resp := make([]commentResponse, 0, len(comments))
+	for _, comment := range comments {
+		resp = append(resp, newCommentResponse(comment, attachmentIDsByCommentID[comment.ID]))
+	}
+
+	return resp
+}
+
+type listTodoCommentsRequestURIParams struct {
+	getTodoRequest
+}
+
+type listTodoCommentsRequestQuery struct {
+	PageID   int32 `form:"pageId" binding:"required,min=1"`
+	PageSize int32 `form:"pageSize" binding:"required,min=5,max=10"`
+}
+
+// listTodoComments godoc
+//
+//	@Summary		List comments of a todo
+//	@Description	List the comments of the todo, oldest first, based on page ID and page size
+//	@Tags			comments
+//	@Produce		json
+//	@Param			todoId		path	int	true	"Todo ID"	minimum(1)
+//	@Param			pageId		query	int	true	"page ID"	minimum(1)
+//	@Param			pageSize	query	int	true	"page size"	minimum(5)	maximum(10)
+//	@Success		200			{array}	commentResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/comments [get]
+func (server *Server) listTodoComments(ctx *gin.Context) {
+	var reqURIParams listTodoCommentsRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqQuery listTodoCommentsRequestQuery
+	if err := ctx.ShouldBindQuery(&reqQuery); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	comments, err := server.store.ListComments(ctx, db.ListCommentsParams{
+		TodoID: reqURIParams.TodoID,
+		Limit:  reqQuery.PageSize,
+		Offset: (reqQuery.PageID - 1) * reqQuery.PageSize,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildCommentResponsesAndHandleErrors(ctx, comments)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type getTodoCommentRequest struct {
+	getTodoRequest
+	CommentID int64 `uri:"commentId" binding:"required,min=1"`
+}
+
+// getTodoComment godoc
+//
+//	@Summary		Returns a comment of a todo
+//	@Description	Get comment by CommentID
+//	@Tags			comments
+//	@Produce		json
+//	@Param			todoId		path		int	true	"Todo ID"		minimum(1)
+//	@Param			commentId	path		int	true	"Comment ID"	minimum(1)
+//	@Success		200			{object}	commentResponse
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/comments/{commentId} [get]
+func (server *Server) getTodoComment(ctx *gin.Context) {
+	var req getTodoCommentRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	comment := server.fetchCommentAndHandleErrors(ctx, req.TodoID, req.CommentID)
+	if comment == nil {
+		return
+	}
+
+	resp := server.buildCommentResponsesAndHandleErrors(ctx, []db.Comment{*comment})
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp[0])
+}
+
+type createTodoCommentRequestURIParams struct {
+	getTodoRequest
+}
+
+type createTodoCommentRequestBody struct {
+	Body          string  `json:"body" binding:"required,max=10000"`
+	AttachmentIDs []int64 `json:"attachmentIds" binding:"omitempty,dive,min=1"`
+}
+
+// createTodoComment godoc
+//
+//	@Summary		Comments on a todo
+//	@Description	Creates a comment on the todo; the Markdown body is stored as is and returned along with its sanitized HTML rendering. The comment may reference attachments of the same todo
+//	@Tags			comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path		int								true	"Todo ID"	minimum(1)
+//	@Param			comment	body		createTodoCommentRequestBody	true	"Comment body/attachment IDs"
+//	@Success		200		{object}	commentResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/comments [post]
+func (server *Server) createTodoComment(ctx *gin.Context) {
+	var reqURIParams createTodoCommentRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody createTodoCommentRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	result, err := server.store.CreateCommentTx(ctx, db.CreateCommentTxParams{
+		TodoID:        reqURIParams.TodoID,
+		Body:          reqBody.Body,
+		AttachmentIDs: uniqueIDs(reqBody.AttachmentIDs),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrUnknownCommentAttachment) {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownCommentAttachmentError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCommentResponse(result.Comment, result.AttachmentIDs))
+}
+
+type updateTodoCommentRequestURIParams struct {
+	getTodoCommentRequest
+}
+
+type updateTodoCommentRequestBody struct {
+	Body          string   `json:"body" binding:"required,max=10000"`
+	AttachmentIDs *[]int64 `json:"attachmentIds" binding:"omitempty,dive,min=1"`
+}
+
+// updateTodoComment godoc
+//
+//	@Summary		Edits a comment of a todo
+//	@Description	Replaces the body of the comment and marks it as edited; the referenced attachments are replaced only if 'attachmentIds' is provided
+//	@Tags			comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId		path		int								true	"Todo ID"		minimum(1)
+//	@Param			commentId	path		int								true	"Comment ID"	minimum(1)
+//	@Param			comment		body		updateTodoCommentRequestBody	true	"Comment body/attachment IDs"
+//	@Success		200			{object}	commentResponse
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/comments/{commentId} [patch]
+func (server *Server) updateTodoComment(ctx *gin.Context) {
+	var reqURIParams updateTodoCommentRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	var reqBody updateTodoCommentRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	comment := server.fetchCommentAndHandleErrors(ctx, reqURIParams.TodoID, reqURIParams.CommentID)
+	if comment == nil {
+		return
+	}
+
+	arg := db.UpdateCommentTxParams{
+		ID:                reqURIParams.CommentID,
+		Body:              reqBody.Body,
+		UpdateAttachments: reqBody.AttachmentIDs != nil,
+	}
+	if reqBody.AttachmentIDs != nil {
+		arg.AttachmentIDs = uniqueIDs(*reqBody.AttachmentIDs)
+	}
+
+	result, err := server.store.UpdateCommentTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrUnknownCommentAttachment) {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownCommentAttachmentError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newCommentResponse(result.Comment, result.AttachmentIDs))
+}
+
+type deleteTodoCommentRequest struct {
+	getTodoCommentRequest
+}
+
+// deleteTodoComment godoc
+//
+//	@Summary		Deletes a comment of a todo
+//	@Description	Delete comment by CommentID; the attachments it references are kept
+//	@Tags			comments
+//	@Param			todoId		path	int	true	"Todo ID"		minimum(1)
+//	@Param			commentId	path	int	true	"Comment ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/comments/{commentId} [delete]
+func (server *Server) deleteTodoComment(ctx *gin.Context) {
+	var req deleteTodoCommentRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	comment := server.fetchCommentAndHandleErrors(ctx, req.TodoID, req.CommentID)
+	if comment == nil {
+		return
+	}
+
+	if err := server.store.DeleteComment(ctx, req.CommentID); err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+// fetchCommentAndHandleErrors fetches the comment, making sure the todo exists and the comment belongs to it
+func (server *Server) fetchCommentAndHandleErrors(ctx *gin.Context, todoID, commentID int64) *db.Comment {
+	todo := server.fetchTodoAndHandleErrors(ctx, todoID)
+	if todo == nil {
+		return nil
+	}
+
+	comment, err := server.store.GetComment(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceComment,
+				id:           commentID,
+			})
+			return nil
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	if comment.TodoID != todoID {
+		NewHTTPError(ctx, http.StatusForbidden, newCommentNotAssociatedWithTodoError(todoID, commentID))
+		return nil
+	}
+
+	return &comment
+}
@@ -0,0 +1,366 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomCommentOfTodo(todo db.Todo) db.Comment {
+	return db.Comment{
+		ID:     util.RandomInt(1, 1000),
+		TodoID: todo.ID,
+		Body:   "**bold** " + util.RandomString(10),
+	}
+}
+
+func assertBodyMatchComment(t *testing.T, body *bytes.Buffer, comment db.Comment, attachmentIDs []int64) {
+	data, err := io.ReadAll(body)
+	assert.NoError(t, err)
+
+	var gotComment commentResponse
+	err = json.Unmarshal(data, &gotComment)
+	assert.NoError(t, err)
+	assert.Equal(t, comment, gotComment.Comment)
+	assert.Equal(t, attachmentIDs, gotComment.AttachmentIDs)
+	assert.Contains(t, gotComment.HTML, "<strong>")
+}
+
+func TestListTodoCommentsAPI(t *testing.T) {
+	todo := RandomTodo()
+	comments := []db.Comment{RandomCommentOfTodo(todo), RandomCommentOfTodo(todo)}
+	attachmentID := util.RandomInt(1, 1000)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	arg := db.ListCommentsParams{
+		TodoID: todo.ID,
+		Limit:  5,
+		Offset: 5,
+	}
+	store.EXPECT().ListComments(gomock.Any(), gomock.Eq(arg)).Times(1).Return(comments, nil)
+	store.EXPECT().
+		ListCommentAttachments(gomock.Any(), gomock.Eq([]int64{comments[0].ID, comments[1].ID})).
+		Times(1).
+		Return([]db.CommentAttachment{{CommentID: comments[1].ID, AttachmentID: attachmentID}}, nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/comments?pageId=2&pageSize=5", todo.ID)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var gotComments []commentResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &gotComments)
+	assert.NoError(t, err)
+	assert.Len(t, gotComments, 2)
+	assert.Equal(t, []int64{}, gotComments[0].AttachmentIDs)
+	assert.Equal(t, []int64{attachmentID}, gotComments[1].AttachmentIDs)
+}
+
+func TestCreateTodoCommentAPI(t *testing.T) {
+	todo := RandomTodo()
+	comment := RandomCommentOfTodo(todo)
+	attachmentID := util.RandomInt(1, 1000)
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			body: gin.H{
+				"body":          comment.Body,
+				"attachmentIds": []int64{attachmentID, attachmentID},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.CreateCommentTxParams{
+					TodoID:        todo.ID,
+					Body:          comment.Body,
+					AttachmentIDs: []int64{attachmentID},
+				}
+				store.EXPECT().
+					CreateCommentTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CreateCommentTxResult{Comment: comment, AttachmentIDs: []int64{attachmentID}}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchComment(t, recorder.Body, comment, []int64{attachmentID})
+			},
+		},
+		{
+			name:   "EmptyBody",
+			todoID: todo.ID,
+			body: gin.H{
+				"body": "",
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateCommentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "UnknownAttachment",
+			todoID: todo.ID,
+			body: gin.H{
+				"body":          comment.Body,
+				"attachmentIds": []int64{attachmentID},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().
+					CreateCommentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateCommentTxResult{}, db.ErrUnknownCommentAttachment)
+			},
+			errorExpected: true,
+			expectedError: unknownCommentAttachmentError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "TodoNotFound",
+			todoID: todo.ID,
+			body: gin.H{
+				"body": comment.Body,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateCommentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/comments", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestUpdateTodoCommentAPI(t *testing.T) {
+	todo := RandomTodo()
+	comment := RandomCommentOfTodo(todo)
+	otherComment := RandomCommentOfTodo(todo)
+	otherComment.TodoID = todo.ID + 1
+	attachmentID := util.RandomInt(1, 1000)
+
+	tcs := []struct {
+		name               string
+		commentID          int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:      "OKKeepAttachments",
+			commentID: comment.ID,
+			body: gin.H{
+				"body": comment.Body,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetComment(gomock.Any(), gomock.Eq(comment.ID)).Times(1).Return(comment, nil)
+				arg := db.UpdateCommentTxParams{
+					ID:   comment.ID,
+					Body: comment.Body,
+				}
+				store.EXPECT().
+					UpdateCommentTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateCommentTxResult{Comment: comment, AttachmentIDs: []int64{attachmentID}}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchComment(t, recorder.Body, comment, []int64{attachmentID})
+			},
+		},
+		{
+			name:      "OKClearAttachments",
+			commentID: comment.ID,
+			body: gin.H{
+				"body":          comment.Body,
+				"attachmentIds": []int64{},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetComment(gomock.Any(), gomock.Eq(comment.ID)).Times(1).Return(comment, nil)
+				arg := db.UpdateCommentTxParams{
+					ID:                comment.ID,
+					Body:              comment.Body,
+					UpdateAttachments: true,
+					AttachmentIDs:     []int64{},
+				}
+				store.EXPECT().
+					UpdateCommentTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateCommentTxResult{Comment: comment, AttachmentIDs: []int64{}}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchComment(t, recorder.Body, comment, []int64{})
+			},
+		},
+		{
+			name:      "CommentOfOtherTodo",
+			commentID: otherComment.ID,
+			body: gin.H{
+				"body": comment.Body,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetComment(gomock.Any(), gomock.Eq(otherComment.ID)).Times(1).Return(otherComment, nil)
+				store.EXPECT().UpdateCommentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newCommentNotAssociatedWithTodoError(todo.ID, otherComment.ID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:      "CommentNotFound",
+			commentID: comment.ID,
+			body: gin.H{
+				"body": comment.Body,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetComment(gomock.Any(), gomock.Eq(comment.ID)).Times(1).Return(db.Comment{}, db.ErrRecordNotFound)
+				store.EXPECT().UpdateCommentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceComment,
+				id:           comment.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/comments/%d", todo.ID, tc.commentID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestDeleteTodoCommentAPI(t *testing.T) {
+	todo := RandomTodo()
+	comment := RandomCommentOfTodo(todo)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	store.EXPECT().GetComment(gomock.Any(), gomock.Eq(comment.ID)).Times(1).Return(comment, nil)
+	store.EXPECT().DeleteComment(gomock.Any(), gomock.Eq(comment.ID)).Times(1).Return(nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/comments/%d", todo.ID, comment.ID)
+	request, err := http.NewRequest(http.MethodDelete, url, nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -9,4 +9,35 @@ const (
 	ContentType                 = "Content-Type"
 	ResourceTodo                = "todo"
 	ResourceAttachment          = "attachment"
+	ResourceTag                 = "tag"
+	ResourceReminder            = "reminder"
+	ResourceWorkflow            = "workflow"
+	ResourceProject             = "project"
+	ResourceComment             = "comment"
+	ResourceRevision            = "revision"
+	ResourceTrashedTodo         = "trashed todo"
+	ResourceChecklistItem       = "checklist item"
+	ResourceTimeEntry           = "time entry"
+	ResourceTemplate            = "template"
+	ResourceTemplateAttachment  = "template attachment"
+	ResourceSmartList           = "smart list"
+	DefaultTagColor             = "#808080"
+	TagMatchAny                 = "any"
+	TagMatchAll                 = "all"
+	UserIDHeader                = "X-User-ID"
+	MaxUserIDLength             = 64
+	AssigneeMe                  = "me"
+	DateLayout                  = "2006-01-02"
+	ReportFormatCSV             = "csv"
+	CSVContentType              = "text/csv"
+	DefaultPageSize             = 20
+	DefaultMaxPageSize          = 100
+	LinkHeader                  = "Link"
+	TotalCountHeader            = "X-Total-Count"
+	DefaultSearchLanguage       = "english"
+	DefaultDuplicateThreshold   = 0.6
+	DefaultMaxSimilarTodos      = 5
+	DuplicateCheckWarn          = "warn"
+	DuplicateCheckStrict        = "strict"
+	TodoIncludeAttachments      = "attachments"
 )
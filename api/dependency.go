@@ -0,0 +1,201 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type listTodoBlockersRequest struct {
+	getTodoRequest
+}
+
+// listTodoBlockers godoc
+//
+//	@Summary		List blockers of a todo
+//	@Description	List the todos blocking the todo, in their manual order
+//	@Tags			dependencies
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/blockers [get]
+func (server *Server) listTodoBlockers(ctx *gin.Context) {
+	var req listTodoBlockersRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	blockers, err := server.store.ListTodoBlockers(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponsesAndHandleErrors(ctx, blockers)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type listTodosBlockedByRequest struct {
+	getTodoRequest
+}
+
+// listTodosBlockedBy godoc
+//
+//	@Summary		List todos blocked by a todo
+//	@Description	List the todos the todo blocks, in their manual order
+//	@Tags			dependencies
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/blocking [get]
+func (server *Server) listTodosBlockedBy(ctx *gin.Context) {
+	var req listTodosBlockedByRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	blocked, err := server.store.ListTodosBlockedBy(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponsesAndHandleErrors(ctx, blocked)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type addTodoBlockerRequestURIParams struct {
+	getTodoRequest
+}
+
+type addTodoBlockerRequestBody struct {
+	BlockerID int64 `json:"blockerId" binding:"required,min=1"`
+}
+
+// addTodoBlocker godoc
+//
+//	@Summary		Add a blocker to a todo
+//	@Description	Declares that the todo is blocked by another todo; links which would form a cycle are refused and existing links are ignored
+//	@Tags			dependencies
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path	int							true	"Todo ID"	minimum(1)
+//	@Param			blocker	body	addTodoBlockerRequestBody	true	"Blocker todo ID"
+//	@Success		200		{array}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/blockers [post]
+func (server *Server) addTodoBlocker(ctx *gin.Context) {
+	var reqURIParams addTodoBlockerRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody addTodoBlockerRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	result, err := server.store.AddTodoDependencyTx(ctx, db.AddTodoDependencyTxParams{
+		TodoID:      reqURIParams.TodoID,
+		BlockedByID: reqBody.BlockerID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownBlockerTodoError)
+			return
+		}
+
+		if errors.Is(err, db.ErrTodoDependencyCycle) {
+			NewHTTPError(ctx, http.StatusBadRequest, todoDependencyCycleError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponsesAndHandleErrors(ctx, result.Blockers)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type removeTodoBlockerRequest struct {
+	getTodoRequest
+	BlockerID int64 `uri:"blockerId" binding:"required,min=1"`
+}
+
+// removeTodoBlocker godoc
+//
+//	@Summary		Remove a blocker from a todo
+//	@Description	Removes the link between the todo and its blocker
+//	@Tags			dependencies
+//	@Param			todoId		path	int	true	"Todo ID"			minimum(1)
+//	@Param			blockerId	path	int	true	"Blocker todo ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/blockers/{blockerId} [delete]
+func (server *Server) removeTodoBlocker(ctx *gin.Context) {
+	var req removeTodoBlockerRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	err := server.store.RemoveTodoDependency(ctx, db.RemoveTodoDependencyParams{
+		TodoID:      req.TodoID,
+		BlockedByID: req.BlockerID,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,295 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTodoBlockersAPI(t *testing.T) {
+	todo := RandomTodo()
+	blockers := []db.Todo{RandomTodo(), RandomTodo()}
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().ListTodoBlockers(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(blockers, nil)
+				expectTodoRollups(store, blockers...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodos(t, recorder.Body, blockers)
+			},
+		},
+		{
+			name:   "TodoNotFound",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().ListTodoBlockers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: "todo",
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InvalidTodoID",
+			todoID: 0,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: todoIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/blockers", tc.todoID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestListTodosBlockedByAPI(t *testing.T) {
+	todo := RandomTodo()
+	blocked := []db.Todo{RandomTodo()}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	store.EXPECT().ListTodosBlockedBy(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(blocked, nil)
+	expectTodoRollups(store, blocked...)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/blocking", todo.ID)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assertBodyMatchTodos(t, recorder.Body, blocked)
+}
+
+func TestAddTodoBlockerAPI(t *testing.T) {
+	todo := RandomTodo()
+	blocker := RandomTodo()
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			body: gin.H{
+				"blockerId": blocker.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.AddTodoDependencyTxParams{
+					TodoID:      todo.ID,
+					BlockedByID: blocker.ID,
+				}
+				store.EXPECT().
+					AddTodoDependencyTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.AddTodoDependencyTxResult{Blockers: []db.Todo{blocker}}, nil)
+				expectTodoRollups(store, blocker)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodos(t, recorder.Body, []db.Todo{blocker})
+			},
+		},
+		{
+			name:   "MissingBlockerID",
+			todoID: todo.ID,
+			body:   gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().AddTodoDependencyTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "UnknownBlocker",
+			todoID: todo.ID,
+			body: gin.H{
+				"blockerId": blocker.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().
+					AddTodoDependencyTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AddTodoDependencyTxResult{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: unknownBlockerTodoError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "Cycle",
+			todoID: todo.ID,
+			body: gin.H{
+				"blockerId": blocker.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().
+					AddTodoDependencyTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AddTodoDependencyTxResult{}, db.ErrTodoDependencyCycle)
+			},
+			errorExpected: true,
+			expectedError: todoDependencyCycleError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "TodoNotFound",
+			todoID: todo.ID,
+			body: gin.H{
+				"blockerId": blocker.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().AddTodoDependencyTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: "todo",
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/blockers", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestRemoveTodoBlockerAPI(t *testing.T) {
+	todo := RandomTodo()
+	blocker := RandomTodo()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	arg := db.RemoveTodoDependencyParams{
+		TodoID:      todo.ID,
+		BlockedByID: blocker.ID,
+	}
+	store.EXPECT().RemoveTodoDependency(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/blockers/%d", todo.ID, blocker.ID)
+	request, err := http.NewRequest(http.MethodDelete, url, nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type similarTodoRequest struct {
+	Title      string   `form:"title" binding:"required,max=255"`
+	Threshold  *float32 `form:"threshold" binding:"omitempty,min=0,max=1"`
+	MaxResults int32    `form:"maxResults" binding:"omitempty,min=1,max=20"`
+}
+
+// similarTodoResponse is an open todo with a title similar to the given one, along with their trigram similarity
+type similarTodoResponse struct {
+	todoResponse
+	Similarity float32 `json:"similarity"`
+}
+
+// createTodoResponse is the created todo along with the open todos it possibly duplicates, when checked for
+type createTodoResponse struct {
+	todoResponse
+	PossibleDuplicates []similarTodoResponse `json:"possibleDuplicates,omitempty"`
+}
+
+// duplicateTodoConflictResponse reports the open todos a todo possibly duplicates when created with the strict
+// duplicate check
+type duplicateTodoConflictResponse struct {
+	HTTPError
+	PossibleDuplicates []similarTodoResponse `json:"possibleDuplicates"`
+}
+
+// duplicateThreshold is the configured minimum similarity of the titles of possibly duplicate todos
+func (server *Server) duplicateThreshold() float32 {
+	if server.config.DuplicateThreshold > 0 {
+		return server.config.DuplicateThreshold
+	}
+
+	return DefaultDuplicateThreshold
+}
+
+// similarTodo godoc
+//
+//	@Summary		Lists the todos similar to a title
+//	@Description	Lists the open todos whose title is similar to the given title by trigram similarity, most similar first, to warn about duplicates while typing. Similarity goes from 0 to 1 and ignores case; thresholds below 0.3 act as 0.3
+//	@Tags			todos
+//	@Produce		json
+//	@Param			title		query		string	true	"title"	maxlength(255)
+//	@Param			threshold	query		number	false	"minimum similarity; the configured one by default"	minimum(0)	maximum(1)
+//	@Param			maxResults	query		int		false	"maximum number of todos"	minimum(1)	maximum(20)	default(5)
+//	@Success		200			{array}		similarTodoResponse
+//	@Failure		400
+//	@Failure		500
+//	@Router			/todos/similar [get]
+func (server *Server) similarTodo(ctx *gin.Context) {
+	var req similarTodoRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	threshold := server.duplicateThreshold()
+	if req.Threshold != nil {
+		threshold = *req.Threshold
+	}
+
+	maxResults := req.MaxResults
+	if maxResults == 0 {
+		maxResults = DefaultMaxSimilarTodos
+	}
+
+	resp := server.listSimilarTodosAndHandleErrors(ctx, req.Title, threshold, maxResults)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// listSimilarTodosAndHandleErrors lists the responses of the open todos with a title similar to the title; writes the
+// error response and returns nil on failure
+func (server *Server) listSimilarTodosAndHandleErrors(ctx *gin.Context, title string, threshold float32, maxResults int32) []similarTodoResponse {
+	rows, err := server.store.ListSimilarTodos(ctx, db.ListSimilarTodosParams{
+		Title:      title,
+		Threshold:  threshold,
+		MaxResults: maxResults,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+	if len(rows) == 0 {
+		return []similarTodoResponse{}
+	}
+
+	todos := make([]db.Todo, 0, len(rows))
+	for _, row := range rows {
+		todos = append(todos, row.Todo)
+	}
+
+	resp := server.buildTodoResponsesAndHandleErrors(ctx, todos)
+	if resp == nil {
+		return nil
+	}
+
+	similar := make([]similarTodoResponse, 0, len(rows))
+	for i, row := range rows {
+		similar = append(similar, similarTodoResponse{
+			todoResponse: resp[i],
+			Similarity:   row.Similarity,
+		})
+	}
+
+	return similar
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarTodoAPI(t *testing.T) {
+	rows := []db.ListSimilarTodosRow{
+		{Todo: RandomTodo(), Similarity: 0.9},
+		{Todo: RandomTodo(), Similarity: 0.7},
+	}
+
+	tcs := []struct {
+		name               string
+		config             util.Config
+		query              url.Values
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:  "OK",
+			query: url.Values{"title": {"Quartely report"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListSimilarTodosParams{
+					Title:      "Quartely report",
+					Threshold:  DefaultDuplicateThreshold,
+					MaxResults: DefaultMaxSimilarTodos,
+				}
+
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rows, nil)
+				expectTodoRollups(store, rows[0].Todo, rows[1].Todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp []struct {
+					db.Todo
+					Similarity float32 `json:"similarity"`
+				}
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				assert.Len(t, resp, 2)
+				for i, similar := range resp {
+					assert.Equal(t, rows[i].Todo, similar.Todo)
+					assert.Equal(t, rows[i].Similarity, similar.Similarity)
+				}
+			},
+		},
+		{
+			name:   "OKConfiguredThreshold",
+			config: util.Config{DuplicateThreshold: 0.8},
+			query:  url.Values{"title": {"Quartely report"}, "maxResults": {"10"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListSimilarTodosParams{
+					Title:      "Quartely report",
+					Threshold:  0.8,
+					MaxResults: 10,
+				}
+
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.ListSimilarTodosRow{}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.JSONEq(t, "[]", recorder.Body.String())
+			},
+		},
+		{
+			name:   "OKGivenThreshold",
+			config: util.Config{DuplicateThreshold: 0.8},
+			query:  url.Values{"title": {"Quartely report"}, "threshold": {"0.4"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListSimilarTodosParams{
+					Title:      "Quartely report",
+					Threshold:  0.4,
+					MaxResults: DefaultMaxSimilarTodos,
+				}
+
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.ListSimilarTodosRow{}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "MissingTitle",
+			query: url.Values{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidThreshold",
+			query: url.Values{"title": {"Quartely report"}, "threshold": {"1.5"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: url.Values{"title": {"Quartely report"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListSimilarTodosRow{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(tc.config, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := "/todos/similar?" + tc.query.Encode()
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
@@ -3,21 +3,69 @@ package api
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
 )
 
 var (
 	todoIDInvalidError                         = errors.New("Invalid todoId; todoId must be a valid integer > 0")
-	todoStatusInvalidError                     = errors.New("Invalid todoTitle; todoTitle must be either 'complete' or 'incomplete'")
 	uploadAttachmentAPIContentLengthLimitError = fmt.Errorf("Upload attachment API request content lenght must be less than %d Mibs", MaxContentLength/1024/1024)
 	invalidHeaderContentTypeError              = fmt.Errorf("Request %s isn't %s", ContentType, MultipartFormDataHeader)
 	attachmentKeyEmptyError                    = fmt.Errorf("No files present in '%s' key", UploadAttachmentFormFileKey)
 	noAttachmentsPresentForTheTodo             = errors.New("No attachments present for the todo")
-	updateTodoTitleStatusInvalidBodyError      = errors.New("At least one of 'title' or 'status' must be provided for update")
+	updateTodoTitleStatusInvalidBodyError      = errors.New("At least one of 'title', 'status', 'dueAt', 'dueTimezone', 'priority' or 'description' must be provided for update")
+	moveTodoInvalidBodyError                   = errors.New("Exactly one of 'before' or 'after' must be provided for move")
+	moveTodoRelativeToItselfError              = errors.New("A todo can't be moved relative to itself")
+	unknownParentTodoError                     = errors.New("Parent todo doesn't exist within the system")
+	todoParentCycleError                       = errors.New("A todo can't be moved under itself or one of its subtasks")
+	todoNotRecurringError                      = errors.New("Todo isn't recurring")
+	recurringTodoWithoutDueDateError           = errors.New("A todo needs a due date to become recurring")
+	recurrenceEndedError                       = errors.New("Recurrence has no further occurrences")
+	unknownTodoStatusError                     = errors.New("Status isn't a state of the todo's workflow")
+	incompleteSubtasksError                    = errors.New("Todo can't be completed while any of its subtasks is incomplete")
+	todoBlockedError                           = errors.New("Todo can't be completed while any of its blockers is open; set 'force' to complete it anyway")
+	unknownBlockerTodoError                    = errors.New("Blocker todo doesn't exist within the system")
+	todoDependencyCycleError                   = errors.New("A todo can't be blocked by itself or by a todo it blocks, directly or not")
+	unknownCommentAttachmentError              = errors.New("One or more attachments don't belong to the todo")
+	todoRevisionNotRevertibleError             = errors.New("Revisions of the attachments can't be reverted")
+	revertTodoStatusNotAllowedError            = errors.New("The previous status isn't reachable from the current status in the todo's workflow")
+	todoParentTrashedError                     = errors.New("A subtask can't be restored while its parent is in the trash; restore the parent instead")
+	archiveIncompleteTodoError                 = errors.New("Only completed todos can be archived")
+	updateChecklistItemInvalidBodyError        = errors.New("At least one of 'text' or 'checked' must be provided for update")
+	checklistOrderMismatchError                = errors.New("The order must list every checklist item of the todo exactly once")
+	userIDMissingError                         = fmt.Errorf("The '%s' header must identify the user in at most %d characters", UserIDHeader, MaxUserIDLength)
+	timerAlreadyRunningError                   = errors.New("A timer is already running for the user; stop it before starting another")
+	noRunningTimerError                        = errors.New("No timer of the user is running on the todo")
+	updateTimeEntryInvalidBodyError            = errors.New("At least one of 'startedAt', 'stoppedAt' or 'note' must be provided for update")
+	timeEntryInvalidRangeError                 = errors.New("'stoppedAt' must be after 'startedAt'")
+	timeReportInvalidRangeError                = errors.New("'to' can't be before 'from'")
+	templateIDInvalidError                     = errors.New("Invalid templateId; templateId must be a valid integer > 0")
+	updateTemplateInvalidBodyError             = errors.New("At least one of 'name', 'title', 'description', 'priority', 'tagIds', 'checklist' or 'subtasks' must be provided for update")
+	mergeTodoIntoItselfError                   = errors.New("A todo can't be merged into itself")
+	unknownMergeTargetTodoError                = errors.New("Target todo doesn't exist within the system")
+	workflowWithoutTerminalStateError          = errors.New("The todo's workflow has no terminal state to close the merged todo in")
+	unknownProjectError                        = errors.New("Project doesn't exist within the system")
+	workflowIDInvalidError                     = errors.New("Invalid workflowId; workflowId must be a valid integer > 0")
+	initialWorkflowStateTerminalError          = errors.New("The first state of a workflow is the initial status of new todos and can't be terminal")
+	deleteDefaultWorkflowError                 = errors.New("The default workflow can't be deleted")
+	unknownWorkflowError                       = errors.New("Workflow doesn't exist within the system")
+	projectIDInvalidError                      = errors.New("Invalid projectId; projectId must be a valid integer > 0")
+	updateProjectInvalidBodyError              = errors.New("At least one of 'name' or 'workflowId' must be provided for update")
+	tagIDInvalidError                          = errors.New("Invalid tagId; tagId must be a valid integer > 0")
+	updateTagInvalidBodyError                  = errors.New("At least one of 'name' or 'color' must be provided for update")
+	mergeTagIntoItselfError                    = errors.New("A tag can't be merged into itself")
+	unknownTagError                            = errors.New("One or more tags don't exist within the system")
+	pageSizeInvalidError                       = errors.New("Invalid pageSize; pageSize must be a valid integer >= 5 & <= 10")
+	cursorWithPageIDError                      = errors.New("Either 'pageId' or 'cursor' can be provided for paging, not both")
+	cursorInvalidError                         = errors.New("Invalid cursor; cursors must be taken from a previous page")
+	cursorSortMismatchError                    = errors.New("The cursor was taken from a page in another sort order")
+	smartListIDInvalidError                    = errors.New("Invalid smartListId; smartListId must be a valid integer > 0")
+	updateSmartListInvalidBodyError            = errors.New("At least one of 'name', 'query' or 'pinned' must be provided for update")
+	smartListQueryInvalidError                 = errors.New("Invalid query; the query must be a URL query string of the todo list, such as 'overdue=true&sort=-createdAt'")
 	// todoTitleInvalidError                      = errors.New("Invalid todoTitle; todoTitle must be a string of length < 256")
 	// pageIDInvalidError                         = errors.New("Invalid pageId; pageId must be a valid integer > 0")
-	// pageSizeInvalidError                       = errors.New("Invalid pageSize; pageSize must be a valid integer >= 5 & <= 10")
 	// attachmentIDInvalidError                   = errors.New("Invalid attachmentId; attachmentId must be a valid integer > 0")
 )
 
@@ -27,6 +75,192 @@ func newTodoAttachmentLimitReachedError(attachments int) error {
 	return fmt.Errorf("%d attachments per todo allowed; %d files already present for the todo", TodoAttachmentLimit, attachments)
 }
 
+type tagNameAlreadyExistError error
+
+func newTagNameAlreadyExistError(name string) tagNameAlreadyExistError {
+	return fmt.Errorf("tag with name '%s' already exist", name)
+}
+
+type reminderAlreadyExistError error
+
+func newReminderAlreadyExistError(offsetMinutes int32) reminderAlreadyExistError {
+	return fmt.Errorf("reminder %d minutes before due date already exist for the todo", offsetMinutes)
+}
+
+type reminderNotAssociatedWithTodoError error
+
+func newReminderNotAssociatedWithTodoError(todoID, reminderID int64) reminderNotAssociatedWithTodoError {
+	return fmt.Errorf("reminder %d is not associated with the todo %d", reminderID, todoID)
+}
+
+type commentNotAssociatedWithTodoError error
+
+func newCommentNotAssociatedWithTodoError(todoID, commentID int64) commentNotAssociatedWithTodoError {
+	return fmt.Errorf("comment %d is not associated with the todo %d", commentID, todoID)
+}
+
+type revisionNotAssociatedWithTodoError error
+
+func newRevisionNotAssociatedWithTodoError(todoID, revisionID int64) revisionNotAssociatedWithTodoError {
+	return fmt.Errorf("revision %d is not associated with the todo %d", revisionID, todoID)
+}
+
+type checklistItemNotAssociatedWithTodoError error
+
+func newChecklistItemNotAssociatedWithTodoError(todoID, itemID int64) checklistItemNotAssociatedWithTodoError {
+	return fmt.Errorf("checklist item %d is not associated with the todo %d", itemID, todoID)
+}
+
+type timeEntryNotAssociatedWithTodoError error
+
+func newTimeEntryNotAssociatedWithTodoError(todoID, entryID int64) timeEntryNotAssociatedWithTodoError {
+	return fmt.Errorf("time entry %d is not associated with the todo %d", entryID, todoID)
+}
+
+type timeEntryOfOtherUserError error
+
+func newTimeEntryOfOtherUserError(entryID int64) timeEntryOfOtherUserError {
+	return fmt.Errorf("time entry %d was tracked by another user", entryID)
+}
+
+type userNotAssignedError error
+
+func newUserNotAssignedError(todoID int64, userID string) userNotAssignedError {
+	return fmt.Errorf("user '%s' is not assigned to the todo %d", userID, todoID)
+}
+
+type templateNameAlreadyExistError error
+
+func newTemplateNameAlreadyExistError(name string) templateNameAlreadyExistError {
+	return fmt.Errorf("template with name '%s' already exist", name)
+}
+
+type templateAttachmentNotAssociatedWithTemplateError error
+
+func newTemplateAttachmentNotAssociatedWithTemplateError(templateID, attachmentID int64) templateAttachmentNotAssociatedWithTemplateError {
+	return fmt.Errorf("attachment %d is not associated with the template %d", attachmentID, templateID)
+}
+
+type instantiatedTextTooLongError error
+
+func newInstantiatedTextTooLongError(field string, maxLength int) instantiatedTextTooLongError {
+	return fmt.Errorf("'%s' exceeds %d characters once the placeholders are substituted", field, maxLength)
+}
+
+type todoMergedError error
+
+func newTodoMergedError(todoID, mergedIntoID int64) todoMergedError {
+	return fmt.Errorf("todo %d was merged into the todo %d", todoID, mergedIntoID)
+}
+
+type mergeAttachmentLimitExceededError error
+
+func newMergeAttachmentLimitExceededError(attachments int) mergeAttachmentLimitExceededError {
+	return fmt.Errorf("%d attachments per todo allowed; the merged todo would have %d attachments, delete %d of them first", TodoAttachmentLimit, attachments, attachments-TodoAttachmentLimit)
+}
+
+type pageSizeTooLargeError error
+
+func newPageSizeTooLargeError(maxPageSize int32) pageSizeTooLargeError {
+	return fmt.Errorf("invalid pageSize; pageSize must be a valid integer >= 1 & <= %d", maxPageSize)
+}
+
+type unknownSearchLanguageError error
+
+func newUnknownSearchLanguageError(language string) unknownSearchLanguageError {
+	return fmt.Errorf("todos can't be searched in '%s'; search languages: %s", language, strings.Join(db.SearchLanguages, ", "))
+}
+
+type filterInvalidRangeError error
+
+func newFilterInvalidRangeError(fromField, toField string) filterInvalidRangeError {
+	return fmt.Errorf("'%s' can't be before '%s'", toField, fromField)
+}
+
+type unknownSortFieldError error
+
+func newUnknownSortFieldError(field string, sortFields []string) unknownSortFieldError {
+	return fmt.Errorf("todos can't be sorted by '%s'; sort fields: %s", field, strings.Join(sortFields, ", "))
+}
+
+type duplicateSortFieldError error
+
+func newDuplicateSortFieldError(field string) duplicateSortFieldError {
+	return fmt.Errorf("sort field '%s' is listed more than once", field)
+}
+
+type smartListOfOtherUserError error
+
+func newSmartListOfOtherUserError(smartListID int64) smartListOfOtherUserError {
+	return fmt.Errorf("smart list %d was saved by another user", smartListID)
+}
+
+type smartListNameAlreadyExistError error
+
+func newSmartListNameAlreadyExistError(name string) smartListNameAlreadyExistError {
+	return fmt.Errorf("smart list with name '%s' already exist", name)
+}
+
+type unknownSmartListParameterError error
+
+func newUnknownSmartListParameterError(key string) unknownSmartListParameterError {
+	return fmt.Errorf("'%s' isn't a filter or sort parameter of the todo list", key)
+}
+
+type smartListOutdatedError error
+
+func newSmartListOutdatedError(name string, err error) smartListOutdatedError {
+	return fmt.Errorf("the query of smart list '%s' no longer fits the filters of the todo list: %w; update its query", name, err)
+}
+
+type unknownTodoFieldError error
+
+func newUnknownTodoFieldError(field string, fields []string) unknownTodoFieldError {
+	return fmt.Errorf("todos have no field '%s'; fields: %s", field, strings.Join(fields, ", "))
+}
+
+type unknownTodoIncludeError error
+
+func newUnknownTodoIncludeError(include string, includes []string) unknownTodoIncludeError {
+	return fmt.Errorf("todos can't include '%s'; includes: %s", include, strings.Join(includes, ", "))
+}
+
+type possibleDuplicateTodosError error
+
+func newPossibleDuplicateTodosError(count int) possibleDuplicateTodosError {
+	return fmt.Errorf("%d open todos have a similar title; create the todo without the strict duplicate check to keep it anyway", count)
+}
+
+type todoTransitionNotAllowedError error
+
+func newTodoTransitionNotAllowedError(status string) todoTransitionNotAllowedError {
+	return fmt.Errorf("the todo's workflow doesn't allow moving from its current status to '%s'", status)
+}
+
+type duplicateWorkflowStateError error
+
+func newDuplicateWorkflowStateError(name string) duplicateWorkflowStateError {
+	return fmt.Errorf("state '%s' is listed more than once", name)
+}
+
+type unknownWorkflowStateError error
+
+func newUnknownWorkflowStateError(name string) unknownWorkflowStateError {
+	return fmt.Errorf("transition refers to state '%s' which isn't listed in the states", name)
+}
+
+type workflowSelfTransitionError error
+
+func newWorkflowSelfTransitionError(name string) workflowSelfTransitionError {
+	return fmt.Errorf("transition from '%s' to itself doesn't change the state", name)
+}
+
+type workflowNameAlreadyExistError error
+
+func newWorkflowNameAlreadyExistError(name string) workflowNameAlreadyExistError {
+	return fmt.Errorf("workflow with name '%s' already exist", name)
+}
+
 type ResourceNotFoundError struct {
 	resourceType string
 	id           int64
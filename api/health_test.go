@@ -6,12 +6,13 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/jaingounchained/todo/util"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGetHealthAPI(t *testing.T) {
 	// start test server and send request
-	server := NewGinHandler(nil, nil, nil)
+	server := NewGinHandler(util.Config{}, nil, nil, nil, nil)
 	recorder := httptest.NewRecorder()
 
 	url := "/health"
@@ -1,13 +1,27 @@
 package api
 
 import (
+	"math/rand"
 	"os"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 )
 
+// userIDAlphabet holds no whitespace so random user IDs survive the trimming of the user header
+const userIDAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
 	os.Exit(m.Run())
 }
+
+// RandomUserID returns a random user ID valid as X-User-ID header
+func RandomUserID() string {
+	b := make([]byte, 8)
+	for i := range b {
+		b[i] = userIDAlphabet[rand.Intn(len(userIDAlphabet))]
+	}
+
+	return string(b)
+}
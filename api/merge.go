@@ -0,0 +1,159 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type mergeTodoRequestURIParams struct {
+	getTodoRequest
+}
+
+type mergeTodoRequestBody struct {
+	TargetID int64 `json:"targetId" binding:"required,min=1"`
+}
+
+// mergeTodoResponse is the merged todo along with the todo it was merged into
+type mergeTodoResponse struct {
+	Source todoResponse `json:"source"`
+	Target todoResponse `json:"target"`
+}
+
+// mergeConflictResponse reports the attachments of both todos when they don't fit in the target together
+type mergeConflictResponse struct {
+	HTTPError
+	Limit             int             `json:"limit"`
+	SourceAttachments []db.Attachment `json:"sourceAttachments"`
+	TargetAttachments []db.Attachment `json:"targetAttachments"`
+}
+
+// mergeTodo godoc
+//
+//	@Summary		Merges a Todo into another one
+//	@Description	Merges a duplicate todo into the target todo in a single transaction: the attachments, comments and tags of the todo move to the target, and the todo gets closed in the first terminal state of its workflow with 'mergedIntoId' pointing to the target.
+//	@Description	When the attachments of both todos exceed the attachment limit, nothing is merged and the attachments of both are reported
+//	@Tags			todos
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path		int						true	"Todo ID"	minimum(1)
+//	@Param			merge	body		mergeTodoRequestBody	true	"Target todo"
+//	@Success		200		{object}	mergeTodoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409		{object}	mergeConflictResponse
+//	@Failure		500
+//	@Router			/todos/{todoId}/merge [post]
+func (server *Server) mergeTodo(ctx *gin.Context) {
+	var reqURIParams mergeTodoRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody mergeTodoRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if reqBody.TargetID == reqURIParams.TodoID {
+		NewHTTPError(ctx, http.StatusBadRequest, mergeTodoIntoItselfError)
+		return
+	}
+
+	source := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if source == nil {
+		return
+	}
+
+	target, err := server.store.GetTodo(ctx, reqBody.TargetID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownMergeTargetTodoError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	result, err := server.store.MergeTodoTx(ctx, db.MergeTodoTxParams{
+		SourceID:        source.ID,
+		TargetID:        target.ID,
+		AttachmentLimit: TodoAttachmentLimit,
+		Storage:         server.storage,
+	})
+	if err != nil {
+		var mergedErr *db.TodoMergedError
+		if errors.As(err, &mergedErr) {
+			NewHTTPError(ctx, http.StatusConflict, newTodoMergedError(mergedErr.TodoID, mergedErr.MergedIntoID))
+			return
+		}
+
+		var limitErr *db.MergeAttachmentLimitError
+		if errors.As(err, &limitErr) {
+			server.reportMergeConflict(ctx, *source, target, limitErr.Attachments)
+			return
+		}
+
+		if errors.Is(err, db.ErrWorkflowWithoutTerminalState) {
+			NewHTTPError(ctx, http.StatusConflict, workflowWithoutTerminalStateError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	// The merge is committed; the leftover directory of the source is only logged
+	if result.CleanupErr != nil {
+		_ = ctx.Error(result.CleanupErr)
+	}
+
+	resp := server.buildTodoResponsesAndHandleErrors(ctx, []db.Todo{result.Source, result.Target})
+	if resp == nil {
+		return
+	}
+
+	server.notifyTodoChange(ctx, source.ID,
+		fmt.Sprintf("Todo merged: %s", source.Title),
+		fmt.Sprintf("Todo '%s' was merged into '%s' and closed", source.Title, target.Title),
+	)
+	server.notifyTodoChange(ctx, target.ID,
+		fmt.Sprintf("Todo merged: %s", target.Title),
+		fmt.Sprintf("Todo '%s' was merged into '%s'", source.Title, target.Title),
+	)
+
+	ctx.JSON(http.StatusOK, mergeTodoResponse{
+		Source: resp[0],
+		Target: resp[1],
+	})
+}
+
+// reportMergeConflict lists the attachments of both todos, among which some have to be deleted before merging
+func (server *Server) reportMergeConflict(ctx *gin.Context, source, target db.Todo, attachments int) {
+	sourceAttachments, err := server.store.ListAttachmentOfTodo(ctx, source.ID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	targetAttachments, err := server.store.ListAttachmentOfTodo(ctx, target.ID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusConflict, mergeConflictResponse{
+		HTTPError: HTTPError{
+			Message: newMergeAttachmentLimitExceededError(attachments).Error(),
+		},
+		Limit:             TodoAttachmentLimit,
+		SourceAttachments: sourceAttachments,
+		TargetAttachments: targetAttachments,
+	})
+}
@@ -0,0 +1,302 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	mockNotification "github.com/jaingounchained/todo/notification/mock"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTodoAPI(t *testing.T) {
+	source := RandomTodo()
+	target := RandomTodo()
+	target.ID = source.ID + 1
+
+	mergedSource := source
+	mergedSource.MergedIntoID = &target.ID
+
+	attachments := []db.Attachment{
+		{ID: util.RandomInt(1, 1000), TodoID: source.ID, OriginalFilename: util.RandomString(10)},
+	}
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage)
+		expectedDispatches int
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: source.ID,
+			body: gin.H{
+				"targetId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(target.ID)).Times(1).Return(target, nil)
+				arg := db.MergeTodoTxParams{
+					SourceID:        source.ID,
+					TargetID:        target.ID,
+					AttachmentLimit: TodoAttachmentLimit,
+					Storage:         mockStorage,
+				}
+				store.EXPECT().
+					MergeTodoTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.MergeTodoTxResult{Source: mergedSource, Target: target}, nil)
+				expectTodoRollups(store, mergedSource, target)
+			},
+			expectedDispatches: 2,
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				assert.NoError(t, err)
+
+				var gotResp struct {
+					Source db.Todo `json:"source"`
+					Target db.Todo `json:"target"`
+				}
+				err = json.Unmarshal(data, &gotResp)
+				assert.NoError(t, err)
+				assert.Equal(t, mergedSource, gotResp.Source)
+				assert.Equal(t, target, gotResp.Target)
+			},
+		},
+		{
+			name:   "SourceDirectoryLeftOver",
+			todoID: source.ID,
+			body: gin.H{
+				"targetId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(target.ID)).Times(1).Return(target, nil)
+				store.EXPECT().
+					MergeTodoTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeTodoTxResult{Source: mergedSource, Target: target, CleanupErr: fmt.Errorf("storage failure")}, nil)
+				expectTodoRollups(store, mergedSource, target)
+			},
+			expectedDispatches: 2,
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "MergeIntoItself",
+			todoID: source.ID,
+			body: gin.H{
+				"targetId": source.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().MergeTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: mergeTodoIntoItselfError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "MissingTargetID",
+			todoID: source.ID,
+			body:   gin.H{},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().MergeTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "TodoNotFound",
+			todoID: source.ID,
+			body: gin.H{
+				"targetId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().MergeTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: "todo",
+				id:           source.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "UnknownTarget",
+			todoID: source.ID,
+			body: gin.H{
+				"targetId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(target.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().MergeTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: unknownMergeTargetTodoError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "AlreadyMerged",
+			todoID: source.ID,
+			body: gin.H{
+				"targetId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(target.ID)).Times(1).Return(target, nil)
+				store.EXPECT().
+					MergeTodoTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeTodoTxResult{}, &db.TodoMergedError{TodoID: source.ID, MergedIntoID: target.ID})
+			},
+			errorExpected: true,
+			expectedError: newTodoMergedError(source.ID, target.ID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "AttachmentLimitExceeded",
+			todoID: source.ID,
+			body: gin.H{
+				"targetId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(target.ID)).Times(1).Return(target, nil)
+				store.EXPECT().
+					MergeTodoTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeTodoTxResult{}, &db.MergeAttachmentLimitError{Attachments: 6})
+				store.EXPECT().ListAttachmentOfTodo(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(attachments, nil)
+				store.EXPECT().ListAttachmentOfTodo(gomock.Any(), gomock.Eq(target.ID)).Times(1).Return([]db.Attachment{}, nil)
+			},
+			errorExpected: true,
+			expectedError: newMergeAttachmentLimitExceededError(6),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+
+				data, readErr := io.ReadAll(recorder.Body)
+				assert.NoError(t, readErr)
+
+				var gotResp mergeConflictResponse
+				assert.NoError(t, json.Unmarshal(data, &gotResp))
+				assert.Equal(t, err.Error(), gotResp.Message)
+				assert.Equal(t, TodoAttachmentLimit, gotResp.Limit)
+				assert.Equal(t, attachments[0].ID, gotResp.SourceAttachments[0].ID)
+				assert.Empty(t, gotResp.TargetAttachments)
+			},
+		},
+		{
+			name:   "WorkflowWithoutTerminalState",
+			todoID: source.ID,
+			body: gin.H{
+				"targetId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(target.ID)).Times(1).Return(target, nil)
+				store.EXPECT().
+					MergeTodoTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeTodoTxResult{}, db.ErrWorkflowWithoutTerminalState)
+			},
+			errorExpected: true,
+			expectedError: workflowWithoutTerminalStateError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: source.ID,
+			body: gin.H{
+				"targetId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(target.ID)).Times(1).Return(target, nil)
+				store.EXPECT().
+					MergeTodoTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.MergeTodoTxResult{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mockStorage.NewMockStorage(ctrl)
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store, mockStorage)
+
+			dispatcher := mockNotification.NewMockDispatcher(ctrl)
+			dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any(), gomock.Any()).Times(tc.expectedDispatches)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, mockStorage, dispatcher, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/merge", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	notification "github.com/jaingounchained/todo/notification"
+)
+
+// notifyTodoChange dispatches a notification about the change of the todo to its assignees and watchers;
+// the user of the request, when identified, isn't notified about their own change; the dispatcher is expected to
+// return without waiting for the notifier, see notification.AsyncDispatcher
+func (server *Server) notifyTodoChange(ctx *gin.Context, todoID int64, subject, message string) {
+	server.dispatcher.Dispatch(ctx, notification.Notification{
+		TodoID:  todoID,
+		Subject: subject,
+		Message: message,
+	}, strings.TrimSpace(ctx.GetHeader(UserIDHeader)))
+}
+
+// updatedTodoFields lists the fields changed by the update request, the way they're named in the request
+func updatedTodoFields(reqBody updateTodoRequestBody) []string {
+	fields := []struct {
+		name    string
+		updated bool
+	}{
+		{"title", reqBody.Title != nil},
+		{"status", reqBody.Status != nil},
+		{"dueAt", reqBody.DueAt.Present},
+		{"dueTimezone", reqBody.DueTimezone != nil},
+		{"priority", reqBody.Priority != nil},
+		{"description", reqBody.Description != nil},
+	}
+
+	updated := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field.updated {
+			updated = append(updated, field.name)
+		}
+	}
+
+	return updated
+}
+
+// quoteAll quotes each of the names for a notification message
+func quoteAll(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, fmt.Sprintf("'%s'", name))
+	}
+
+	return strings.Join(quoted, ", ")
+}
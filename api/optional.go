@@ -0,0 +1,50 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// optionalTime tells an absent JSON field apart from an explicit null, which clears the value
+type optionalTime struct {
+	Present bool
+	Value   *time.Time
+}
+
+func (t *optionalTime) UnmarshalJSON(data []byte) error {
+	t.Present = true
+	if string(data) == "null" {
+		t.Value = nil
+		return nil
+	}
+
+	var value time.Time
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	t.Value = &value
+	return nil
+}
+
+// optionalInt64 tells an absent JSON field apart from an explicit null, which clears the value
+type optionalInt64 struct {
+	Present bool
+	Value   *int64
+}
+
+func (i *optionalInt64) UnmarshalJSON(data []byte) error {
+	i.Present = true
+	if string(data) == "null" {
+		i.Value = nil
+		return nil
+	}
+
+	var value int64
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	i.Value = &value
+	return nil
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+// listTodoPageResponse is a page of todos along with the cursors of its neighbouring pages
+type listTodoPageResponse struct {
+	// Todos are todo responses, trimmed down to the sparse fieldset if any
+	Todos      []any   `json:"todos"`
+	NextCursor *string `json:"nextCursor"`
+	PrevCursor *string `json:"prevCursor"`
+	TotalCount *int64  `json:"totalCount,omitempty"`
+}
+
+// todoPageCursor is the content of the opaque cursors handed out to the clients; it is bound to the sort order it
+// was taken in
+type todoPageCursor struct {
+	Sort   string        `json:"sort"`
+	Cursor db.TodoCursor `json:"cursor"`
+}
+
+// maxPageSize is the configured maximum page size of the cursor pagination
+func (server *Server) maxPageSize() int32 {
+	if server.config.MaxPageSize > 0 {
+		return server.config.MaxPageSize
+	}
+
+	return DefaultMaxPageSize
+}
+
+// formatTodoSort formats the sort order the way it is given in the 'sort' query parameter
+func formatTodoSort(sort []db.TodoSort) string {
+	fields := make([]string, 0, len(sort))
+	for _, s := range sort {
+		if s.Descending {
+			fields = append(fields, "-"+s.Field)
+		} else {
+			fields = append(fields, s.Field)
+		}
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// encodeTodoCursor encodes the cursor taken in the sort order into an opaque string
+func encodeTodoCursor(cursor db.TodoCursor, sort []db.TodoSort) string {
+	// Marshalling a struct of plain values can't fail
+	data, _ := json.Marshal(todoPageCursor{
+		Sort:   formatTodoSort(sort),
+		Cursor: cursor,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeTodoCursor decodes the opaque cursor, which must have been taken in the same sort order
+func decodeTodoCursor(cursor string, sort []db.TodoSort) (*db.TodoCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, cursorInvalidError
+	}
+
+	var pageCursor todoPageCursor
+	if err := json.Unmarshal(data, &pageCursor); err != nil {
+		return nil, cursorInvalidError
+	}
+
+	if pageCursor.Sort != formatTodoSort(sort) {
+		return nil, cursorSortMismatchError
+	}
+
+	return &pageCursor.Cursor, nil
+}
+
+// pageRows trims the row fetched beyond the page when paging by cursor, and returns the cursors of the neighbouring
+// pages, encoded by cursorAt, which are also set in the Link header. Paging backward comes from the next page, and
+// paging forward from a cursor leaves the previous page behind
+func pageRows[T any](ctx *gin.Context, rows []T, arg db.ListTodosParams, cursorAt func(row T, backward bool) string) ([]T, *string, *string) {
+	pageSize := int(arg.Limit) - 1
+	backward := arg.Cursor != nil && arg.Cursor.Backward
+
+	hasMore := len(rows) > pageSize
+	if hasMore && backward {
+		rows = rows[1:]
+	} else if hasMore {
+		rows = rows[:pageSize]
+	}
+
+	var nextCursor, prevCursor *string
+	if len(rows) > 0 {
+		if hasMore || backward {
+			cursor := cursorAt(rows[len(rows)-1], false)
+			nextCursor = &cursor
+		}
+		if (hasMore && backward) || (arg.Cursor != nil && !backward) {
+			cursor := cursorAt(rows[0], true)
+			prevCursor = &cursor
+		}
+	}
+	setLinkHeader(ctx, nextCursor, prevCursor)
+
+	return rows, nextCursor, prevCursor
+}
+
+// setTotalCountHeader sets the total count of the listed todos in the X-Total-Count header and returns it
+func setTotalCountHeader(ctx *gin.Context, count int64) *int64 {
+	ctx.Header(TotalCountHeader, strconv.FormatInt(count, 10))
+	return &count
+}
+
+// setLinkHeader sets the RFC 8288 Link header pointing to the first page and to the neighbouring pages, given by their
+// cursors, keeping the other query parameters of the request
+func setLinkHeader(ctx *gin.Context, nextCursor, prevCursor *string) {
+	link := func(cursor *string, rel string) string {
+		query := ctx.Request.URL.Query()
+		query.Del("pageId")
+		query.Del("cursor")
+		if cursor != nil {
+			query.Set("cursor", *cursor)
+		}
+
+		return fmt.Sprintf("<%s?%s>; rel=\"%s\"", ctx.Request.URL.Path, query.Encode(), rel)
+	}
+
+	links := []string{link(nil, "first")}
+	if prevCursor != nil {
+		links = append(links, link(prevCursor, "prev"))
+	}
+	if nextCursor != nil {
+		links = append(links, link(nextCursor, "next"))
+	}
+
+	ctx.Header(LinkHeader, strings.Join(links, ", "))
+}
@@ -0,0 +1,222 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type getProjectRequest struct {
+	ProjectID int64 `uri:"projectId" binding:"required,min=1"`
+}
+
+// getProject godoc
+//
+//	@Summary		Returns a project
+//	@Description	Get project by ProjectID
+//	@Tags			projects
+//	@Produce		json
+//	@Param			projectId	path		int	true	"Project ID"	minimum(1)
+//	@Success		200			{object}	db.Project
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/projects/{projectId} [get]
+func (server *Server) getProject(ctx *gin.Context) {
+	var req getProjectRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, projectIDInvalidError)
+		return
+	}
+
+	project := server.fetchProjectAndHandleErrors(ctx, req.ProjectID)
+	if project == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, project)
+}
+
+// listProjects godoc
+//
+//	@Summary		List projects
+//	@Description	List all the projects ordered by name
+//	@Tags			projects
+//	@Produce		json
+//	@Success		200	{array}	db.Project
+//	@Failure		500
+//	@Router			/projects [get]
+func (server *Server) listProjects(ctx *gin.Context) {
+	projects, err := server.store.ListProjects(ctx)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, projects)
+}
+
+type createProjectRequest struct {
+	Name       string `json:"name" binding:"required,max=255"`
+	WorkflowID *int64 `json:"workflowId" binding:"omitempty,min=1"`
+}
+
+// createProject godoc
+//
+//	@Summary		Creates a project
+//	@Description	Creates a project with the specified name and workflow; without a workflow its todos follow the default workflow
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			project	body		createProjectRequest	true	"Project name/workflow"
+//	@Success		200		{object}	db.Project
+//	@Failure		400
+//	@Failure		500
+//	@Router			/projects [post]
+func (server *Server) createProject(ctx *gin.Context) {
+	var req createProjectRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	project, err := server.store.CreateProject(ctx, db.CreateProjectParams{
+		Name:       req.Name,
+		WorkflowID: req.WorkflowID,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownWorkflowError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, project)
+}
+
+type updateProjectRequestURIParams struct {
+	getProjectRequest
+}
+
+type updateProjectRequestBody struct {
+	Name       *string       `json:"name" binding:"omitempty,max=255"`
+	WorkflowID optionalInt64 `json:"workflowId" swaggertype:"integer" extensions:"x-nullable"`
+}
+
+// updateProject godoc
+//
+//	@Summary		Updates the project name/workflow
+//	@Description	Renames a project or switches its workflow; a null workflowId falls back to the default workflow. Todos keep their status when the workflow changes
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			projectId	path		int							true	"Project ID"	minimum(1)
+//	@Param			project		body		updateProjectRequestBody	true	"Project name/workflow"
+//	@Success		200			{object}	db.Project
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/projects/{projectId} [patch]
+func (server *Server) updateProject(ctx *gin.Context) {
+	var reqURIParams updateProjectRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, projectIDInvalidError)
+		return
+	}
+
+	var reqBody updateProjectRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// Update at least one of name or workflow
+	if reqBody.Name == nil && !reqBody.WorkflowID.Present {
+		NewHTTPError(ctx, http.StatusBadRequest, updateProjectInvalidBodyError)
+		return
+	}
+
+	project, err := server.store.UpdateProject(ctx, db.UpdateProjectParams{
+		ID:               reqURIParams.ProjectID,
+		Name:             reqBody.Name,
+		UpdateWorkflowID: reqBody.WorkflowID.Present,
+		WorkflowID:       reqBody.WorkflowID.Value,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceProject,
+				id:           reqURIParams.ProjectID,
+			})
+			return
+		}
+
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownWorkflowError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, project)
+}
+
+type deleteProjectRequest struct {
+	getProjectRequest
+}
+
+// deleteProject godoc
+//
+//	@Summary		Deletes a project
+//	@Description	Delete project by ProjectID; its todos are kept and follow the default workflow from then on
+//	@Tags			projects
+//	@Param			projectId	path	int	true	"Project ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/projects/{projectId} [delete]
+func (server *Server) deleteProject(ctx *gin.Context) {
+	var req deleteProjectRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, projectIDInvalidError)
+		return
+	}
+
+	project := server.fetchProjectAndHandleErrors(ctx, req.ProjectID)
+	if project == nil {
+		return
+	}
+
+	if err := server.store.DeleteProject(ctx, req.ProjectID); err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+func (server *Server) fetchProjectAndHandleErrors(ctx *gin.Context, projectID int64) *db.Project {
+	project, err := server.store.GetProject(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceProject,
+				id:           projectID,
+			})
+			return nil
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	return &project
+}
@@ -0,0 +1,276 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomProject() db.Project {
+	return db.Project{
+		ID:   util.RandomInt(1, 1000),
+		Name: util.RandomString(10),
+	}
+}
+
+func assertBodyMatchProject(t *testing.T, body *bytes.Buffer, project db.Project) {
+	data, err := io.ReadAll(body)
+	assert.NoError(t, err)
+
+	var gotProject db.Project
+	err = json.Unmarshal(data, &gotProject)
+	assert.NoError(t, err)
+	assert.Equal(t, project, gotProject)
+}
+
+func TestCreateProjectAPI(t *testing.T) {
+	project := RandomProject()
+	workflowID := util.RandomInt(1, 1000)
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OKDefaultWorkflow",
+			body: gin.H{
+				"name": project.Name,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.CreateProjectParams{
+					Name: project.Name,
+				}
+				store.EXPECT().CreateProject(gomock.Any(), gomock.Eq(arg)).Times(1).Return(project, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchProject(t, recorder.Body, project)
+			},
+		},
+		{
+			name: "OKWithWorkflow",
+			body: gin.H{
+				"name":       project.Name,
+				"workflowId": workflowID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.CreateProjectParams{
+					Name:       project.Name,
+					WorkflowID: &workflowID,
+				}
+				store.EXPECT().CreateProject(gomock.Any(), gomock.Eq(arg)).Times(1).Return(project, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchProject(t, recorder.Body, project)
+			},
+		},
+		{
+			name: "NameAbsent",
+			body: gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownWorkflow",
+			body: gin.H{
+				"name":       project.Name,
+				"workflowId": workflowID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateProject(gomock.Any(), gomock.Any()).Times(1).Return(db.Project{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			errorExpected: true,
+			expectedError: unknownWorkflowError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/projects", bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestUpdateProjectAPI(t *testing.T) {
+	project := RandomProject()
+	updatedName := util.RandomString(10)
+	workflowID := util.RandomInt(1, 1000)
+
+	tcs := []struct {
+		name               string
+		projectID          int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:      "OKRename",
+			projectID: project.ID,
+			body: gin.H{
+				"name": updatedName,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateProjectParams{
+					ID:   project.ID,
+					Name: &updatedName,
+				}
+				store.EXPECT().UpdateProject(gomock.Any(), gomock.Eq(arg)).Times(1).Return(project, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchProject(t, recorder.Body, project)
+			},
+		},
+		{
+			name:      "OKSwitchWorkflow",
+			projectID: project.ID,
+			body: gin.H{
+				"workflowId": workflowID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateProjectParams{
+					ID:               project.ID,
+					UpdateWorkflowID: true,
+					WorkflowID:       &workflowID,
+				}
+				store.EXPECT().UpdateProject(gomock.Any(), gomock.Eq(arg)).Times(1).Return(project, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "OKDefaultWorkflow",
+			projectID: project.ID,
+			body: gin.H{
+				"workflowId": nil,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateProjectParams{
+					ID:               project.ID,
+					UpdateWorkflowID: true,
+				}
+				store.EXPECT().UpdateProject(gomock.Any(), gomock.Eq(arg)).Times(1).Return(project, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "EmptyBody",
+			projectID: project.ID,
+			body:      gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: updateProjectInvalidBodyError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:      "NotFound",
+			projectID: project.ID,
+			body: gin.H{
+				"name": updatedName,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateProject(gomock.Any(), gomock.Any()).Times(1).Return(db.Project{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceProject,
+				id:           project.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/projects/%d", tc.projectID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/rrule"
+)
+
+// recurrenceResponse is a recurrence along with the due date of the instance created once the current one is completed
+type recurrenceResponse struct {
+	db.Recurrence
+	NextOccurrence *time.Time `json:"nextOccurrence"`
+}
+
+func newRecurrenceResponse(recurrence db.Recurrence, todo db.Todo) (recurrenceResponse, error) {
+	after := time.Now()
+	if todo.DueAt != nil {
+		after = *todo.DueAt
+	}
+
+	resp := recurrenceResponse{Recurrence: recurrence}
+	next, err := recurrence.NextOccurrence(after)
+	if errors.Is(err, db.ErrRecurrenceEnded) {
+		return resp, nil
+	}
+	if err != nil {
+		return resp, err
+	}
+
+	resp.NextOccurrence = &next
+	return resp, nil
+}
+
+type getTodoRecurrenceRequest struct {
+	getTodoRequest
+}
+
+// getTodoRecurrence godoc
+//
+//	@Summary		Returns the recurrence of a todo
+//	@Description	Get the recurrence rule of the todo along with its next occurrence
+//	@Tags			recurrences
+//	@Produce		json
+//	@Param			todoId	path		int	true	"Todo ID"	minimum(1)
+//	@Success		200		{object}	recurrenceResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/recurrence [get]
+func (server *Server) getTodoRecurrence(ctx *gin.Context) {
+	var req getTodoRecurrenceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	if todo.RecurrenceID == nil {
+		NewHTTPError(ctx, http.StatusNotFound, todoNotRecurringError)
+		return
+	}
+
+	recurrence, err := server.store.GetRecurrence(ctx, *todo.RecurrenceID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp, err := newRecurrenceResponse(recurrence, *todo)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type setTodoRecurrenceRequestURIParams struct {
+	getTodoRequest
+}
+
+type setTodoRecurrenceRequestBody struct {
+	Rrule           string `json:"rrule" binding:"required,max=255" example:"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"`
+	CopyAttachments bool   `json:"copyAttachments"`
+}
+
+// setTodoRecurrence godoc
+//
+//	@Summary		Makes a todo recurring
+//	@Description	Sets an RFC 5545 recurrence rule on the todo, starting at its due date; completing the todo creates the next instance
+//	@Tags			recurrences
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId		path		int								true	"Todo ID"	minimum(1)
+//	@Param			recurrence	body		setTodoRecurrenceRequestBody	true	"Recurrence rule"
+//	@Success		200			{object}	recurrenceResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/recurrence [put]
+func (server *Server) setTodoRecurrence(ctx *gin.Context) {
+	var reqURIParams setTodoRecurrenceRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody setTodoRecurrenceRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if _, err := rrule.Parse(reqBody.Rrule); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	result, err := server.store.SetTodoRecurrenceTx(ctx, db.SetTodoRecurrenceTxParams{
+		TodoID:          reqURIParams.TodoID,
+		Rrule:           reqBody.Rrule,
+		CopyAttachments: reqBody.CopyAttachments,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrTodoWithoutDueDate) {
+			NewHTTPError(ctx, http.StatusBadRequest, recurringTodoWithoutDueDateError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp, err := newRecurrenceResponse(result.Recurrence, result.Todo)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type skipTodoOccurrenceRequest struct {
+	getTodoRequest
+}
+
+// skipTodoOccurrence godoc
+//
+//	@Summary		Skips an occurrence of a recurring todo
+//	@Description	Moves the due date of the recurring todo to its next occurrence
+//	@Tags			recurrences
+//	@Produce		json
+//	@Param			todoId	path		int	true	"Todo ID"	minimum(1)
+//	@Success		200		{object}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/todos/{todoId}/recurrence/skip [post]
+func (server *Server) skipTodoOccurrence(ctx *gin.Context) {
+	var req skipTodoOccurrenceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	result, err := server.store.SkipTodoOccurrenceTx(ctx, req.TodoID)
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRecordNotFound):
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           req.TodoID,
+			})
+		case errors.Is(err, db.ErrTodoNotRecurring):
+			NewHTTPError(ctx, http.StatusNotFound, todoNotRecurringError)
+		case errors.Is(err, db.ErrRecurrenceEnded):
+			NewHTTPError(ctx, http.StatusConflict, recurrenceEndedError)
+		default:
+			NewHTTPError(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type deleteTodoRecurrenceRequest struct {
+	getTodoRequest
+}
+
+// deleteTodoRecurrence godoc
+//
+//	@Summary		Ends the series of a recurring todo
+//	@Description	Removes the recurrence of the todo, the current instance is kept and no further instance is created
+//	@Tags			recurrences
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/recurrence [delete]
+func (server *Server) deleteTodoRecurrence(ctx *gin.Context) {
+	var req deleteTodoRecurrenceRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	if todo.RecurrenceID == nil {
+		NewHTTPError(ctx, http.StatusNotFound, todoNotRecurringError)
+		return
+	}
+
+	err := server.store.DeleteRecurrence(ctx, *todo.RecurrenceID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,491 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomRecurringTodo() (db.Todo, db.Recurrence) {
+	dueAt := time.Date(2030, time.January, 7, 9, 0, 0, 0, time.UTC)
+	recurrence := db.Recurrence{
+		ID:       util.RandomInt(1, 1000),
+		Rrule:    "FREQ=WEEKLY;BYDAY=MO,WE",
+		Dtstart:  dueAt,
+		Timezone: "UTC",
+	}
+
+	todo := RandomTodo()
+	todo.DueAt = &dueAt
+	todo.RecurrenceID = &recurrence.ID
+
+	return todo, recurrence
+}
+
+func assertBodyMatchRecurrence(t *testing.T, body *bytes.Buffer, recurrence db.Recurrence, nextOccurrence *time.Time) {
+	data, err := io.ReadAll(body)
+	assert.NoError(t, err)
+
+	var got recurrenceResponse
+	err = json.Unmarshal(data, &got)
+	assert.NoError(t, err)
+
+	assert.Equal(t, recurrence.ID, got.ID)
+	assert.Equal(t, recurrence.Rrule, got.Rrule)
+	if nextOccurrence == nil {
+		assert.Nil(t, got.NextOccurrence)
+	} else {
+		assert.True(t, nextOccurrence.Equal(*got.NextOccurrence))
+	}
+}
+
+func TestGetTodoRecurrenceAPI(t *testing.T) {
+	todo, recurrence := randomRecurringTodo()
+	nextOccurrence := time.Date(2030, time.January, 9, 9, 0, 0, 0, time.UTC)
+
+	plainTodo := RandomTodo()
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetRecurrence(gomock.Any(), gomock.Eq(recurrence.ID)).Times(1).Return(recurrence, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchRecurrence(t, recorder.Body, recurrence, &nextOccurrence)
+			},
+		},
+		{
+			name:   "InvalidID",
+			todoID: 0,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: todoIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "NotRecurring",
+			todoID: plainTodo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(plainTodo.ID)).Times(1).Return(plainTodo, nil)
+				store.EXPECT().GetRecurrence(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: todoNotRecurringError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetRecurrence(gomock.Any(), gomock.Eq(recurrence.ID)).Times(1).Return(db.Recurrence{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/recurrence", tc.todoID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestSetTodoRecurrenceAPI(t *testing.T) {
+	todo, recurrence := randomRecurringTodo()
+	recurrence.Rrule = "FREQ=DAILY;COUNT=1"
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			body: gin.H{
+				"rrule":           recurrence.Rrule,
+				"copyAttachments": true,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.SetTodoRecurrenceTxParams{
+					TodoID:          todo.ID,
+					Rrule:           recurrence.Rrule,
+					CopyAttachments: true,
+				}
+				store.EXPECT().
+					SetTodoRecurrenceTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.SetTodoRecurrenceTxResult{Recurrence: recurrence, Todo: todo}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				// COUNT=1 leaves no occurrence after the due date
+				assertBodyMatchRecurrence(t, recorder.Body, recurrence, nil)
+			},
+		},
+		{
+			name:   "InvalidRule",
+			todoID: todo.ID,
+			body: gin.H{
+				"rrule": "FREQ=HOURLY",
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().SetTodoRecurrenceTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "RuleAbsent",
+			todoID: todo.ID,
+			body:   gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().SetTodoRecurrenceTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "TodoNotFound",
+			todoID: todo.ID,
+			body: gin.H{
+				"rrule": recurrence.Rrule,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().SetTodoRecurrenceTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "WithoutDueDate",
+			todoID: todo.ID,
+			body: gin.H{
+				"rrule": recurrence.Rrule,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().SetTodoRecurrenceTx(gomock.Any(), gomock.Any()).Times(1).Return(db.SetTodoRecurrenceTxResult{}, db.ErrTodoWithoutDueDate)
+			},
+			errorExpected: true,
+			expectedError: recurringTodoWithoutDueDateError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: todo.ID,
+			body: gin.H{
+				"rrule": recurrence.Rrule,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().SetTodoRecurrenceTx(gomock.Any(), gomock.Any()).Times(1).Return(db.SetTodoRecurrenceTxResult{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/recurrence", tc.todoID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestSkipTodoOccurrenceAPI(t *testing.T) {
+	todo, _ := randomRecurringTodo()
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().SkipTodoOccurrenceTx(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.SkipTodoOccurrenceTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name:   "NotFound",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().SkipTodoOccurrenceTx(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.SkipTodoOccurrenceTxResult{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "NotRecurring",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().SkipTodoOccurrenceTx(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.SkipTodoOccurrenceTxResult{}, db.ErrTodoNotRecurring)
+			},
+			errorExpected: true,
+			expectedError: todoNotRecurringError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "RecurrenceEnded",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().SkipTodoOccurrenceTx(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.SkipTodoOccurrenceTxResult{}, db.ErrRecurrenceEnded)
+			},
+			errorExpected: true,
+			expectedError: recurrenceEndedError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().SkipTodoOccurrenceTx(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.SkipTodoOccurrenceTxResult{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/recurrence/skip", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestDeleteTodoRecurrenceAPI(t *testing.T) {
+	todo, recurrence := randomRecurringTodo()
+	plainTodo := RandomTodo()
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().DeleteRecurrence(gomock.Any(), gomock.Eq(recurrence.ID)).Times(1).Return(nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "NotRecurring",
+			todoID: plainTodo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(plainTodo.ID)).Times(1).Return(plainTodo, nil)
+				store.EXPECT().DeleteRecurrence(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: todoNotRecurringError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().DeleteRecurrence(gomock.Any(), gomock.Eq(recurrence.ID)).Times(1).Return(sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/recurrence", tc.todoID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type listTodoRemindersRequest struct {
+	getTodoRequest
+}
+
+// listTodoReminders godoc
+//
+//	@Summary		List reminders of a todo
+//	@Description	List the reminders of the todo, fired relative to its due date
+//	@Tags			reminders
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	db.Reminder
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/reminders [get]
+func (server *Server) listTodoReminders(ctx *gin.Context) {
+	var req listTodoRemindersRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	reminders, err := server.store.ListRemindersOfTodo(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reminders)
+}
+
+type createTodoReminderRequestURIParams struct {
+	getTodoRequest
+}
+
+type createTodoReminderRequestBody struct {
+	OffsetMinutes *int32 `json:"offsetMinutes" binding:"required,min=0,max=525600"`
+}
+
+// createTodoReminder godoc
+//
+//	@Summary		Creates a reminder
+//	@Description	Creates a reminder fired the specified number of minutes before the todo is due
+//	@Tags			reminders
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId		path		int								true	"Todo ID"	minimum(1)
+//	@Param			reminder	body		createTodoReminderRequestBody	true	"Reminder offset"
+//	@Success		200			{object}	db.Reminder
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/todos/{todoId}/reminders [post]
+func (server *Server) createTodoReminder(ctx *gin.Context) {
+	var reqURIParams createTodoReminderRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody createTodoReminderRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	reminder, err := server.store.CreateReminder(ctx, db.CreateReminderParams{
+		TodoID:        reqURIParams.TodoID,
+		OffsetMinutes: *reqBody.OffsetMinutes,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, newReminderAlreadyExistError(*reqBody.OffsetMinutes))
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reminder)
+}
+
+type deleteTodoReminderRequest struct {
+	getTodoRequest
+	ReminderID int64 `uri:"reminderId" binding:"required,min=1"`
+}
+
+// deleteTodoReminder godoc
+//
+//	@Summary		Delete reminder
+//	@Description	Delete reminder of the corresponding todo
+//	@Tags			reminders
+//	@Param			todoId		path	int	true	"Todo ID"		minimum(1)
+//	@Param			reminderId	path	int	true	"Reminder ID"	minimum(1)
+//	@Success		200
+//	@Failure		403
+//	@Failure		404
+//	@Failure		400
+//	@Failure		500
+//	@Router			/todos/{todoId}/reminders/{reminderId} [delete]
+func (server *Server) deleteTodoReminder(ctx *gin.Context) {
+	var req deleteTodoReminderRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	reminder, err := server.store.GetReminder(ctx, req.ReminderID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceReminder,
+				id:           req.ReminderID,
+			})
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	if reminder.TodoID != req.TodoID {
+		NewHTTPError(ctx, http.StatusForbidden, newReminderNotAssociatedWithTodoError(req.TodoID, req.ReminderID))
+		return
+	}
+
+	if err := server.store.DeleteReminder(ctx, req.ReminderID); err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
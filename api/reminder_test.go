@@ -0,0 +1,237 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomReminderOfTodo(todo db.Todo) db.Reminder {
+	return db.Reminder{
+		ID:            util.RandomInt(1, 1000),
+		TodoID:        todo.ID,
+		OffsetMinutes: int32(util.RandomInt(0, 1440)),
+	}
+}
+
+func assertBodyMatchReminder(t *testing.T, body *bytes.Buffer, reminder db.Reminder) {
+	data, err := io.ReadAll(body)
+	assert.NoError(t, err)
+
+	var gotReminder db.Reminder
+	err = json.Unmarshal(data, &gotReminder)
+	assert.NoError(t, err)
+	assert.Equal(t, reminder, gotReminder)
+}
+
+func TestCreateTodoReminderAPI(t *testing.T) {
+	todo := RandomTodo()
+	reminder := RandomReminderOfTodo(todo)
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			body: gin.H{
+				"offsetMinutes": reminder.OffsetMinutes,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.CreateReminderParams{
+					TodoID:        todo.ID,
+					OffsetMinutes: reminder.OffsetMinutes,
+				}
+				store.EXPECT().CreateReminder(gomock.Any(), gomock.Eq(arg)).Times(1).Return(reminder, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchReminder(t, recorder.Body, reminder)
+			},
+		},
+		{
+			name:   "OffsetAbsent",
+			todoID: todo.ID,
+			body:   gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateReminder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "NegativeOffset",
+			todoID: todo.ID,
+			body: gin.H{
+				"offsetMinutes": -5,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateReminder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "DuplicateOffset",
+			todoID: todo.ID,
+			body: gin.H{
+				"offsetMinutes": reminder.OffsetMinutes,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().CreateReminder(gomock.Any(), gomock.Any()).Times(1).Return(db.Reminder{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			errorExpected: true,
+			expectedError: newReminderAlreadyExistError(reminder.OffsetMinutes),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/reminders", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestDeleteTodoReminderAPI(t *testing.T) {
+	todo := RandomTodo()
+	reminder := RandomReminderOfTodo(todo)
+	otherReminder := RandomReminderOfTodo(RandomTodo())
+	otherReminder.TodoID = todo.ID + 1
+
+	tcs := []struct {
+		name               string
+		reminderID         int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:       "OK",
+			reminderID: reminder.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetReminder(gomock.Any(), gomock.Eq(reminder.ID)).Times(1).Return(reminder, nil)
+				store.EXPECT().DeleteReminder(gomock.Any(), gomock.Eq(reminder.ID)).Times(1).Return(nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "ReminderNotFound",
+			reminderID: reminder.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetReminder(gomock.Any(), gomock.Eq(reminder.ID)).Times(1).Return(db.Reminder{}, db.ErrRecordNotFound)
+				store.EXPECT().DeleteReminder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceReminder,
+				id:           reminder.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:       "ReminderOfAnotherTodo",
+			reminderID: otherReminder.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetReminder(gomock.Any(), gomock.Eq(otherReminder.ID)).Times(1).Return(otherReminder, nil)
+				store.EXPECT().DeleteReminder(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newReminderNotAssociatedWithTodoError(todo.ID, otherReminder.ID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/reminders/%d", todo.ID, tc.reminderID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
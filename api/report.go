@@ -0,0 +1,250 @@
+package api
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type timeReportRequest struct {
+	From     string `form:"from" binding:"required,datetime=2006-01-02"`
+	To       string `form:"to" binding:"required,datetime=2006-01-02"`
+	Timezone string `form:"timezone" binding:"omitempty,timezone"`
+	UserID   string `form:"userId" binding:"max=64"`
+	Format   string `form:"format" binding:"omitempty,oneof=json csv"`
+}
+
+// timeReportQuery is a bound time report request, whose range runs from the start of its first day to the end of its last day
+type timeReportQuery struct {
+	timezone      string
+	startedFrom   time.Time
+	startedBefore time.Time
+	userID        *string
+	format        string
+}
+
+// bindTimeReportRequestAndHandleErrors binds the report query, resolving its inclusive range of days in the
+// requested timezone; writes the error response and returns nil on failure
+func bindTimeReportRequestAndHandleErrors(ctx *gin.Context) *timeReportQuery {
+	var req timeReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return nil
+	}
+
+	if req.Timezone == "" {
+		req.Timezone = time.UTC.String()
+	}
+	loc, err := time.LoadLocation(req.Timezone)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return nil
+	}
+
+	from, err := time.ParseInLocation(DateLayout, req.From, loc)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return nil
+	}
+
+	to, err := time.ParseInLocation(DateLayout, req.To, loc)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return nil
+	}
+
+	if to.Before(from) {
+		NewHTTPError(ctx, http.StatusBadRequest, timeReportInvalidRangeError)
+		return nil
+	}
+
+	reportQuery := &timeReportQuery{
+		timezone:      req.Timezone,
+		startedFrom:   from,
+		startedBefore: to.AddDate(0, 0, 1),
+		format:        req.Format,
+	}
+	if req.UserID != "" {
+		reportQuery.userID = &req.UserID
+	}
+
+	return reportQuery
+}
+
+// writeCSV writes the records as a CSV file download
+func writeCSV(ctx *gin.Context, filename string, records [][]string) {
+	ctx.Header("Content-Type", CSVContentType)
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	ctx.Status(http.StatusOK)
+
+	// The status is already sent; a failed write can only cut the file short
+	_ = csv.NewWriter(ctx.Writer).WriteAll(records)
+}
+
+// formatHours formats the seconds as hours with two decimals, the way time is billed
+func formatHours(seconds int64) string {
+	return strconv.FormatFloat(float64(seconds)/3600, 'f', 2, 64)
+}
+
+// reportTimeByTodo godoc
+//
+//	@Summary		Reports the time tracked per todo
+//	@Description	Sums the time tracked on each todo by the entries started between the first and last day, inclusive, in the timezone; running timers count until now
+//	@Tags			time tracking
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			from		query	string	true	"First day"	Format(date)
+//	@Param			to			query	string	true	"Last day"	Format(date)
+//	@Param			timezone	query	string	false	"IANA timezone of the days; defaults to UTC"
+//	@Param			userId		query	string	false	"Only count the time tracked by the user"
+//	@Param			format		query	string	false	"Response format"	Enums(json, csv)
+//	@Success		200			{array}	db.ReportTimeByTodoRow
+//	@Failure		400
+//	@Failure		500
+//	@Router			/reports/time/todos [get]
+func (server *Server) reportTimeByTodo(ctx *gin.Context) {
+	reportQuery := bindTimeReportRequestAndHandleErrors(ctx)
+	if reportQuery == nil {
+		return
+	}
+
+	rows, err := server.store.ReportTimeByTodo(ctx, db.ReportTimeByTodoParams{
+		StartedFrom:   reportQuery.startedFrom,
+		StartedBefore: reportQuery.startedBefore,
+		UserID:        reportQuery.userID,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	if reportQuery.format == ReportFormatCSV {
+		records := [][]string{{"todoId", "title", "seconds", "hours"}}
+		for _, row := range rows {
+			records = append(records, []string{
+				strconv.FormatInt(row.TodoID, 10),
+				row.Title,
+				strconv.FormatInt(row.Seconds, 10),
+				formatHours(row.Seconds),
+			})
+		}
+
+		writeCSV(ctx, "time-by-todo.csv", records)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rows)
+}
+
+// reportTimeByProject godoc
+//
+//	@Summary		Reports the time tracked per project
+//	@Description	Sums the time tracked on the todos of each project by the entries started between the first and last day, inclusive, in the timezone; todos outside of any project are reported without project
+//	@Tags			time tracking
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			from		query	string	true	"First day"	Format(date)
+//	@Param			to			query	string	true	"Last day"	Format(date)
+//	@Param			timezone	query	string	false	"IANA timezone of the days; defaults to UTC"
+//	@Param			userId		query	string	false	"Only count the time tracked by the user"
+//	@Param			format		query	string	false	"Response format"	Enums(json, csv)
+//	@Success		200			{array}	db.ReportTimeByProjectRow
+//	@Failure		400
+//	@Failure		500
+//	@Router			/reports/time/projects [get]
+func (server *Server) reportTimeByProject(ctx *gin.Context) {
+	reportQuery := bindTimeReportRequestAndHandleErrors(ctx)
+	if reportQuery == nil {
+		return
+	}
+
+	rows, err := server.store.ReportTimeByProject(ctx, db.ReportTimeByProjectParams{
+		StartedFrom:   reportQuery.startedFrom,
+		StartedBefore: reportQuery.startedBefore,
+		UserID:        reportQuery.userID,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	if reportQuery.format == ReportFormatCSV {
+		records := [][]string{{"projectId", "name", "seconds", "hours"}}
+		for _, row := range rows {
+			var projectID, name string
+			if row.ProjectID != nil {
+				projectID = strconv.FormatInt(*row.ProjectID, 10)
+			}
+			if row.Name != nil {
+				name = *row.Name
+			}
+
+			records = append(records, []string{
+				projectID,
+				name,
+				strconv.FormatInt(row.Seconds, 10),
+				formatHours(row.Seconds),
+			})
+		}
+
+		writeCSV(ctx, "time-by-project.csv", records)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rows)
+}
+
+// reportTimeByDay godoc
+//
+//	@Summary		Reports the time tracked per day
+//	@Description	Sums the time tracked each day between the first and last day, inclusive, in the timezone; an entry counts toward the day it started and days without tracked time are left out
+//	@Tags			time tracking
+//	@Produce		json
+//	@Produce		text/csv
+//	@Param			from		query	string	true	"First day"	Format(date)
+//	@Param			to			query	string	true	"Last day"	Format(date)
+//	@Param			timezone	query	string	false	"IANA timezone of the days; defaults to UTC"
+//	@Param			userId		query	string	false	"Only count the time tracked by the user"
+//	@Param			format		query	string	false	"Response format"	Enums(json, csv)
+//	@Success		200			{array}	db.ReportTimeByDayRow
+//	@Failure		400
+//	@Failure		500
+//	@Router			/reports/time/days [get]
+func (server *Server) reportTimeByDay(ctx *gin.Context) {
+	reportQuery := bindTimeReportRequestAndHandleErrors(ctx)
+	if reportQuery == nil {
+		return
+	}
+
+	rows, err := server.store.ReportTimeByDay(ctx, db.ReportTimeByDayParams{
+		Timezone:      reportQuery.timezone,
+		StartedFrom:   reportQuery.startedFrom,
+		StartedBefore: reportQuery.startedBefore,
+		UserID:        reportQuery.userID,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	if reportQuery.format == ReportFormatCSV {
+		records := [][]string{{"day", "seconds", "hours"}}
+		for _, row := range rows {
+			records = append(records, []string{
+				row.Day,
+				strconv.FormatInt(row.Seconds, 10),
+				formatHours(row.Seconds),
+			})
+		}
+
+		writeCSV(ctx, "time-by-day.csv", records)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rows)
+}
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportTimeByTodoAPI(t *testing.T) {
+	userID := RandomUserID()
+	rows := []db.ReportTimeByTodoRow{
+		{TodoID: util.RandomInt(1, 1000), Title: "Audit, phase \"one\"", Seconds: 5400},
+	}
+
+	tcs := []struct {
+		name               string
+		query              string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:  "OK",
+			query: "from=2024-06-01&to=2024-06-30&userId=" + userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ReportTimeByTodoParams{
+					StartedFrom:   time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+					StartedBefore: time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC),
+					UserID:        &userID,
+				}
+				store.EXPECT().ReportTimeByTodo(gomock.Any(), gomock.Eq(arg)).Times(1).Return(rows, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var gotRows []db.ReportTimeByTodoRow
+				err := json.Unmarshal(recorder.Body.Bytes(), &gotRows)
+				assert.NoError(t, err)
+				assert.Equal(t, rows, gotRows)
+			},
+		},
+		{
+			name:  "OKCSVInTimezone",
+			query: "from=2024-06-01&to=2024-06-01&timezone=Asia/Kolkata&format=csv",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ReportTimeByTodo(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.ReportTimeByTodoParams) ([]db.ReportTimeByTodoRow, error) {
+						// Days start at midnight in the timezone
+						assert.True(t, arg.StartedFrom.Equal(time.Date(2024, time.May, 31, 18, 30, 0, 0, time.UTC)))
+						assert.True(t, arg.StartedBefore.Equal(time.Date(2024, time.June, 1, 18, 30, 0, 0, time.UTC)))
+						assert.Nil(t, arg.UserID)
+						return rows, nil
+					})
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.Equal(t, CSVContentType, recorder.Header().Get("Content-Type"))
+				expected := "todoId,title,seconds,hours\n" +
+					strconv.FormatInt(rows[0].TodoID, 10) + ",\"Audit, phase \"\"one\"\"\",5400,1.50\n"
+				assert.Equal(t, expected, recorder.Body.String())
+			},
+		},
+		{
+			name:  "ToBeforeFrom",
+			query: "from=2024-06-30&to=2024-06-01",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().ReportTimeByTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: timeReportInvalidRangeError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:  "InvalidDate",
+			query: "from=06/01/2024&to=2024-06-30",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().ReportTimeByTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/reports/time/todos?"+tc.query, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestReportTimeByProjectCSVAPI(t *testing.T) {
+	projectID := util.RandomInt(1, 1000)
+	// The comma makes the name quoted
+	name := "Launch, phase " + strconv.FormatInt(util.RandomInt(1, 9), 10)
+	rows := []db.ReportTimeByProjectRow{
+		{ProjectID: &projectID, Name: &name, Seconds: 7200},
+		{Seconds: 900},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ReportTimeByProject(gomock.Any(), gomock.Any()).Times(1).Return(rows, nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/reports/time/projects?from=2024-06-01&to=2024-06-30&format=csv", nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	// Todos outside of any project are reported with empty project columns
+	expected := "projectId,name,seconds,hours\n" +
+		strconv.FormatInt(projectID, 10) + ",\"" + name + "\",7200,2.00\n" +
+		",,900,0.25\n"
+	assert.Equal(t, expected, recorder.Body.String())
+}
+
+func TestReportTimeByDayAPI(t *testing.T) {
+	rows := []db.ReportTimeByDayRow{
+		{Day: "2024-06-03", Seconds: 3600},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	arg := db.ReportTimeByDayParams{
+		Timezone:      "UTC",
+		StartedFrom:   time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		StartedBefore: time.Date(2024, time.June, 8, 0, 0, 0, 0, time.UTC),
+	}
+	store.EXPECT().ReportTimeByDay(gomock.Any(), gomock.Eq(arg)).Times(1).Return(rows, nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/reports/time/days?from=2024-06-01&to=2024-06-07", nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var gotRows []db.ReportTimeByDayRow
+	err = json.Unmarshal(recorder.Body.Bytes(), &gotRows)
+	assert.NoError(t, err)
+	assert.Equal(t, rows, gotRows)
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type listTodoRevisionsRequest struct {
+	getTodoRequest
+}
+
+// listTodoRevisions godoc
+//
+//	@Summary		List revisions of a todo
+//	@Description	List every change of the todo fields and attachments, newest first, with the values before and after the change
+//	@Tags			revisions
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	db.TodoRevision
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/revisions [get]
+func (server *Server) listTodoRevisions(ctx *gin.Context) {
+	var req listTodoRevisionsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	revisions, err := server.store.ListTodoRevisions(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revisions)
+}
+
+type revertTodoRevisionRequest struct {
+	getTodoRequest
+	RevisionID int64 `uri:"revisionId" binding:"required,min=1"`
+}
+
+// revertTodoRevision godoc
+//
+//	@Summary		Reverts a revision of a todo
+//	@Description	Restores the fields changed by the revision to their values before the change, recording the revert as a new revision. A restored status must still be reachable in the todo's workflow; attachment revisions can't be reverted
+//	@Tags			revisions
+//	@Produce		json
+//	@Param			todoId		path		int	true	"Todo ID"		minimum(1)
+//	@Param			revisionId	path		int	true	"Revision ID"	minimum(1)
+//	@Success		200			{object}	todoResponse
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/todos/{todoId}/revisions/{revisionId}/revert [post]
+func (server *Server) revertTodoRevision(ctx *gin.Context) {
+	var req revertTodoRevisionRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	revision, err := server.store.GetTodoRevision(ctx, req.RevisionID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceRevision,
+				id:           req.RevisionID,
+			})
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	if revision.TodoID != req.TodoID {
+		NewHTTPError(ctx, http.StatusForbidden, newRevisionNotAssociatedWithTodoError(req.TodoID, req.RevisionID))
+		return
+	}
+
+	result, err := server.store.RevertTodoRevisionTx(ctx, db.RevertTodoRevisionTxParams{
+		RevisionID:              req.RevisionID,
+		RequireCompleteSubtasks: server.config.RequireCompleteSubtasks,
+		Storage:                 server.storage,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrTodoRevisionNotRevertible) {
+			NewHTTPError(ctx, http.StatusConflict, todoRevisionNotRevertibleError)
+			return
+		}
+
+		if errors.Is(err, db.ErrUnknownTodoStatus) || errors.Is(err, db.ErrTodoTransitionNotAllowed) {
+			NewHTTPError(ctx, http.StatusConflict, revertTodoStatusNotAllowedError)
+			return
+		}
+
+		if errors.Is(err, db.ErrTodoHasIncompleteSubtasks) {
+			NewHTTPError(ctx, http.StatusConflict, incompleteSubtasksError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
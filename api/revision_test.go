@@ -0,0 +1,188 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomTitleRevisionOfTodo(todo db.Todo) db.TodoRevision {
+	return db.TodoRevision{
+		ID:     util.RandomInt(1, 1000),
+		TodoID: todo.ID,
+		Changes: db.TodoRevisionChanges{
+			db.RevisionFieldTitle: {
+				Before: json.RawMessage(`"` + util.RandomString(10) + `"`),
+				After:  json.RawMessage(`"` + todo.Title + `"`),
+			},
+		},
+	}
+}
+
+func TestListTodoRevisionsAPI(t *testing.T) {
+	todo := RandomTodo()
+	revisions := []db.TodoRevision{RandomTitleRevisionOfTodo(todo)}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	store.EXPECT().ListTodoRevisions(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(revisions, nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/revisions", todo.ID)
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	assert.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var gotRevisions []db.TodoRevision
+	err = json.Unmarshal(recorder.Body.Bytes(), &gotRevisions)
+	assert.NoError(t, err)
+	assert.Equal(t, revisions, gotRevisions)
+}
+
+func TestRevertTodoRevisionAPI(t *testing.T) {
+	todo := RandomTodo()
+	revision := RandomTitleRevisionOfTodo(todo)
+	otherRevision := RandomTitleRevisionOfTodo(todo)
+	otherRevision.TodoID = todo.ID + 1
+
+	tcs := []struct {
+		name               string
+		revisionID         int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:       "OK",
+			revisionID: revision.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetTodoRevision(gomock.Any(), gomock.Eq(revision.ID)).Times(1).Return(revision, nil)
+				arg := db.RevertTodoRevisionTxParams{
+					RevisionID: revision.ID,
+				}
+				store.EXPECT().
+					RevertTodoRevisionTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name:       "RevisionOfOtherTodo",
+			revisionID: otherRevision.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetTodoRevision(gomock.Any(), gomock.Eq(otherRevision.ID)).Times(1).Return(otherRevision, nil)
+				store.EXPECT().RevertTodoRevisionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newRevisionNotAssociatedWithTodoError(todo.ID, otherRevision.ID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:       "RevisionNotFound",
+			revisionID: revision.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetTodoRevision(gomock.Any(), gomock.Eq(revision.ID)).Times(1).Return(db.TodoRevision{}, db.ErrRecordNotFound)
+				store.EXPECT().RevertTodoRevisionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceRevision,
+				id:           revision.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:       "NotRevertible",
+			revisionID: revision.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetTodoRevision(gomock.Any(), gomock.Eq(revision.ID)).Times(1).Return(revision, nil)
+				store.EXPECT().
+					RevertTodoRevisionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateTodoTxResult{}, db.ErrTodoRevisionNotRevertible)
+			},
+			errorExpected: true,
+			expectedError: todoRevisionNotRevertibleError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:       "StatusNotAllowed",
+			revisionID: revision.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetTodoRevision(gomock.Any(), gomock.Eq(revision.ID)).Times(1).Return(revision, nil)
+				store.EXPECT().
+					RevertTodoRevisionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateTodoTxResult{}, db.ErrTodoTransitionNotAllowed)
+			},
+			errorExpected: true,
+			expectedError: revertTodoStatusNotAllowedError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/revisions/%d/revert", todo.ID, tc.revisionID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type searchTodoRequest struct {
+	Query    string `form:"q" binding:"required,max=255"`
+	Language string `form:"language" binding:"max=32"`
+	Prefix   *bool  `form:"prefix"`
+	listTodoRequest
+}
+
+// searchTodoResult is a todo matching the search along with its relevance and its highlighted title and description
+type searchTodoResult struct {
+	todoResponse
+	Rank       float32          `json:"rank"`
+	Highlights searchHighlights `json:"highlights"`
+}
+
+// searchHighlights are the HTML escaped title and description snippets of a todo with the matches wrapped in <mark> tags
+type searchHighlights struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// searchTodoPageResponse is a page of search results along with the cursors of its neighbouring pages
+type searchTodoPageResponse struct {
+	Results    []searchTodoResult `json:"results"`
+	NextCursor *string            `json:"nextCursor"`
+	PrevCursor *string            `json:"prevCursor"`
+	TotalCount *int64             `json:"totalCount,omitempty"`
+}
+
+// searchLanguage is the configured default search language
+func (server *Server) searchLanguage() string {
+	if server.config.SearchLanguage != "" {
+		return server.config.SearchLanguage
+	}
+
+	return DefaultSearchLanguage
+}
+
+// searchTodo godoc
+//
+//	@Summary		Search todos
+//	@Description	Full-text search over the titles and descriptions of the todos, titles weighing more, in one of the search languages: simple, english, french, german, spanish. By default each word of the query matches the words starting with it; without 'prefix', the query follows the web search syntax with quoted phrases, 'or' and '-'.
+//	@Description	The results come most relevant first unless sorted otherwise, 'rank' being a sort field too, with their title and description snippets highlighted by <mark> tags. They take the same filters and paging as the todo list
+//	@Tags			todos
+//	@Produce		json
+//
+//	@Param			q				query	string		true	"search query"	maxlength(255)
+//	@Param			language		query	string		false	"search language; the configured one by default"	Enums(simple, english, french, german, spanish)
+//	@Param			prefix			query	bool		false	"prefix matching of the words of the query"	default(true)
+//	@Param			pageId			query	int			false	"page ID; pages by offset"		minimum(1)
+//	@Param			pageSize		query	int			false	"page size; 5 to 10 when paging by offset, up to the configured maximum page size otherwise"	minimum(1)	default(20)
+//	@Param			cursor			query	string		false	"cursor of the page, taken from the previous page"
+//	@Param			withTotal		query	bool		false	"total count of the results in the response and the X-Total-Count header"	default(false)
+//	@Param			tags			query	[]int		false	"tag IDs"						collectionFormat(multi)
+//	@Param			tagMatch		query	string		false	"match any or all of the tags"	Enums(any, all)	default(any)
+//	@Param			overdue			query	bool		false	"overdue todos only if true, not overdue todos only if false"
+//	@Param			archived		query	bool		false	"archived todos instead of the active ones"	default(false)
+//	@Param			assignee		query	string		false	"todos assigned to the user; 'me' stands for the user identified by the X-User-ID header"
+//	@Param			watching		query	bool		false	"todos watched by the user identified by the X-User-ID header only"	default(false)
+//	@Param			status			query	[]string	false	"statuses"															collectionFormat(multi)
+//	@Param			createdFrom		query	string		false	"todos created at or after the time"								format(date-time)
+//	@Param			createdTo		query	string		false	"todos created before the time"										format(date-time)
+//	@Param			updatedFrom		query	string		false	"todos updated at or after the time"								format(date-time)
+//	@Param			updatedTo		query	string		false	"todos updated before the time"										format(date-time)
+//	@Param			title			query	string		false	"case insensitive title substring"									maxlength(255)
+//	@Param			hasAttachments	query	bool		false	"todos with attachments only if true, without attachments only if false"
+//	@Param			minFileCount	query	int			false	"minimum number of attachments"	minimum(0)
+//	@Param			maxFileCount	query	int			false	"maximum number of attachments"	minimum(0)
+//	@Param			sort			query	string		false	"sort fields, such as '-rank,createdAt'"
+//	@Param			X-User-ID		header	string		false	"User ID"
+//
+//	@Success		200				{object}	searchTodoPageResponse
+//	@Header			200				{string}	Link			"first, prev and next pages"
+//	@Header			200				{integer}	X-Total-Count	"total count of the results with 'withTotal'"
+//	@Failure		400
+//	@Failure		500
+//	@Router			/todos/search [get]
+func (server *Server) searchTodo(ctx *gin.Context) {
+	var req searchTodoRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// 'q' is the search query here rather than filters in the query language of the todo list
+	req.listTodoRequest.Query = ""
+
+	if req.Language == "" {
+		req.Language = server.searchLanguage()
+	}
+	if !db.IsSearchLanguage(req.Language) {
+		NewHTTPError(ctx, http.StatusBadRequest, newUnknownSearchLanguageError(req.Language))
+		return
+	}
+
+	listArg := server.listTodosParamsAndHandleErrors(ctx, req.listTodoRequest, db.TodoSearchSortFields, []db.TodoSort{
+		{Field: db.TodoSortFieldRank, Descending: true},
+	})
+	if listArg == nil {
+		return
+	}
+
+	arg := db.SearchTodosParams{
+		ListTodosParams: *listArg,
+		Query:           req.Query,
+		Language:        req.Language,
+		Prefix:          req.Prefix == nil || *req.Prefix,
+	}
+
+	var totalCount *int64
+	if req.WithTotal {
+		count, err := server.store.CountSearchTodos(ctx, arg)
+		if err != nil {
+			NewHTTPError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		totalCount = setTotalCountHeader(ctx, count)
+	}
+
+	rows, err := server.store.SearchTodos(ctx, arg)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	var nextCursor, prevCursor *string
+	if req.PageID == 0 {
+		rows, nextCursor, prevCursor = pageRows(ctx, rows, *listArg, func(row db.SearchTodosRow, backward bool) string {
+			return encodeTodoCursor(db.NewTodoSearchCursor(row, listArg.Sort, backward), listArg.Sort)
+		})
+	}
+
+	results := server.buildSearchResultsAndHandleErrors(ctx, rows)
+	if results == nil {
+		return
+	}
+
+	// Paging by offset returns the results alone, as the todo list does
+	if req.PageID != 0 {
+		ctx.JSON(http.StatusOK, results)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, searchTodoPageResponse{
+		Results:    results,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+		TotalCount: totalCount,
+	})
+}
+
+// buildSearchResultsAndHandleErrors builds the responses of the todos matching the search along with their rank and
+// highlights; writes the error response and returns nil on failure
+func (server *Server) buildSearchResultsAndHandleErrors(ctx *gin.Context, rows []db.SearchTodosRow) []searchTodoResult {
+	todos := make([]db.Todo, 0, len(rows))
+	for _, row := range rows {
+		todos = append(todos, row.Todo)
+	}
+
+	resp := server.buildTodoResponsesAndHandleErrors(ctx, todos)
+	if resp == nil {
+		return nil
+	}
+
+	results := make([]searchTodoResult, 0, len(rows))
+	for i, row := range rows {
+		results = append(results, searchTodoResult{
+			todoResponse: resp[i],
+			Rank:         row.Rank,
+			Highlights: searchHighlights{
+				Title:       highlightHTML(row.TitleHighlight),
+				Description: highlightHTML(row.DescriptionHighlight),
+			},
+		})
+	}
+
+	return results
+}
+
+// highlightHTML escapes the highlighted snippet and wraps its matches in <mark> tags
+func highlightHTML(snippet string) string {
+	return strings.NewReplacer(db.HighlightStart, "<mark>", db.HighlightStop, "</mark>").Replace(html.EscapeString(snippet))
+}
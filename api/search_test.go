@@ -0,0 +1,209 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTodoAPI(t *testing.T) {
+	rows := make([]db.SearchTodosRow, 0)
+	todos := make([]db.Todo, 0)
+	for i := 0; i < 3; i++ {
+		todo := RandomTodo()
+		rows = append(rows, db.SearchTodosRow{
+			Todo:                 todo,
+			Rank:                 float32(3-i) / 10,
+			TitleHighlight:       db.HighlightStart + todo.Title + db.HighlightStop + " <b>",
+			DescriptionHighlight: "",
+		})
+		todos = append(todos, todo)
+	}
+
+	rankSort := []db.TodoSort{{Field: db.TodoSortFieldRank, Descending: true}}
+
+	type Results struct {
+		Results []struct {
+			db.Todo
+			Rank       float32          `json:"rank"`
+			Highlights searchHighlights `json:"highlights"`
+		} `json:"results"`
+		NextCursor *string `json:"nextCursor"`
+		PrevCursor *string `json:"prevCursor"`
+		TotalCount *int64  `json:"totalCount"`
+	}
+
+	tcs := []struct {
+		name               string
+		query              url.Values
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:  "OK",
+			query: url.Values{"q": {"quarterly rep"}, "pageSize": {"2"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.SearchTodosParams{
+					ListTodosParams: db.ListTodosParams{
+						Sort:  rankSort,
+						Limit: 3,
+					},
+					Query:    "quarterly rep",
+					Language: DefaultSearchLanguage,
+					Prefix:   true,
+				}
+
+				store.EXPECT().
+					SearchTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rows, nil)
+				expectTodoRollups(store, todos[:2]...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var results Results
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &results))
+				assert.Len(t, results.Results, 2)
+				for i, result := range results.Results {
+					assert.Equal(t, todos[i], result.Todo)
+					assert.Equal(t, rows[i].Rank, result.Rank)
+					assert.Equal(t, "<mark>"+todos[i].Title+"</mark> &lt;b&gt;", result.Highlights.Title)
+				}
+
+				nextCursor := encodeTodoCursor(db.NewTodoSearchCursor(rows[1], rankSort, false), rankSort)
+				assert.Equal(t, &nextCursor, results.NextCursor)
+				assert.Nil(t, results.PrevCursor)
+				assert.Contains(t, recorder.Header().Get(LinkHeader), `rel="next"`)
+			},
+		},
+		{
+			name:  "OKOffsetPagingWithTotal",
+			query: url.Values{"q": {`"quarterly report" -draft`}, "language": {"german"}, "prefix": {"false"}, "pageId": {"1"}, "pageSize": {"5"}, "withTotal": {"true"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.SearchTodosParams{
+					ListTodosParams: db.ListTodosParams{
+						Limit: 5,
+					},
+					Query:    `"quarterly report" -draft`,
+					Language: "german",
+				}
+
+				store.EXPECT().
+					CountSearchTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(int64(3), nil)
+				store.EXPECT().
+					SearchTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rows, nil)
+				expectTodoRollups(store, todos...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.Equal(t, "3", recorder.Header().Get(TotalCountHeader))
+
+				var results []searchTodoResult
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &results))
+				assert.Len(t, results, 3)
+			},
+		},
+		{
+			name:  "UnknownLanguage",
+			query: url.Values{"q": {"report"}, "language": {"klingon"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newUnknownSearchLanguageError("klingon"),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:  "UnknownSortField",
+			query: url.Values{"q": {"report"}, "sort": {"relevance"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newUnknownSortFieldError("relevance", db.TodoSearchSortFields),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:  "MissingQuery",
+			query: url.Values{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: url.Values{"q": {"report"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchTodos(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.SearchTodosRow{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := "/todos/search?" + tc.query.Encode()
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
@@ -4,10 +4,10 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-	"github.com/gin-gonic/gin/binding"
-	"github.com/go-playground/validator/v10"
 	db "github.com/jaingounchained/todo/db/sqlc"
+	notification "github.com/jaingounchained/todo/notification"
 	storage "github.com/jaingounchained/todo/storage"
+	"github.com/jaingounchained/todo/util"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"go.uber.org/zap"
@@ -15,20 +15,20 @@ import (
 
 // Server serves HTTP requests for todo service
 type Server struct {
-	store   db.Store
-	storage storage.Storage
-	router  *gin.Engine
+	config     util.Config
+	store      db.Store
+	storage    storage.Storage
+	dispatcher notification.Dispatcher
+	router     *gin.Engine
 }
 
 // NewGinHandler creates a new HTTP server and setup routing
-func NewGinHandler(store db.Store, storage storage.Storage, l *zap.Logger) *Server {
+func NewGinHandler(config util.Config, store db.Store, storage storage.Storage, dispatcher notification.Dispatcher, l *zap.Logger) *Server {
 	server := &Server{
-		store:   store,
-		storage: storage,
-	}
-
-	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-		v.RegisterValidation("todoStatus", validTodoStatus)
+		config:     config,
+		store:      store,
+		storage:    storage,
+		dispatcher: dispatcher,
 	}
 
 	server.setupRouter(l)
@@ -65,26 +65,168 @@ func (server *Server) setupSwagger(router *gin.Engine) {
 func (server *Server) setupGetResourceRouters(router *gin.Engine) {
 	// Get todo
 	router.GET("/todos", server.listTodo)
+	router.GET("/todos/search", server.searchTodo)
+	router.GET("/todos/similar", server.similarTodo)
 	router.GET("/todos/:todoId", server.getTodo)
+	router.GET("/todos/:todoId/tree", server.getTodoTree)
 
 	// TODO: Get todo attachment metadata
 	router.GET("/todos/:todoId/attachments", server.getTodoAttachmentMetadata)
 
 	// TODO: Get todo attachment
 	router.GET("/todos/:todoId/attachments/:attachmentId", server.getTodoAttachment)
+
+	// Get tags
+	router.GET("/tags", server.listTags)
+	router.GET("/tags/:tagId", server.getTag)
+	router.GET("/todos/:todoId/tags", server.listTodoTags)
+
+	// Get todo reminders
+	router.GET("/todos/:todoId/reminders", server.listTodoReminders)
+
+	// Get todo recurrence
+	router.GET("/todos/:todoId/recurrence", server.getTodoRecurrence)
+
+	// Get workflows, projects and todo status transitions
+	router.GET("/workflows", server.listWorkflows)
+	router.GET("/workflows/:workflowId", server.getWorkflow)
+	router.GET("/projects", server.listProjects)
+	router.GET("/projects/:projectId", server.getProject)
+	router.GET("/todos/:todoId/transitions", server.listTodoTransitions)
+
+	// Get todo blockers, todos blocked by todo
+	router.GET("/todos/:todoId/blockers", server.listTodoBlockers)
+	router.GET("/todos/:todoId/blocking", server.listTodosBlockedBy)
+
+	// Get todo comments
+	router.GET("/todos/:todoId/comments", server.listTodoComments)
+	router.GET("/todos/:todoId/comments/:commentId", server.getTodoComment)
+
+	// Get todo revisions
+	router.GET("/todos/:todoId/revisions", server.listTodoRevisions)
+
+	// Get todo checklist
+	router.GET("/todos/:todoId/checklist", server.listTodoChecklist)
+
+	// Get todo assignees, watchers
+	router.GET("/todos/:todoId/assignees", server.listTodoAssignees)
+	router.GET("/todos/:todoId/watchers", server.listTodoWatchers)
+
+	// Get todo time entries, time reports
+	router.GET("/todos/:todoId/time-entries", server.listTodoTimeEntries)
+	router.GET("/reports/time/todos", server.reportTimeByTodo)
+	router.GET("/reports/time/projects", server.reportTimeByProject)
+	router.GET("/reports/time/days", server.reportTimeByDay)
+
+	// Get trashed todos
+	router.GET("/trash", server.listTrash)
+
+	// Get templates
+	router.GET("/templates", server.listTemplates)
+	router.GET("/templates/:templateId", server.getTemplate)
+
+	// Get smart lists, todos of smart list
+	router.GET("/smart-lists", server.listSmartLists)
+	router.GET("/smart-lists/:smartListId", server.getSmartList)
+	router.GET("/smart-lists/:smartListId/todos", server.listSmartListTodos)
 }
 
 func (server *Server) setupCreateResourceRouters(router *gin.Engine) {
-	// Create todo
+	// Create todo, clone todo
 	router.POST("/todos", server.createTodo)
+	router.POST("/todos/:todoId/clone", server.cloneTodo)
 
 	// TODO: Create attachments
 	router.POST("/todos/:todoId/attachments", server.uploadTodoAttachments)
+
+	// Create tag, assign tags to todo
+	router.POST("/tags", server.createTag)
+	router.POST("/todos/:todoId/tags", server.addTodoTags)
+
+	// Create todo reminder
+	router.POST("/todos/:todoId/reminders", server.createTodoReminder)
+
+	// Create workflow, project
+	router.POST("/workflows", server.createWorkflow)
+	router.POST("/projects", server.createProject)
+
+	// Add todo blocker
+	router.POST("/todos/:todoId/blockers", server.addTodoBlocker)
+
+	// Comment on todo
+	router.POST("/todos/:todoId/comments", server.createTodoComment)
+
+	// Add checklist item, promote checklist item to subtask
+	router.POST("/todos/:todoId/checklist", server.createTodoChecklistItem)
+	router.POST("/todos/:todoId/checklist/:itemId/promote", server.promoteTodoChecklistItem)
+
+	// Start/stop todo timer, add manual time entry
+	router.POST("/todos/:todoId/timer/start", server.startTodoTimer)
+	router.POST("/todos/:todoId/timer/stop", server.stopTodoTimer)
+	router.POST("/todos/:todoId/time-entries", server.createTodoTimeEntry)
+
+	// Assign users to todo, watch/unwatch todo
+	router.POST("/todos/:todoId/assignees", server.addTodoAssignees)
+	router.POST("/todos/:todoId/watch", server.watchTodo)
+	router.POST("/todos/:todoId/unwatch", server.unwatchTodo)
+
+	// Create template, upload template attachments, instantiate template
+	router.POST("/templates", server.createTemplate)
+	router.POST("/templates/:templateId/attachments", server.uploadTemplateAttachments)
+	router.POST("/templates/:templateId/instantiate", server.instantiateTemplate)
+
+	// Create smart list
+	router.POST("/smart-lists", server.createSmartList)
 }
 
 func (server *Server) setupUpdateResourceRouters(router *gin.Engine) {
 	// Update todo title or status
 	router.PATCH("/todos/:todoId", server.updateTodoTitleStatus)
+
+	// Rename/recolor tag, merge tag into another
+	router.PATCH("/tags/:tagId", server.updateTag)
+	router.POST("/tags/:tagId/merge", server.mergeTag)
+
+	// Reorder todo, move todo under another parent
+	router.POST("/todos/:todoId/move", server.moveTodo)
+	router.PUT("/todos/:todoId/parent", server.setTodoParent)
+
+	// Make todo recurring, skip an occurrence
+	router.PUT("/todos/:todoId/recurrence", server.setTodoRecurrence)
+	router.POST("/todos/:todoId/recurrence/skip", server.skipTodoOccurrence)
+
+	// Replace workflow definition, rename project or switch its workflow
+	router.PUT("/workflows/:workflowId", server.updateWorkflow)
+	router.PATCH("/projects/:projectId", server.updateProject)
+
+	// Edit todo comment
+	router.PATCH("/todos/:todoId/comments/:commentId", server.updateTodoComment)
+
+	// Revert todo revision
+	router.POST("/todos/:todoId/revisions/:revisionId/revert", server.revertTodoRevision)
+
+	// Edit/toggle checklist item, reorder checklist
+	router.PATCH("/todos/:todoId/checklist/:itemId", server.updateTodoChecklistItem)
+	router.PUT("/todos/:todoId/checklist/order", server.reorderTodoChecklist)
+
+	// Edit time entry
+	router.PATCH("/todos/:todoId/time-entries/:timeEntryId", server.updateTodoTimeEntry)
+
+	// Merge todo into another one
+	router.POST("/todos/:todoId/merge", server.mergeTodo)
+
+	// Restore trashed todo
+	router.POST("/todos/:todoId/restore", server.restoreTodo)
+
+	// Archive, unarchive todo
+	router.POST("/todos/:todoId/archive", server.archiveTodo)
+	router.POST("/todos/:todoId/unarchive", server.unarchiveTodo)
+
+	// Update template
+	router.PATCH("/templates/:templateId", server.updateTemplate)
+
+	// Rename, requery, pin/unpin smart list
+	router.PATCH("/smart-lists/:smartListId", server.updateSmartList)
 }
 
 func (server *Server) setupDeleteResourceRouters(router *gin.Engine) {
@@ -93,6 +235,42 @@ func (server *Server) setupDeleteResourceRouters(router *gin.Engine) {
 
 	// Delete todo
 	router.DELETE("/todos/:todoId", server.deleteTodo)
+
+	// Delete tag, remove tag from todo
+	router.DELETE("/tags/:tagId", server.deleteTag)
+	router.DELETE("/todos/:todoId/tags/:tagId", server.removeTodoTag)
+
+	// Delete todo reminder
+	router.DELETE("/todos/:todoId/reminders/:reminderId", server.deleteTodoReminder)
+
+	// End todo series
+	router.DELETE("/todos/:todoId/recurrence", server.deleteTodoRecurrence)
+
+	// Delete workflow, project
+	router.DELETE("/workflows/:workflowId", server.deleteWorkflow)
+	router.DELETE("/projects/:projectId", server.deleteProject)
+
+	// Remove todo blocker
+	router.DELETE("/todos/:todoId/blockers/:blockerId", server.removeTodoBlocker)
+
+	// Delete todo comment
+	router.DELETE("/todos/:todoId/comments/:commentId", server.deleteTodoComment)
+
+	// Delete checklist item
+	router.DELETE("/todos/:todoId/checklist/:itemId", server.deleteTodoChecklistItem)
+
+	// Delete time entry
+	router.DELETE("/todos/:todoId/time-entries/:timeEntryId", server.deleteTodoTimeEntry)
+
+	// Unassign user from todo
+	router.DELETE("/todos/:todoId/assignees/:userId", server.removeTodoAssignee)
+
+	// Delete template, template attachment
+	router.DELETE("/templates/:templateId", server.deleteTemplate)
+	router.DELETE("/templates/:templateId/attachments/:attachmentId", server.deleteTemplateAttachment)
+
+	// Delete smart list
+	router.DELETE("/smart-lists/:smartListId", server.deleteSmartList)
 }
 
 // Start runs the HTTP server on a specific address
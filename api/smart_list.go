@@ -0,0 +1,431 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+// smartListQueryKeys are the query parameters a smart list can save: the filter and sort parameters of the todo list
+var smartListQueryKeys = formKeys(reflect.TypeOf(listTodoQuery{}))
+
+// formKeys lists the form keys of the fields of the struct, those of its embedded structs included
+func formKeys(t reflect.Type) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			keys = append(keys, formKeys(field.Type)...)
+			continue
+		}
+
+		if key := field.Tag.Get("form"); key != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// parseSmartListQuery parses the saved query of a smart list and validates it against the current filters of the
+// todo list, the way the todo list validates its query parameters
+func parseSmartListQuery(query string) (listTodoQuery, error) {
+	var q listTodoQuery
+
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return q, smartListQueryInvalidError
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !slices.Contains(smartListQueryKeys, key) {
+			return q, newUnknownSmartListParameterError(key)
+		}
+	}
+
+	if err := binding.MapFormWithTag(&q, values, "form"); err != nil {
+		return q, err
+	}
+	if err := binding.Validator.ValidateStruct(&q); err != nil {
+		return q, err
+	}
+	if err := q.listTodoFilters.apply(&db.ListTodosParams{}, db.TodoSortFields); err != nil {
+		return q, err
+	}
+
+	return q, nil
+}
+
+// smartListResponse is a smart list along with whether its query still fits the filters of the todo list
+type smartListResponse struct {
+	db.SmartList
+	Valid   bool   `json:"valid"`
+	Problem string `json:"problem,omitempty"`
+}
+
+func newSmartListResponse(smartList db.SmartList) smartListResponse {
+	resp := smartListResponse{
+		SmartList: smartList,
+		Valid:     true,
+	}
+	if _, err := parseSmartListQuery(smartList.Query); err != nil {
+		resp.Valid = false
+		resp.Problem = err.Error()
+	}
+
+	return resp
+}
+
+type getSmartListRequest struct {
+	SmartListID int64 `uri:"smartListId" binding:"required,min=1"`
+}
+
+// getSmartList godoc
+//
+//	@Summary		Returns a smart list
+//	@Description	Get smart list saved by the user by SmartListID, along with whether its query still fits the filters of the todo list
+//	@Tags			smart lists
+//	@Produce		json
+//	@Param			smartListId	path		int		true	"Smart list ID"	minimum(1)
+//	@Param			X-User-ID	header		string	true	"User ID"
+//	@Success		200			{object}	smartListResponse
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/smart-lists/{smartListId} [get]
+func (server *Server) getSmartList(ctx *gin.Context) {
+	var req getSmartListRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, smartListIDInvalidError)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	smartList := server.fetchSmartListAndHandleErrors(ctx, req.SmartListID, userID)
+	if smartList == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSmartListResponse(*smartList))
+}
+
+// listSmartLists godoc
+//
+//	@Summary		List smart lists
+//	@Description	List the smart lists saved by the user, pinned ones first, then by name, along with whether their query still fits the filters of the todo list
+//	@Tags			smart lists
+//	@Produce		json
+//	@Param			X-User-ID	header	string	true	"User ID"
+//	@Success		200			{array}	smartListResponse
+//	@Failure		400
+//	@Failure		500
+//	@Router			/smart-lists [get]
+func (server *Server) listSmartLists(ctx *gin.Context) {
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	smartLists, err := server.store.ListSmartLists(ctx, userID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := make([]smartListResponse, 0, len(smartLists))
+	for _, smartList := range smartLists {
+		resp = append(resp, newSmartListResponse(smartList))
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type createSmartListRequest struct {
+	Name   string `json:"name" binding:"required,max=64"`
+	Query  string `json:"query" binding:"required,max=2048"`
+	Pinned bool   `json:"pinned"`
+}
+
+// createSmartList godoc
+//
+//	@Summary		Creates a smart list
+//	@Description	Saves filter and sort parameters of the todo list under a name for the user, as a URL query string such as 'overdue=true&hasAttachments=true&sort=-createdAt'; paging parameters aren't saved
+//	@Tags			smart lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			smartList	body		createSmartListRequest	true	"Smart list name/query/pinned"
+//	@Param			X-User-ID	header		string					true	"User ID"
+//	@Success		200			{object}	smartListResponse
+//	@Failure		400
+//	@Failure		409
+//	@Failure		500
+//	@Router			/smart-lists [post]
+func (server *Server) createSmartList(ctx *gin.Context) {
+	var req createSmartListRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	if _, err := parseSmartListQuery(req.Query); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	smartList, err := server.store.CreateSmartList(ctx, db.CreateSmartListParams{
+		UserID: userID,
+		Name:   req.Name,
+		Query:  req.Query,
+		Pinned: req.Pinned,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, newSmartListNameAlreadyExistError(req.Name))
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSmartListResponse(smartList))
+}
+
+type updateSmartListRequestURIParams struct {
+	getSmartListRequest
+}
+
+type updateSmartListRequestBody struct {
+	Name   *string `json:"name" binding:"omitempty,max=64"`
+	Query  *string `json:"query" binding:"omitempty,max=2048"`
+	Pinned *bool   `json:"pinned"`
+}
+
+// updateSmartList godoc
+//
+//	@Summary		Updates a smart list
+//	@Description	Renames a smart list, replaces its query or pins and unpins it
+//	@Tags			smart lists
+//	@Accept			json
+//	@Produce		json
+//	@Param			smartListId	path		int							true	"Smart list ID"	minimum(1)
+//	@Param			smartList	body		updateSmartListRequestBody	true	"Smart list name/query/pinned"
+//	@Param			X-User-ID	header		string						true	"User ID"
+//	@Success		200			{object}	smartListResponse
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/smart-lists/{smartListId} [patch]
+func (server *Server) updateSmartList(ctx *gin.Context) {
+	var reqURIParams updateSmartListRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, smartListIDInvalidError)
+		return
+	}
+
+	var reqBody updateSmartListRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// Update at least one of name, query or pinned
+	if reqBody.Name == nil && reqBody.Query == nil && reqBody.Pinned == nil {
+		NewHTTPError(ctx, http.StatusBadRequest, updateSmartListInvalidBodyError)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	if reqBody.Query != nil {
+		if _, err := parseSmartListQuery(*reqBody.Query); err != nil {
+			NewHTTPError(ctx, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	smartList := server.fetchSmartListAndHandleErrors(ctx, reqURIParams.SmartListID, userID)
+	if smartList == nil {
+		return
+	}
+
+	updatedSmartList, err := server.store.UpdateSmartList(ctx, db.UpdateSmartListParams{
+		ID:     smartList.ID,
+		Name:   reqBody.Name,
+		Query:  reqBody.Query,
+		Pinned: reqBody.Pinned,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, newSmartListNameAlreadyExistError(*reqBody.Name))
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSmartListResponse(updatedSmartList))
+}
+
+type deleteSmartListRequest struct {
+	getSmartListRequest
+}
+
+// deleteSmartList godoc
+//
+//	@Summary		Deletes a smart list
+//	@Description	Delete smart list saved by the user by SmartListID
+//	@Tags			smart lists
+//	@Param			smartListId	path	int		true	"Smart list ID"	minimum(1)
+//	@Param			X-User-ID	header	string	true	"User ID"
+//	@Success		200
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/smart-lists/{smartListId} [delete]
+func (server *Server) deleteSmartList(ctx *gin.Context) {
+	var req deleteSmartListRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, smartListIDInvalidError)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	smartList := server.fetchSmartListAndHandleErrors(ctx, req.SmartListID, userID)
+	if smartList == nil {
+		return
+	}
+
+	if err := server.store.DeleteSmartList(ctx, smartList.ID); err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type listSmartListTodosRequest struct {
+	getSmartListRequest
+}
+
+// listSmartListTodos godoc
+//
+//	@Summary		List the todos of a smart list
+//	@Description	Lists the todos matching the saved query of the smart list, evaluated live, with the paging of the todo list.
+//	@Description	A smart list whose query no longer fits the filters of the todo list can't be listed until its query is updated
+//	@Tags			smart lists
+//	@Produce		json
+//	@Param			smartListId	path		int		true	"Smart list ID"	minimum(1)
+//	@Param			pageId		query		int		false	"page ID; pages by offset"		minimum(1)
+//	@Param			pageSize	query		int		false	"page size; 5 to 10 when paging by offset, up to the configured maximum page size otherwise"	minimum(1)	default(20)
+//	@Param			cursor		query		string	false	"cursor of the page, taken from the previous page"
+//	@Param			withTotal	query		bool	false	"total count of the todos in the response and the X-Total-Count header"	default(false)
+//	@Param			fields		query		string	false	"comma separated fields of the todos to return, such as 'title,status'; todoId is always returned"
+//	@Param			include		query		string	false	"comma separated relations to embed, loaded in a single query for the whole page"	Enums(attachments)
+//	@Param			X-User-ID	header		string	true	"User ID"
+//	@Success		200			{object}	listTodoPageResponse{todos=[]todoResponse}
+//	@Header			200			{string}	Link			"first, prev and next pages"
+//	@Header			200			{integer}	X-Total-Count	"total count of the todos with 'withTotal'"
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		422
+//	@Failure		500
+//	@Router			/smart-lists/{smartListId}/todos [get]
+func (server *Server) listSmartListTodos(ctx *gin.Context) {
+	var req listSmartListTodosRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, smartListIDInvalidError)
+		return
+	}
+
+	var paging listTodoPaging
+	if err := ctx.ShouldBindQuery(&paging); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	var options todoResponseOptions
+	if err := ctx.ShouldBindQuery(&options); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	smartList := server.fetchSmartListAndHandleErrors(ctx, req.SmartListID, userID)
+	if smartList == nil {
+		return
+	}
+
+	query, err := parseSmartListQuery(smartList.Query)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusUnprocessableEntity, newSmartListOutdatedError(smartList.Name, err))
+		return
+	}
+
+	server.listTodoPage(ctx, listTodoRequest{
+		listTodoPaging: paging,
+		listTodoQuery:  query,
+	}, options)
+}
+
+// fetchSmartListAndHandleErrors fetches the smart list, making sure it was saved by the user
+func (server *Server) fetchSmartListAndHandleErrors(ctx *gin.Context, smartListID int64, userID string) *db.SmartList {
+	smartList, err := server.store.GetSmartList(ctx, smartListID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceSmartList,
+				id:           smartListID,
+			})
+			return nil
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	if smartList.UserID != userID {
+		NewHTTPError(ctx, http.StatusForbidden, newSmartListOfOtherUserError(smartListID))
+		return nil
+	}
+
+	return &smartList
+}
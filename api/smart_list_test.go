@@ -0,0 +1,537 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomSmartListOfUser(userID string) db.SmartList {
+	return db.SmartList{
+		ID:     util.RandomInt(1, 1000),
+		UserID: userID,
+		Name:   util.RandomString(10),
+		Query:  "overdue=true&hasAttachments=true&sort=-createdAt",
+	}
+}
+
+func TestParseSmartListQuery(t *testing.T) {
+	query, err := parseSmartListQuery("status=open&status=blocked&tags=3&tagMatch=all&title=report&sort=-updatedAt,title")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"open", "blocked"}, query.Statuses)
+	assert.Equal(t, []int64{3}, query.Tags)
+	assert.Equal(t, TagMatchAll, query.TagMatch)
+	assert.Equal(t, "report", query.Title)
+
+	for query, expectedError := range map[string]error{
+		"overdue=true&pageSize=10":      newUnknownSmartListParameterError("pageSize"),
+		"priority=3":                    newUnknownSmartListParameterError("priority"),
+		"%zz":                           smartListQueryInvalidError,
+		"sort=-relevance":               newUnknownSortFieldError("relevance", db.TodoSortFields),
+		"minFileCount=3&maxFileCount=1": newFilterInvalidRangeError("minFileCount", "maxFileCount"),
+	} {
+		_, err := parseSmartListQuery(query)
+		assert.EqualError(t, err, expectedError.Error(), query)
+	}
+
+	_, err = parseSmartListQuery("tagMatch=some")
+	assert.Error(t, err)
+}
+
+func TestCreateSmartListAPI(t *testing.T) {
+	userID := RandomUserID()
+	smartList := RandomSmartListOfUser(userID)
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		userID             string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"name":   smartList.Name,
+				"query":  smartList.Query,
+				"pinned": true,
+			},
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.CreateSmartListParams{
+					UserID: userID,
+					Name:   smartList.Name,
+					Query:  smartList.Query,
+					Pinned: true,
+				}
+
+				store.EXPECT().
+					CreateSmartList(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(smartList, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp smartListResponse
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				assert.Equal(t, smartList, resp.SmartList)
+				assert.True(t, resp.Valid)
+				assert.Empty(t, resp.Problem)
+			},
+		},
+		{
+			name: "PagingParameter",
+			body: gin.H{
+				"name":  smartList.Name,
+				"query": "overdue=true&cursor=abc",
+			},
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSmartList(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newUnknownSmartListParameterError("cursor"),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InvalidFilter",
+			body: gin.H{
+				"name":  smartList.Name,
+				"query": "createdFrom=2024-05-01T00:00:00Z&createdTo=2024-04-01T00:00:00Z",
+			},
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSmartList(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newFilterInvalidRangeError("createdFrom", "createdTo"),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "NameAlreadyExist",
+			body: gin.H{
+				"name":  smartList.Name,
+				"query": smartList.Query,
+			},
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSmartList(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.SmartList{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			errorExpected: true,
+			expectedError: newSmartListNameAlreadyExistError(smartList.Name),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "MissingUser",
+			body: gin.H{
+				"name":  smartList.Name,
+				"query": smartList.Query,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateSmartList(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: userIDMissingError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/smart-lists", bytes.NewReader(data))
+			assert.NoError(t, err)
+			request.Header.Set(UserIDHeader, tc.userID)
+
+			server.router.ServeHTTP(recorder, request)
+
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestListSmartListsAPI(t *testing.T) {
+	userID := RandomUserID()
+	pinned := RandomSmartListOfUser(userID)
+	pinned.Pinned = true
+	// Saved before the filter went away
+	outdated := RandomSmartListOfUser(userID)
+	outdated.Query = "overdue=true&dueWithin=7d"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ListSmartLists(gomock.Any(), gomock.Eq(userID)).
+		Times(1).
+		Return([]db.SmartList{pinned, outdated}, nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	request, err := http.NewRequest(http.MethodGet, "/smart-lists", nil)
+	assert.NoError(t, err)
+	request.Header.Set(UserIDHeader, userID)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var resp []smartListResponse
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	assert.Len(t, resp, 2)
+	assert.Equal(t, pinned, resp[0].SmartList)
+	assert.True(t, resp[0].Valid)
+	assert.Equal(t, outdated, resp[1].SmartList)
+	assert.False(t, resp[1].Valid)
+	assert.Equal(t, newUnknownSmartListParameterError("dueWithin").Error(), resp[1].Problem)
+}
+
+func TestUpdateSmartListAPI(t *testing.T) {
+	userID := RandomUserID()
+	smartList := RandomSmartListOfUser(userID)
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		userID             string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OKPin",
+			body:   gin.H{"pinned": true},
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				pinned := true
+				arg := db.UpdateSmartListParams{
+					ID:     smartList.ID,
+					Pinned: &pinned,
+				}
+
+				updatedSmartList := smartList
+				updatedSmartList.Pinned = true
+
+				store.EXPECT().
+					GetSmartList(gomock.Any(), gomock.Eq(smartList.ID)).
+					Times(1).
+					Return(smartList, nil)
+				store.EXPECT().
+					UpdateSmartList(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updatedSmartList, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp smartListResponse
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				assert.True(t, resp.Pinned)
+				assert.True(t, resp.Valid)
+			},
+		},
+		{
+			name:   "InvalidQuery",
+			body:   gin.H{"query": "sort=rank"},
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateSmartList(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newUnknownSortFieldError("rank", db.TodoSortFields),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InvalidBody",
+			body:   gin.H{},
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateSmartList(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: updateSmartListInvalidBodyError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "OtherUser",
+			body:   gin.H{"pinned": true},
+			userID: RandomUserID(),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSmartList(gomock.Any(), gomock.Eq(smartList.ID)).
+					Times(1).
+					Return(smartList, nil)
+				store.EXPECT().
+					UpdateSmartList(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newSmartListOfOtherUserError(smartList.ID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "NotFound",
+			body:   gin.H{"pinned": false},
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSmartList(gomock.Any(), gomock.Eq(smartList.ID)).
+					Times(1).
+					Return(db.SmartList{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceSmartList,
+				id:           smartList.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/smart-lists/%d", smartList.ID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+			request.Header.Set(UserIDHeader, tc.userID)
+
+			server.router.ServeHTTP(recorder, request)
+
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestListSmartListTodosAPI(t *testing.T) {
+	userID := RandomUserID()
+	smartList := RandomSmartListOfUser(userID)
+	todos := []db.Todo{RandomTodo(), RandomTodo()}
+
+	tcs := []struct {
+		name               string
+		smartList          db.SmartList
+		query              string
+		buildDBStub        func(store *mockdb.MockStore, smartList db.SmartList)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:      "OK",
+			smartList: smartList,
+			query:     "pageSize=2&withTotal=true",
+			buildDBStub: func(store *mockdb.MockStore, smartList db.SmartList) {
+				overdue := true
+				withAttachments := true
+				arg := db.ListTodosParams{
+					Overdue:        &overdue,
+					HasAttachments: &withAttachments,
+					Sort:           []db.TodoSort{{Field: db.TodoSortFieldCreatedAt, Descending: true}},
+					Limit:          3,
+				}
+
+				store.EXPECT().
+					GetSmartList(gomock.Any(), gomock.Eq(smartList.ID)).
+					Times(1).
+					Return(smartList, nil)
+				store.EXPECT().
+					CountTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(int64(2), nil)
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.Equal(t, "2", recorder.Header().Get(TotalCountHeader))
+
+				var resp struct {
+					Todos      []db.Todo `json:"todos"`
+					NextCursor *string   `json:"nextCursor"`
+				}
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				assert.Equal(t, todos, resp.Todos)
+				assert.Nil(t, resp.NextCursor)
+			},
+		},
+		{
+			name: "Outdated",
+			smartList: func() db.SmartList {
+				outdated := smartList
+				outdated.Query = "dueWithin=7d"
+				return outdated
+			}(),
+			buildDBStub: func(store *mockdb.MockStore, smartList db.SmartList) {
+				store.EXPECT().
+					GetSmartList(gomock.Any(), gomock.Eq(smartList.ID)).
+					Times(1).
+					Return(smartList, nil)
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newSmartListOutdatedError(smartList.Name, newUnknownSmartListParameterError("dueWithin")),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:      "InvalidPaging",
+			smartList: smartList,
+			query:     "pageId=1&pageSize=50",
+			buildDBStub: func(store *mockdb.MockStore, smartList db.SmartList) {
+				store.EXPECT().
+					GetSmartList(gomock.Any(), gomock.Eq(smartList.ID)).
+					Times(1).
+					Return(smartList, nil)
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: pageSizeInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:      "InternalError",
+			smartList: smartList,
+			buildDBStub: func(store *mockdb.MockStore, smartList db.SmartList) {
+				store.EXPECT().
+					GetSmartList(gomock.Any(), gomock.Eq(smartList.ID)).
+					Times(1).
+					Return(db.SmartList{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store, tc.smartList)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/smart-lists/%d/todos?%s", tc.smartList.ID, tc.query)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+			request.Header.Set(UserIDHeader, userID)
+
+			server.router.ServeHTTP(recorder, request)
+
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
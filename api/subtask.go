@@ -0,0 +1,149 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+// todoTreeResponse is a todo along with its subtasks to any depth
+type todoTreeResponse struct {
+	todoResponse
+	Children []todoTreeResponse `json:"children"`
+}
+
+type getTodoTreeRequest struct {
+	getTodoRequest
+}
+
+// getTodoTree godoc
+//
+//	@Summary		Returns a Todo tree
+//	@Description	Get todo by TodoID along with its subtasks to any depth, siblings are in their manual order
+//	@Tags			todos
+//	@Produce		json
+//	@Param			todoId	path		int	true	"Todo ID"	minimum(1)
+//	@Success		200		{object}	todoTreeResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/tree [get]
+func (server *Server) getTodoTree(ctx *gin.Context) {
+	var req getTodoTreeRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	descendants, err := server.store.ListTodoDescendants(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponsesAndHandleErrors(ctx, append([]db.Todo{*todo}, descendants...))
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newTodoTreeResponse(resp))
+}
+
+// newTodoTreeResponse nests the todos under their parents, the first todo being the root
+func newTodoTreeResponse(todos []todoResponse) todoTreeResponse {
+	childrenByParentID := make(map[int64][]todoResponse)
+	for _, todo := range todos[1:] {
+		if todo.ParentID != nil {
+			childrenByParentID[*todo.ParentID] = append(childrenByParentID[*todo.ParentID], todo)
+		}
+	}
+
+	var build func(todo todoResponse) todoTreeResponse
+	build = func(todo todoResponse) todoTreeResponse {
+		node := todoTreeResponse{
+			todoResponse: todo,
+			Children:     make([]todoTreeResponse, 0, len(childrenByParentID[todo.ID])),
+		}
+		for _, child := range childrenByParentID[todo.ID] {
+			node.Children = append(node.Children, build(child))
+		}
+
+		return node
+	}
+
+	return build(todos[0])
+}
+
+type setTodoParentRequestURIParams struct {
+	getTodoRequest
+}
+
+type setTodoParentRequestBody struct {
+	ParentID *int64 `json:"parentId" binding:"omitempty,min=1"`
+}
+
+// setTodoParent godoc
+//
+//	@Summary		Moves a Todo under a new parent
+//	@Description	Moves the todo along with its subtasks under the parent todo; a null parentId turns it into a top level todo
+//	@Tags			todos
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path		int							true	"Todo ID"	minimum(1)
+//	@Param			parent	body		setTodoParentRequestBody	true	"Parent todo ID"
+//	@Success		200		{object}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/parent [put]
+func (server *Server) setTodoParent(ctx *gin.Context) {
+	var reqURIParams setTodoParentRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody setTodoParentRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	result, err := server.store.SetTodoParentTx(ctx, db.SetTodoParentTxParams{
+		TodoID:   reqURIParams.TodoID,
+		ParentID: reqBody.ParentID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownParentTodoError)
+			return
+		}
+
+		if errors.Is(err, db.ErrTodoParentCycle) {
+			NewHTTPError(ctx, http.StatusBadRequest, todoParentCycleError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,403 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	mockNotification "github.com/jaingounchained/todo/notification/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTodoTreeAPI(t *testing.T) {
+	root := RandomTodo()
+	child := RandomTodo()
+	child.ID = root.ID + 1
+	child.ParentID = &root.ID
+	grandchild := RandomTodo()
+	grandchild.ID = root.ID + 2
+	grandchild.ParentID = &child.ID
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: root.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(root.ID)).Times(1).Return(root, nil)
+				store.EXPECT().ListTodoDescendants(gomock.Any(), gomock.Eq(root.ID)).Times(1).Return([]db.Todo{child, grandchild}, nil)
+				store.EXPECT().
+					ListTodoRollups(gomock.Any(), gomock.Eq([]int64{root.ID, child.ID, grandchild.ID})).
+					Times(1).
+					Return([]db.ListTodoRollupsRow{
+						{TodoID: root.ID, ChildrenTotal: 1},
+						{TodoID: child.ID, ChildrenTotal: 1},
+					}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				data, err := io.ReadAll(recorder.Body)
+				assert.NoError(t, err)
+
+				var tree todoTreeResponse
+				err = json.Unmarshal(data, &tree)
+				assert.NoError(t, err)
+
+				assert.Equal(t, root.ID, tree.ID)
+				assert.Equal(t, int32(1), tree.Subtasks.Total)
+				assert.Len(t, tree.Children, 1)
+				assert.Equal(t, child.ID, tree.Children[0].ID)
+				assert.Len(t, tree.Children[0].Children, 1)
+				assert.Equal(t, grandchild.ID, tree.Children[0].Children[0].ID)
+				assert.Empty(t, tree.Children[0].Children[0].Children)
+			},
+		},
+		{
+			name:   "InvalidID",
+			todoID: 0,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: todoIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "NotFound",
+			todoID: root.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(root.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().ListTodoDescendants(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           root.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: root.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(root.ID)).Times(1).Return(root, nil)
+				store.EXPECT().ListTodoDescendants(gomock.Any(), gomock.Eq(root.ID)).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/tree", tc.todoID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestSetTodoParentAPI(t *testing.T) {
+	todo := RandomTodo()
+	parent := RandomTodo()
+	parent.ID = todo.ID + 1
+
+	movedTodo := todo
+	movedTodo.ParentID = &parent.ID
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			body: gin.H{
+				"parentId": parent.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.SetTodoParentTxParams{
+					TodoID:   todo.ID,
+					ParentID: &parent.ID,
+				}
+				store.EXPECT().SetTodoParentTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.SetTodoParentTxResult{Todo: movedTodo}, nil)
+				expectTodoRollups(store, movedTodo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, movedTodo)
+			},
+		},
+		{
+			name:   "OKTopLevel",
+			todoID: todo.ID,
+			body: gin.H{
+				"parentId": nil,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(movedTodo, nil)
+				arg := db.SetTodoParentTxParams{
+					TodoID: todo.ID,
+				}
+				store.EXPECT().SetTodoParentTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.SetTodoParentTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name:   "InvalidParentID",
+			todoID: todo.ID,
+			body: gin.H{
+				"parentId": 0,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().SetTodoParentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "TodoNotFound",
+			todoID: todo.ID,
+			body: gin.H{
+				"parentId": parent.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().SetTodoParentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "UnknownParent",
+			todoID: todo.ID,
+			body: gin.H{
+				"parentId": parent.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().SetTodoParentTx(gomock.Any(), gomock.Any()).Times(1).Return(db.SetTodoParentTxResult{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: unknownParentTodoError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "Cycle",
+			todoID: todo.ID,
+			body: gin.H{
+				"parentId": parent.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().SetTodoParentTx(gomock.Any(), gomock.Any()).Times(1).Return(db.SetTodoParentTxResult{}, db.ErrTodoParentCycle)
+			},
+			errorExpected: true,
+			expectedError: todoParentCycleError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: todo.ID,
+			body: gin.H{
+				"parentId": parent.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().SetTodoParentTx(gomock.Any(), gomock.Any()).Times(1).Return(db.SetTodoParentTxResult{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/parent", tc.todoID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestUpdateTodoRequireCompleteSubtasksAPI(t *testing.T) {
+	todo := RandomTodo()
+	complete := "complete"
+	arg := db.UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: db.UpdateTodoTitleStatusParams{
+			ID:     todo.ID,
+			Status: &complete,
+		},
+		RequireCompleteSubtasks: true,
+	}
+
+	tcs := []struct {
+		name               string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OKSubtasksComplete",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateTodoTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name: "SubtasksIncomplete",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateTodoTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateTodoTxResult{}, db.ErrTodoHasIncompleteSubtasks)
+			},
+			errorExpected: true,
+			expectedError: incompleteSubtasksError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			dispatcher := mockNotification.NewMockDispatcher(ctrl)
+			dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{RequireCompleteSubtasks: true}, store, nil, dispatcher, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(gin.H{"status": complete})
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d", todo.ID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
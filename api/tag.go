@@ -0,0 +1,452 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type getTagRequest struct {
+	TagID int64 `uri:"tagId" binding:"required,min=1"`
+}
+
+// getTag godoc
+//
+//	@Summary		Returns a tag
+//	@Description	Get tag by TagID
+//	@Tags			tags
+//	@Produce		json
+//	@Param			tagId	path		int	true	"Tag ID"	minimum(1)
+//	@Success		200		{object}	db.Tag
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/tags/{tagId} [get]
+func (server *Server) getTag(ctx *gin.Context) {
+	var req getTagRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, tagIDInvalidError)
+		return
+	}
+
+	tag := server.fetchTagAndHandleErrors(ctx, req.TagID)
+	if tag == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tag)
+}
+
+// listTags godoc
+//
+//	@Summary		List tags
+//	@Description	List all the tags ordered by name
+//	@Tags			tags
+//	@Produce		json
+//	@Success		200	{array}	db.Tag
+//	@Failure		500
+//	@Router			/tags [get]
+func (server *Server) listTags(ctx *gin.Context) {
+	tags, err := server.store.ListTags(ctx)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tags)
+}
+
+type createTagRequest struct {
+	Name  string `json:"name" binding:"required,max=64"`
+	Color string `json:"color" binding:"omitempty,hexcolor,max=7"`
+}
+
+// createTag godoc
+//
+//	@Summary		Creates a tag
+//	@Description	Creates a tag with the specified name and color
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			tag	body		createTagRequest	true	"Tag name/color"
+//	@Success		200	{object}	db.Tag
+//	@Failure		400
+//	@Failure		409
+//	@Failure		500
+//	@Router			/tags [post]
+func (server *Server) createTag(ctx *gin.Context) {
+	var req createTagRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if req.Color == "" {
+		req.Color = DefaultTagColor
+	}
+
+	tag, err := server.store.CreateTag(ctx, db.CreateTagParams{
+		Name:  req.Name,
+		Color: req.Color,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, newTagNameAlreadyExistError(req.Name))
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tag)
+}
+
+type updateTagRequestURIParams struct {
+	getTagRequest
+}
+
+type updateTagRequestBody struct {
+	Name  *string `json:"name" binding:"omitempty,min=1,max=64"`
+	Color *string `json:"color" binding:"omitempty,hexcolor,max=7"`
+}
+
+// updateTag godoc
+//
+//	@Summary		Updates the tag name/color
+//	@Description	Renames or recolors a tag; every todo carrying the tag reflects the change
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			tagId	path		int						true	"Tag ID"	minimum(1)
+//	@Param			tag		body		updateTagRequestBody	true	"Tag name/color"
+//	@Success		200		{object}	db.Tag
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/tags/{tagId} [patch]
+func (server *Server) updateTag(ctx *gin.Context) {
+	var reqURIParams updateTagRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, tagIDInvalidError)
+		return
+	}
+
+	var reqBody updateTagRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// Update at least one of name or color
+	if reqBody.Name == nil && reqBody.Color == nil {
+		NewHTTPError(ctx, http.StatusBadRequest, updateTagInvalidBodyError)
+		return
+	}
+
+	tag, err := server.store.UpdateTag(ctx, db.UpdateTagParams{
+		ID:    reqURIParams.TagID,
+		Name:  reqBody.Name,
+		Color: reqBody.Color,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTag,
+				id:           reqURIParams.TagID,
+			})
+			return
+		}
+
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, newTagNameAlreadyExistError(*reqBody.Name))
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tag)
+}
+
+type mergeTagRequestURIParams struct {
+	getTagRequest
+}
+
+type mergeTagRequestBody struct {
+	TargetTagID int64 `json:"targetTagId" binding:"required,min=1"`
+}
+
+// mergeTag godoc
+//
+//	@Summary		Merges a tag into another
+//	@Description	Moves every todo of the tag to the target tag and deletes the tag
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			tagId	path		int					true	"Tag ID"	minimum(1)
+//	@Param			target	body		mergeTagRequestBody	true	"Target tag"
+//	@Success		200		{object}	db.Tag
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/tags/{tagId}/merge [post]
+func (server *Server) mergeTag(ctx *gin.Context) {
+	var reqURIParams mergeTagRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, tagIDInvalidError)
+		return
+	}
+
+	var reqBody mergeTagRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if reqURIParams.TagID == reqBody.TargetTagID {
+		NewHTTPError(ctx, http.StatusBadRequest, mergeTagIntoItselfError)
+		return
+	}
+
+	tag := server.fetchTagAndHandleErrors(ctx, reqURIParams.TagID)
+	if tag == nil {
+		return
+	}
+
+	result, err := server.store.MergeTagsTx(ctx, db.MergeTagsTxParams{
+		SourceTagID: reqURIParams.TagID,
+		TargetTagID: reqBody.TargetTagID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTag,
+				id:           reqBody.TargetTagID,
+			})
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result.Tag)
+}
+
+type deleteTagRequest struct {
+	getTagRequest
+}
+
+// deleteTag godoc
+//
+//	@Summary		Deletes a tag
+//	@Description	Delete tag by TagID; the tag is removed from every todo
+//	@Tags			tags
+//	@Param			tagId	path	int	true	"Tag ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/tags/{tagId} [delete]
+func (server *Server) deleteTag(ctx *gin.Context) {
+	var req deleteTagRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, tagIDInvalidError)
+		return
+	}
+
+	tag := server.fetchTagAndHandleErrors(ctx, req.TagID)
+	if tag == nil {
+		return
+	}
+
+	if err := server.store.DeleteTag(ctx, req.TagID); err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type listTodoTagsRequest struct {
+	getTodoRequest
+}
+
+// listTodoTags godoc
+//
+//	@Summary		List tags of a todo
+//	@Description	List the tags assigned to the todo
+//	@Tags			tags
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	db.Tag
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/tags [get]
+func (server *Server) listTodoTags(ctx *gin.Context) {
+	var req listTodoTagsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	tags, err := server.store.ListTagsOfTodo(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tags)
+}
+
+type addTodoTagsRequestURIParams struct {
+	getTodoRequest
+}
+
+type addTodoTagsRequestBody struct {
+	TagIDs []int64 `json:"tagIds" binding:"required,min=1,dive,min=1"`
+}
+
+// addTodoTags godoc
+//
+//	@Summary		Add tags to a todo
+//	@Description	Assigns the tags to the todo; already assigned tags are ignored
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path	int						true	"Todo ID"	minimum(1)
+//	@Param			tags	body	addTodoTagsRequestBody	true	"Tag IDs"
+//	@Success		200		{array}	db.Tag
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/tags [post]
+func (server *Server) addTodoTags(ctx *gin.Context) {
+	var reqURIParams addTodoTagsRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody addTodoTagsRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	err := server.store.AddTagsToTodo(ctx, db.AddTagsToTodoParams{
+		TodoID: reqURIParams.TodoID,
+		TagIds: uniqueIDs(reqBody.TagIDs),
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownTagError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	tags, err := server.store.ListTagsOfTodo(ctx, reqURIParams.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tags)
+}
+
+type removeTodoTagRequest struct {
+	getTodoRequest
+	TagID int64 `uri:"tagId" binding:"required,min=1"`
+}
+
+// removeTodoTag godoc
+//
+//	@Summary		Remove a tag from a todo
+//	@Description	Unassigns the tag from the todo
+//	@Tags			tags
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Param			tagId	path	int	true	"Tag ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/tags/{tagId} [delete]
+func (server *Server) removeTodoTag(ctx *gin.Context) {
+	var req removeTodoTagRequest
+	if err := ctx.ShouldBindUri(&req.getTodoRequest); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, tagIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	err := server.store.RemoveTagFromTodo(ctx, db.RemoveTagFromTodoParams{
+		TodoID: req.TodoID,
+		TagID:  req.TagID,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+func (server *Server) fetchTagAndHandleErrors(ctx *gin.Context, tagID int64) *db.Tag {
+	tag, err := server.store.GetTag(ctx, tagID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTag,
+				id:           tagID,
+			})
+			return nil
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	return &tag
+}
+
+// uniqueIDs returns ids without duplicates, preserving their order
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	return unique
+}
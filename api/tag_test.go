@@ -0,0 +1,601 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomTag() db.Tag {
+	return db.Tag{
+		ID:    util.RandomInt(1, 1000),
+		Name:  util.RandomString(10),
+		Color: DefaultTagColor,
+	}
+}
+
+func assertBodyMatchTag(t *testing.T, body *bytes.Buffer, tag db.Tag) {
+	data, err := io.ReadAll(body)
+	assert.NoError(t, err)
+
+	var gotTag db.Tag
+	err = json.Unmarshal(data, &gotTag)
+	assert.NoError(t, err)
+	assert.Equal(t, tag, gotTag)
+}
+
+func assertBodyMatchTags(t *testing.T, body *bytes.Buffer, tags []db.Tag) {
+	data, err := io.ReadAll(body)
+	assert.NoError(t, err)
+
+	var gotTags []db.Tag
+	err = json.Unmarshal(data, &gotTags)
+	assert.NoError(t, err)
+	assert.Equal(t, tags, gotTags)
+}
+
+func TestCreateTagAPI(t *testing.T) {
+	tag := RandomTag()
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OKDefaultColor",
+			body: gin.H{
+				"name": tag.Name,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.CreateTagParams{
+					Name:  tag.Name,
+					Color: DefaultTagColor,
+				}
+				store.EXPECT().CreateTag(gomock.Any(), gomock.Eq(arg)).Times(1).Return(tag, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTag(t, recorder.Body, tag)
+			},
+		},
+		{
+			name: "OKWithColor",
+			body: gin.H{
+				"name":  tag.Name,
+				"color": "#ff0000",
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.CreateTagParams{
+					Name:  tag.Name,
+					Color: "#ff0000",
+				}
+				store.EXPECT().CreateTag(gomock.Any(), gomock.Eq(arg)).Times(1).Return(tag, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTag(t, recorder.Body, tag)
+			},
+		},
+		{
+			name: "InvalidColor",
+			body: gin.H{
+				"name":  tag.Name,
+				"color": "red",
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateTag(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NameAbsent",
+			body: gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateTag(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "DuplicateName",
+			body: gin.H{
+				"name": tag.Name,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateTag(gomock.Any(), gomock.Any()).Times(1).Return(db.Tag{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			errorExpected: true,
+			expectedError: newTagNameAlreadyExistError(tag.Name),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{
+				"name": tag.Name,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateTag(gomock.Any(), gomock.Any()).Times(1).Return(db.Tag{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/tags", bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestUpdateTagAPI(t *testing.T) {
+	tag := RandomTag()
+	updatedName := util.RandomString(10)
+	updatedTag := tag
+	updatedTag.Name = updatedName
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"name": updatedName,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateTagParams{
+					ID:   tag.ID,
+					Name: &updatedName,
+				}
+				store.EXPECT().UpdateTag(gomock.Any(), gomock.Eq(arg)).Times(1).Return(updatedTag, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTag(t, recorder.Body, updatedTag)
+			},
+		},
+		{
+			name: "EmptyName",
+			body: gin.H{
+				"name": "",
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateTag(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "EmptyBody",
+			body: gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateTag(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: updateTagInvalidBodyError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/tags/%d", tag.ID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestMergeTagAPI(t *testing.T) {
+	source := RandomTag()
+	target := RandomTag()
+	target.ID = source.ID + 1
+
+	tcs := []struct {
+		name               string
+		tagID              int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:  "OK",
+			tagID: source.ID,
+			body: gin.H{
+				"targetTagId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTag(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				arg := db.MergeTagsTxParams{
+					SourceTagID: source.ID,
+					TargetTagID: target.ID,
+				}
+				store.EXPECT().MergeTagsTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.MergeTagsTxResult{Tag: target}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTag(t, recorder.Body, target)
+			},
+		},
+		{
+			name:  "MergeIntoItself",
+			tagID: source.ID,
+			body: gin.H{
+				"targetTagId": source.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTag(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().MergeTagsTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: mergeTagIntoItselfError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:  "SourceNotFound",
+			tagID: source.ID,
+			body: gin.H{
+				"targetTagId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTag(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(db.Tag{}, db.ErrRecordNotFound)
+				store.EXPECT().MergeTagsTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTag,
+				id:           source.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:  "TargetNotFound",
+			tagID: source.ID,
+			body: gin.H{
+				"targetTagId": target.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTag(gomock.Any(), gomock.Eq(source.ID)).Times(1).Return(source, nil)
+				store.EXPECT().MergeTagsTx(gomock.Any(), gomock.Any()).Times(1).Return(db.MergeTagsTxResult{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTag,
+				id:           target.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/tags/%d/merge", tc.tagID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestAddTodoTagsAPI(t *testing.T) {
+	todo := RandomTodo()
+	tags := []db.Tag{RandomTag(), RandomTag()}
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			body: gin.H{
+				"tagIds": []int64{tags[0].ID, tags[1].ID, tags[0].ID},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.AddTagsToTodoParams{
+					TodoID: todo.ID,
+					TagIds: []int64{tags[0].ID, tags[1].ID},
+				}
+				store.EXPECT().AddTagsToTodo(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+				store.EXPECT().ListTagsOfTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(tags, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTags(t, recorder.Body, tags)
+			},
+		},
+		{
+			name:   "EmptyTagIDs",
+			todoID: todo.ID,
+			body: gin.H{
+				"tagIds": []int64{},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().AddTagsToTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "UnknownTag",
+			todoID: todo.ID,
+			body: gin.H{
+				"tagIds": []int64{tags[0].ID},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().AddTagsToTodo(gomock.Any(), gomock.Any()).Times(1).Return(&pgconn.PgError{Code: db.ForeignKeyViolation})
+				store.EXPECT().ListTagsOfTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: unknownTagError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "TodoNotFound",
+			todoID: todo.ID,
+			body: gin.H{
+				"tagIds": []int64{tags[0].ID},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().AddTagsToTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: "todo",
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/tags", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestRemoveTodoTagAPI(t *testing.T) {
+	todo := RandomTodo()
+	tag := RandomTag()
+
+	tcs := []struct {
+		name               string
+		url                string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			url:  fmt.Sprintf("/todos/%d/tags/%d", todo.ID, tag.ID),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.RemoveTagFromTodoParams{
+					TodoID: todo.ID,
+					TagID:  tag.ID,
+				}
+				store.EXPECT().RemoveTagFromTodo(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidTodoID",
+			url:  fmt.Sprintf("/todos/0/tags/%d", tag.ID),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().RemoveTagFromTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: todoIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InvalidTagID",
+			url:  fmt.Sprintf("/todos/%d/tags/0", todo.ID),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().RemoveTagFromTodo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: tagIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodDelete, tc.url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
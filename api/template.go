@@ -0,0 +1,598 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/placeholder"
+)
+
+// templateResponse is a template along with its tags and attachments
+type templateResponse struct {
+	db.Template
+	Tags        []db.Tag                `json:"tags"`
+	Attachments []db.TemplateAttachment `json:"attachments"`
+}
+
+// buildTemplateResponseAndHandleErrors fetches the attachments of the template and builds its response;
+// writes the error response and returns nil on failure
+func (server *Server) buildTemplateResponseAndHandleErrors(ctx *gin.Context, template db.Template, tags []db.Tag) *templateResponse {
+	attachments, err := server.store.ListTemplateAttachments(ctx, template.ID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	return &templateResponse{
+		Template:    template,
+		Tags:        tags,
+		Attachments: attachments,
+	}
+}
+
+type getTemplateRequest struct {
+	TemplateID int64 `uri:"templateId" binding:"required,min=1"`
+}
+
+// getTemplate godoc
+//
+//	@Summary		Returns a template
+//	@Description	Get template by TemplateID, along with its tags and attachments
+//	@Tags			templates
+//	@Produce		json
+//	@Param			templateId	path		int	true	"Template ID"	minimum(1)
+//	@Success		200			{object}	templateResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/templates/{templateId} [get]
+func (server *Server) getTemplate(ctx *gin.Context) {
+	var req getTemplateRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, templateIDInvalidError)
+		return
+	}
+
+	template := server.fetchTemplateAndHandleErrors(ctx, req.TemplateID)
+	if template == nil {
+		return
+	}
+
+	tags, err := server.store.ListTagsOfTemplate(ctx, req.TemplateID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTemplateResponseAndHandleErrors(ctx, *template, tags)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// listTemplates godoc
+//
+//	@Summary		List templates
+//	@Description	List all the templates ordered by name
+//	@Tags			templates
+//	@Produce		json
+//	@Success		200	{array}	db.Template
+//	@Failure		500
+//	@Router			/templates [get]
+func (server *Server) listTemplates(ctx *gin.Context) {
+	templates, err := server.store.ListTemplates(ctx)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, templates)
+}
+
+type createTemplateRequest struct {
+	Name        string   `json:"name" binding:"required,max=64"`
+	Title       string   `json:"title" binding:"required,max=255"`
+	Description string   `json:"description" binding:"max=10000"`
+	Priority    int16    `json:"priority" binding:"min=0,max=3"`
+	TagIDs      []int64  `json:"tagIds" binding:"omitempty,dive,min=1"`
+	Checklist   []string `json:"checklist" binding:"max=50,dive,required,max=255"`
+	Subtasks    []string `json:"subtasks" binding:"max=20,dive,required,max=255"`
+}
+
+// createTemplate godoc
+//
+//	@Summary		Creates a template
+//	@Description	Creates a template of a todo with the specified title, Markdown description, priority, tags, checklist items and subtask titles. The texts may hold placeholders such as {{date}}, substituted when the template gets instantiated
+//	@Tags			templates
+//	@Accept			json
+//	@Produce		json
+//	@Param			template	body		createTemplateRequest	true	"Template name/title/description/priority/tags/checklist/subtasks"
+//	@Success		200			{object}	templateResponse
+//	@Failure		400
+//	@Failure		409
+//	@Failure		500
+//	@Router			/templates [post]
+func (server *Server) createTemplate(ctx *gin.Context) {
+	var req createTemplateRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := server.store.CreateTemplateTx(ctx, db.CreateTemplateTxParams{
+		CreateTemplateParams: db.CreateTemplateParams{
+			Name:        req.Name,
+			Title:       req.Title,
+			Description: req.Description,
+			Priority:    req.Priority,
+			Checklist:   nonNilStrings(req.Checklist),
+			Subtasks:    nonNilStrings(req.Subtasks),
+		},
+		TagIDs:  uniqueIDs(req.TagIDs),
+		Storage: server.storage,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, newTemplateNameAlreadyExistError(req.Name))
+			return
+		}
+
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownTagError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, templateResponse{
+		Template:    result.Template,
+		Tags:        result.Tags,
+		Attachments: []db.TemplateAttachment{},
+	})
+}
+
+type updateTemplateRequestURIParams struct {
+	getTemplateRequest
+}
+
+type updateTemplateRequestBody struct {
+	Name        *string   `json:"name" binding:"omitempty,max=64"`
+	Title       *string   `json:"title" binding:"omitempty,max=255"`
+	Description *string   `json:"description" binding:"omitempty,max=10000"`
+	Priority    *int16    `json:"priority" binding:"omitempty,min=0,max=3"`
+	TagIDs      *[]int64  `json:"tagIds" binding:"omitempty,dive,min=1"`
+	Checklist   *[]string `json:"checklist" binding:"omitempty,max=50,dive,required,max=255"`
+	Subtasks    *[]string `json:"subtasks" binding:"omitempty,max=20,dive,required,max=255"`
+}
+
+// updateTemplate godoc
+//
+//	@Summary		Updates a template
+//	@Description	Updates the provided fields of the template; 'tagIds', 'checklist' and 'subtasks' replace the current ones. Todos already instantiated from the template are left unchanged
+//	@Tags			templates
+//	@Accept			json
+//	@Produce		json
+//	@Param			templateId	path		int							true	"Template ID"	minimum(1)
+//	@Param			template	body		updateTemplateRequestBody	true	"Template name/title/description/priority/tags/checklist/subtasks"
+//	@Success		200			{object}	templateResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/templates/{templateId} [patch]
+func (server *Server) updateTemplate(ctx *gin.Context) {
+	var reqURIParams updateTemplateRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, templateIDInvalidError)
+		return
+	}
+
+	var reqBody updateTemplateRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if reqBody.Name == nil && reqBody.Title == nil && reqBody.Description == nil && reqBody.Priority == nil &&
+		reqBody.TagIDs == nil && reqBody.Checklist == nil && reqBody.Subtasks == nil {
+		NewHTTPError(ctx, http.StatusBadRequest, updateTemplateInvalidBodyError)
+		return
+	}
+
+	arg := db.UpdateTemplateTxParams{
+		UpdateTemplateParams: db.UpdateTemplateParams{
+			ID:          reqURIParams.TemplateID,
+			Name:        reqBody.Name,
+			Title:       reqBody.Title,
+			Description: reqBody.Description,
+			Priority:    reqBody.Priority,
+		},
+		UpdateTags: reqBody.TagIDs != nil,
+	}
+	if reqBody.TagIDs != nil {
+		arg.TagIDs = uniqueIDs(*reqBody.TagIDs)
+	}
+	if reqBody.Checklist != nil {
+		arg.Checklist = nonNilStrings(*reqBody.Checklist)
+	}
+	if reqBody.Subtasks != nil {
+		arg.Subtasks = nonNilStrings(*reqBody.Subtasks)
+	}
+
+	result, err := server.store.UpdateTemplateTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTemplate,
+				id:           reqURIParams.TemplateID,
+			})
+			return
+		}
+
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, newTemplateNameAlreadyExistError(*reqBody.Name))
+			return
+		}
+
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownTagError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTemplateResponseAndHandleErrors(ctx, result.Template, result.Tags)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type deleteTemplateRequest struct {
+	getTemplateRequest
+}
+
+// deleteTemplate godoc
+//
+//	@Summary		Deletes a template
+//	@Description	Delete template by TemplateID along with its attachments; todos instantiated from the template are kept
+//	@Tags			templates
+//	@Param			templateId	path	int	true	"Template ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/templates/{templateId} [delete]
+func (server *Server) deleteTemplate(ctx *gin.Context) {
+	var req deleteTemplateRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, templateIDInvalidError)
+		return
+	}
+
+	template := server.fetchTemplateAndHandleErrors(ctx, req.TemplateID)
+	if template == nil {
+		return
+	}
+
+	err := server.store.DeleteTemplateTx(ctx, db.DeleteTemplateTxParams{
+		TemplateID: req.TemplateID,
+		Storage:    server.storage,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type uploadTemplateAttachmentsRequest struct {
+	getTemplateRequest
+}
+
+// uploadTemplateAttachments godoc
+//
+//	@Summary		Upload template attachments
+//	@Description	Upload attachments for the corresponding template; they're copied to every todo instantiated from it
+//	@Tags			templates
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			templateId	path		int		true	"Template ID"	minimum(1)
+//	@Param			attachments	formData	[]file	true	"attachments"
+//	@Success		200			{array}		db.TemplateAttachment
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		413
+//	@Failure		415
+//	@Failure		500
+//	@Router			/templates/{templateId}/attachments [post]
+func (server *Server) uploadTemplateAttachments(ctx *gin.Context) {
+	if strings.TrimSpace(ctx.ContentType()) != MultipartFormDataHeader {
+		NewHTTPError(ctx, http.StatusBadRequest, invalidHeaderContentTypeError)
+		return
+	}
+
+	var req uploadTemplateAttachmentsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, templateIDInvalidError)
+		return
+	}
+
+	template := server.fetchTemplateAndHandleErrors(ctx, req.TemplateID)
+	if template == nil {
+		return
+	}
+
+	attachments, err := server.store.ListTemplateAttachments(ctx, req.TemplateID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Instantiated todos get a copy of every attachment, so templates share the attachment limit of todos
+	if len(attachments) >= TodoAttachmentLimit {
+		NewHTTPError(ctx, http.StatusForbidden, newTodoAttachmentLimitReachedError(TodoAttachmentLimit))
+		return
+	}
+
+	fileContents, _ := readAttachmentFilesAndHandleErrors(ctx, len(attachments))
+	if fileContents == nil {
+		return
+	}
+
+	err = server.store.UploadTemplateAttachmentTx(ctx, db.UploadTemplateAttachmentTxParams{
+		TemplateID:   req.TemplateID,
+		FileContents: fileContents,
+		Storage:      server.storage,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	attachments, err = server.store.ListTemplateAttachments(ctx, req.TemplateID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, attachments)
+}
+
+type deleteTemplateAttachmentRequest struct {
+	getTemplateRequest
+	AttachmentID int64 `uri:"attachmentId" binding:"required,min=1"`
+}
+
+// deleteTemplateAttachment godoc
+//
+//	@Summary		Delete template attachment
+//	@Description	Delete attachment of the corresponding template; copies in instantiated todos are kept
+//	@Tags			templates
+//	@Param			templateId		path	int	true	"Template ID"	minimum(1)
+//	@Param			attachmentId	path	int	true	"Attachment ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/templates/{templateId}/attachments/{attachmentId} [delete]
+func (server *Server) deleteTemplateAttachment(ctx *gin.Context) {
+	var req deleteTemplateAttachmentRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	template := server.fetchTemplateAndHandleErrors(ctx, req.TemplateID)
+	if template == nil {
+		return
+	}
+
+	attachment, err := server.store.GetTemplateAttachment(ctx, req.AttachmentID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTemplateAttachment,
+				id:           req.AttachmentID,
+			})
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	if attachment.TemplateID != req.TemplateID {
+		NewHTTPError(ctx, http.StatusForbidden, newTemplateAttachmentNotAssociatedWithTemplateError(req.TemplateID, req.AttachmentID))
+		return
+	}
+
+	err = server.store.DeleteTemplateAttachmentTx(ctx, db.DeleteTemplateAttachmentTxParams{
+		Attachment: attachment,
+		Storage:    server.storage,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type instantiateTemplateRequestURIParams struct {
+	getTemplateRequest
+}
+
+type instantiateTemplateRequestBody struct {
+	ProjectID *int64            `json:"projectId" binding:"omitempty,min=1"`
+	Timezone  string            `json:"timezone" binding:"omitempty,timezone"`
+	Variables map[string]string `json:"variables" binding:"omitempty,max=20,dive,keys,min=1,max=64,endkeys,max=255"`
+}
+
+// instantiateTemplate godoc
+//
+//	@Summary		Instantiates a template
+//	@Description	Creates a todo from the template in a single transaction, along with its tags, checklist, subtasks and copies of the template attachments.
+//	@Description	The placeholders of the texts are substituted: {{date}}, {{time}}, {{weekday}}, {{month}} and {{year}} refer to the instantiation in 'timezone' (UTC by default), any other placeholder must be given a value in 'variables', which may override the former ones as well
+//	@Tags			templates
+//	@Accept			json
+//	@Produce		json
+//	@Param			templateId	path		int								true	"Template ID"	minimum(1)
+//	@Param			instance	body		instantiateTemplateRequestBody	false	"Project/timezone/placeholder values"
+//	@Success		200			{object}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/templates/{templateId}/instantiate [post]
+func (server *Server) instantiateTemplate(ctx *gin.Context) {
+	var reqURIParams instantiateTemplateRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, templateIDInvalidError)
+		return
+	}
+
+	// The body is optional
+	var reqBody instantiateTemplateRequestBody
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+			NewHTTPError(ctx, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	template := server.fetchTemplateAndHandleErrors(ctx, reqURIParams.TemplateID)
+	if template == nil {
+		return
+	}
+
+	if reqBody.ProjectID != nil {
+		_, err := server.store.GetProject(ctx, *reqBody.ProjectID)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				NewHTTPError(ctx, http.StatusBadRequest, unknownProjectError)
+				return
+			}
+
+			NewHTTPError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	arg, err := instantiateTemplateParams(*template, reqBody, time.Now())
+	if err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	arg.Storage = server.storage
+
+	result, err := server.store.InstantiateTemplateTx(ctx, arg)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// instantiateTemplateParams substitutes the placeholders of the template texts for its instantiation at the given time;
+// fails when a placeholder has no value or a substituted text no longer fits its column
+func instantiateTemplateParams(template db.Template, reqBody instantiateTemplateRequestBody, now time.Time) (db.InstantiateTemplateTxParams, error) {
+	// The timezone was validated when binding the request
+	loc, _ := time.LoadLocation(reqBody.Timezone)
+
+	values := placeholder.Builtins(now.In(loc))
+	for name, value := range reqBody.Variables {
+		values[name] = value
+	}
+
+	arg := db.InstantiateTemplateTxParams{
+		Template:  template,
+		ProjectID: reqBody.ProjectID,
+	}
+
+	var err error
+	if arg.Title, err = placeholder.Expand(template.Title, values); err != nil {
+		return arg, err
+	}
+	if arg.Description, err = placeholder.Expand(template.Description, values); err != nil {
+		return arg, err
+	}
+	if arg.Checklist, err = placeholder.ExpandAll(template.Checklist, values); err != nil {
+		return arg, err
+	}
+	if arg.Subtasks, err = placeholder.ExpandAll(template.Subtasks, values); err != nil {
+		return arg, err
+	}
+
+	if err := checkInstantiatedLength("title", 255, arg.Title); err != nil {
+		return arg, err
+	}
+	if err := checkInstantiatedLength("description", 10000, arg.Description); err != nil {
+		return arg, err
+	}
+	if err := checkInstantiatedLength("checklist", 255, arg.Checklist...); err != nil {
+		return arg, err
+	}
+	if err := checkInstantiatedLength("subtasks", 255, arg.Subtasks...); err != nil {
+		return arg, err
+	}
+
+	return arg, nil
+}
+
+func checkInstantiatedLength(field string, maxLength int, texts ...string) error {
+	for _, text := range texts {
+		if utf8.RuneCountInString(text) > maxLength {
+			return newInstantiatedTextTooLongError(field, maxLength)
+		}
+	}
+
+	return nil
+}
+
+// nonNilStrings returns an empty slice instead of nil, which would be stored as NULL
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+func (server *Server) fetchTemplateAndHandleErrors(ctx *gin.Context, templateID int64) *db.Template {
+	template, err := server.store.GetTemplate(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTemplate,
+				id:           templateID,
+			})
+			return nil
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	return &template
+}
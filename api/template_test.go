@@ -0,0 +1,676 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/placeholder"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomTemplate() db.Template {
+	return db.Template{
+		ID:          util.RandomInt(1, 1000),
+		Name:        util.RandomString(10),
+		Title:       "Standup {{date}}",
+		Description: "Notes of {{team}}",
+		Priority:    int16(util.RandomInt(0, 3)),
+		Checklist:   []string{"Review {{team}} board"},
+		Subtasks:    []string{"Prepare {{weekday}} agenda"},
+	}
+}
+
+func assertBodyMatchTemplate(t *testing.T, body *bytes.Buffer, resp templateResponse) {
+	data, err := io.ReadAll(body)
+	assert.NoError(t, err)
+
+	var gotResp templateResponse
+	err = json.Unmarshal(data, &gotResp)
+	assert.NoError(t, err)
+	assert.Equal(t, resp, gotResp)
+}
+
+func TestCreateTemplateAPI(t *testing.T) {
+	template := RandomTemplate()
+	tag := RandomTag()
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"name":        template.Name,
+				"title":       template.Title,
+				"description": template.Description,
+				"priority":    template.Priority,
+				"tagIds":      []int64{tag.ID, tag.ID},
+				"checklist":   template.Checklist,
+				"subtasks":    template.Subtasks,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				arg := db.CreateTemplateTxParams{
+					CreateTemplateParams: db.CreateTemplateParams{
+						Name:        template.Name,
+						Title:       template.Title,
+						Description: template.Description,
+						Priority:    template.Priority,
+						Checklist:   template.Checklist,
+						Subtasks:    template.Subtasks,
+					},
+					TagIDs:  []int64{tag.ID},
+					Storage: mockStorage,
+				}
+				store.EXPECT().
+					CreateTemplateTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CreateTemplateTxResult{Template: template, Tags: []db.Tag{tag}}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTemplate(t, recorder.Body, templateResponse{
+					Template:    template,
+					Tags:        []db.Tag{tag},
+					Attachments: []db.TemplateAttachment{},
+				})
+			},
+		},
+		{
+			name: "OKWithoutChecklistAndSubtasks",
+			body: gin.H{
+				"name":  template.Name,
+				"title": template.Title,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				arg := db.CreateTemplateTxParams{
+					CreateTemplateParams: db.CreateTemplateParams{
+						Name:      template.Name,
+						Title:     template.Title,
+						Checklist: []string{},
+						Subtasks:  []string{},
+					},
+					TagIDs:  []int64{},
+					Storage: mockStorage,
+				}
+				store.EXPECT().
+					CreateTemplateTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CreateTemplateTxResult{Template: template, Tags: []db.Tag{}}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NameAlreadyExists",
+			body: gin.H{
+				"name":  template.Name,
+				"title": template.Title,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().
+					CreateTemplateTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateTemplateTxResult{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			errorExpected: true,
+			expectedError: newTemplateNameAlreadyExistError(template.Name),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "UnknownTag",
+			body: gin.H{
+				"name":   template.Name,
+				"title":  template.Title,
+				"tagIds": []int64{tag.ID},
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().
+					CreateTemplateTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateTemplateTxResult{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			errorExpected: true,
+			expectedError: unknownTagError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "EmptyChecklistItem",
+			body: gin.H{
+				"name":      template.Name,
+				"title":     template.Title,
+				"checklist": []string{""},
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().CreateTemplateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "MissingName",
+			body: gin.H{
+				"title": template.Title,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().CreateTemplateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mockStorage.NewMockStorage(ctrl)
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store, mockStorage)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, mockStorage, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := "/templates"
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestUpdateTemplateAPI(t *testing.T) {
+	template := RandomTemplate()
+	newTitle := util.RandomString(10)
+
+	tcs := []struct {
+		name               string
+		templateID         int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:       "OK",
+			templateID: template.ID,
+			body: gin.H{
+				"title":     newTitle,
+				"checklist": []string{},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateTemplateTxParams{
+					UpdateTemplateParams: db.UpdateTemplateParams{
+						ID:        template.ID,
+						Title:     &newTitle,
+						Checklist: []string{},
+					},
+				}
+				updated := template
+				updated.Title = newTitle
+				updated.Checklist = []string{}
+				store.EXPECT().
+					UpdateTemplateTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateTemplateTxResult{Template: updated, Tags: []db.Tag{}}, nil)
+				store.EXPECT().
+					ListTemplateAttachments(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return([]db.TemplateAttachment{}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "OKReplaceTags",
+			templateID: template.ID,
+			body: gin.H{
+				"tagIds": []int64{},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateTemplateTxParams{
+					UpdateTemplateParams: db.UpdateTemplateParams{
+						ID: template.ID,
+					},
+					UpdateTags: true,
+					TagIDs:     []int64{},
+				}
+				store.EXPECT().
+					UpdateTemplateTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateTemplateTxResult{Template: template, Tags: []db.Tag{}}, nil)
+				store.EXPECT().
+					ListTemplateAttachments(gomock.Any(), gomock.Eq(template.ID)).
+					Times(1).
+					Return([]db.TemplateAttachment{}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "EmptyBody",
+			templateID: template.ID,
+			body:       gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateTemplateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: updateTemplateInvalidBodyError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:       "InvalidTemplateID",
+			templateID: 0,
+			body: gin.H{
+				"title": newTitle,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateTemplateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: templateIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:       "TemplateNotFound",
+			templateID: template.ID,
+			body: gin.H{
+				"title": newTitle,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateTemplateTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateTemplateTxResult{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTemplate,
+				id:           template.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/templates/%d", tc.templateID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestDeleteTemplateAttachmentAPI(t *testing.T) {
+	template := RandomTemplate()
+	attachment := db.TemplateAttachment{
+		ID:               util.RandomInt(1, 1000),
+		TemplateID:       template.ID,
+		OriginalFilename: util.RandomString(10),
+		StorageFilename:  util.RandomString(10),
+	}
+	otherAttachment := attachment
+	otherAttachment.TemplateID = template.ID + 1
+
+	tcs := []struct {
+		name               string
+		buildDBStub        func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Eq(template.ID)).Times(1).Return(template, nil)
+				store.EXPECT().GetTemplateAttachment(gomock.Any(), gomock.Eq(attachment.ID)).Times(1).Return(attachment, nil)
+				arg := db.DeleteTemplateAttachmentTxParams{
+					Attachment: attachment,
+					Storage:    mockStorage,
+				}
+				store.EXPECT().DeleteTemplateAttachmentTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "AttachmentOfOtherTemplate",
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Eq(template.ID)).Times(1).Return(template, nil)
+				store.EXPECT().GetTemplateAttachment(gomock.Any(), gomock.Eq(attachment.ID)).Times(1).Return(otherAttachment, nil)
+				store.EXPECT().DeleteTemplateAttachmentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newTemplateAttachmentNotAssociatedWithTemplateError(template.ID, attachment.ID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "TemplateNotFound",
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Eq(template.ID)).Times(1).Return(db.Template{}, db.ErrRecordNotFound)
+				store.EXPECT().GetTemplateAttachment(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTemplate,
+				id:           template.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mockStorage.NewMockStorage(ctrl)
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store, mockStorage)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, mockStorage, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/templates/%d/attachments/%d", template.ID, attachment.ID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestInstantiateTemplateAPI(t *testing.T) {
+	template := RandomTemplate()
+	todo := RandomTodo()
+	project := RandomProject()
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"projectId": project.ID,
+				"timezone":  "Asia/Kolkata",
+				"variables": gin.H{"team": "core"},
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Eq(template.ID)).Times(1).Return(template, nil)
+				store.EXPECT().GetProject(gomock.Any(), gomock.Eq(project.ID)).Times(1).Return(project, nil)
+				store.EXPECT().
+					InstantiateTemplateTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ any, arg db.InstantiateTemplateTxParams) (db.InstantiateTemplateTxResult, error) {
+						assert.Equal(t, template, arg.Template)
+						assert.Equal(t, project.ID, *arg.ProjectID)
+						assert.Equal(t, "Notes of core", arg.Description)
+						assert.Equal(t, []string{"Review core board"}, arg.Checklist)
+						assert.NotContains(t, arg.Title, "{{")
+						assert.NotContains(t, arg.Subtasks[0], "{{")
+						assert.Equal(t, mockStorage, arg.Storage)
+						return db.InstantiateTemplateTxResult{Todo: todo}, nil
+					})
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name: "UnknownPlaceholder",
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Eq(template.ID)).Times(1).Return(template, nil)
+				store.EXPECT().InstantiateTemplateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &placeholder.UnknownPlaceholderError{Name: "team"},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InstantiatedTitleTooLong",
+			body: gin.H{
+				"variables": gin.H{"date": strings.Repeat("a", 255), "team": "core"},
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Eq(template.ID)).Times(1).Return(template, nil)
+				store.EXPECT().InstantiateTemplateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newInstantiatedTextTooLongError("title", 255),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "UnknownProject",
+			body: gin.H{
+				"projectId": project.ID,
+				"variables": gin.H{"team": "core"},
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Eq(template.ID)).Times(1).Return(template, nil)
+				store.EXPECT().GetProject(gomock.Any(), gomock.Eq(project.ID)).Times(1).Return(db.Project{}, db.ErrRecordNotFound)
+				store.EXPECT().InstantiateTemplateTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: unknownProjectError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InvalidTimezone",
+			body: gin.H{
+				"timezone": "Mars/Olympus",
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TemplateNotFound",
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Eq(template.ID)).Times(1).Return(db.Template{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTemplate,
+				id:           template.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InternalError",
+			body: gin.H{
+				"variables": gin.H{"team": "core"},
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetTemplate(gomock.Any(), gomock.Eq(template.ID)).Times(1).Return(template, nil)
+				store.EXPECT().
+					InstantiateTemplateTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.InstantiateTemplateTxResult{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mockStorage.NewMockStorage(ctrl)
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store, mockStorage)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, mockStorage, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// The body is optional
+			var body io.Reader
+			if tc.body != nil {
+				data, err := json.Marshal(tc.body)
+				assert.NoError(t, err)
+				body = bytes.NewReader(data)
+			}
+
+			url := fmt.Sprintf("/templates/%d/instantiate", template.ID)
+			request, err := http.NewRequest(http.MethodPost, url, body)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestInstantiateTemplateParams(t *testing.T) {
+	template := RandomTemplate()
+	now := time.Date(2024, time.March, 31, 20, 0, 0, 0, time.UTC)
+
+	// The builtin placeholders refer to the instantiation in the requested timezone
+	arg, err := instantiateTemplateParams(template, instantiateTemplateRequestBody{
+		Timezone:  "Asia/Kolkata",
+		Variables: map[string]string{"team": "core"},
+	}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "Standup 2024-04-01", arg.Title)
+	assert.Equal(t, "Notes of core", arg.Description)
+	assert.Equal(t, []string{"Review core board"}, arg.Checklist)
+	assert.Equal(t, []string{"Prepare Monday agenda"}, arg.Subtasks)
+
+	// Variables override the builtin placeholders
+	arg, err = instantiateTemplateParams(template, instantiateTemplateRequestBody{
+		Variables: map[string]string{"team": "core", "date": "today"},
+	}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "Standup today", arg.Title)
+	assert.Equal(t, []string{"Prepare Sunday agenda"}, arg.Subtasks)
+}
@@ -0,0 +1,371 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type startTodoTimerRequest struct {
+	getTodoRequest
+}
+
+// startTodoTimer godoc
+//
+//	@Summary		Starts a timer on a todo
+//	@Description	Starts tracking the time the user spends on the todo; a user can run a single timer at a time
+//	@Tags			time tracking
+//	@Produce		json
+//	@Param			todoId		path		int		true	"Todo ID"	minimum(1)
+//	@Param			X-User-ID	header		string	true	"User ID"
+//	@Success		200			{object}	db.TimeEntry
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/todos/{todoId}/timer/start [post]
+func (server *Server) startTodoTimer(ctx *gin.Context) {
+	var req startTodoTimerRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	entry, err := server.store.StartTimer(ctx, db.StartTimerParams{
+		TodoID: req.TodoID,
+		UserID: userID,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, timerAlreadyRunningError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry)
+}
+
+type stopTodoTimerRequest struct {
+	getTodoRequest
+}
+
+// stopTodoTimer godoc
+//
+//	@Summary		Stops the timer on a todo
+//	@Description	Stops the timer the user is running on the todo, turning it into a time entry
+//	@Tags			time tracking
+//	@Produce		json
+//	@Param			todoId		path		int		true	"Todo ID"	minimum(1)
+//	@Param			X-User-ID	header		string	true	"User ID"
+//	@Success		200			{object}	db.TimeEntry
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/todos/{todoId}/timer/stop [post]
+func (server *Server) stopTodoTimer(ctx *gin.Context) {
+	var req stopTodoTimerRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	entry, err := server.store.StopTimer(ctx, db.StopTimerParams{
+		TodoID: req.TodoID,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusConflict, noRunningTimerError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry)
+}
+
+type listTodoTimeEntriesRequest struct {
+	getTodoRequest
+}
+
+// listTodoTimeEntries godoc
+//
+//	@Summary		List time entries of a todo
+//	@Description	List the time tracked on the todo by every user, including running timers, oldest first
+//	@Tags			time tracking
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	db.TimeEntry
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/time-entries [get]
+func (server *Server) listTodoTimeEntries(ctx *gin.Context) {
+	var req listTodoTimeEntriesRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	entries, err := server.store.ListTimeEntries(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries)
+}
+
+type createTodoTimeEntryRequestURIParams struct {
+	getTodoRequest
+}
+
+type createTodoTimeEntryRequestBody struct {
+	StartedAt time.Time `json:"startedAt" binding:"required"`
+	StoppedAt time.Time `json:"stoppedAt" binding:"required,gtfield=StartedAt"`
+	Note      string    `json:"note" binding:"max=255"`
+}
+
+// createTodoTimeEntry godoc
+//
+//	@Summary		Adds a manual time entry to a todo
+//	@Description	Records time the user spent on the todo without running a timer
+//	@Tags			time tracking
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId		path		int								true	"Todo ID"	minimum(1)
+//	@Param			X-User-ID	header		string							true	"User ID"
+//	@Param			entry		body		createTodoTimeEntryRequestBody	true	"Time entry start/stop/note"
+//	@Success		200			{object}	db.TimeEntry
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/time-entries [post]
+func (server *Server) createTodoTimeEntry(ctx *gin.Context) {
+	var reqURIParams createTodoTimeEntryRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody createTodoTimeEntryRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	entry, err := server.store.CreateTimeEntry(ctx, db.CreateTimeEntryParams{
+		TodoID:    reqURIParams.TodoID,
+		UserID:    userID,
+		StartedAt: reqBody.StartedAt,
+		StoppedAt: &reqBody.StoppedAt,
+		Note:      reqBody.Note,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry)
+}
+
+type getTodoTimeEntryRequest struct {
+	getTodoRequest
+	TimeEntryID int64 `uri:"timeEntryId" binding:"required,min=1"`
+}
+
+type updateTodoTimeEntryRequestURIParams struct {
+	getTodoTimeEntryRequest
+}
+
+type updateTodoTimeEntryRequestBody struct {
+	StartedAt *time.Time `json:"startedAt"`
+	StoppedAt *time.Time `json:"stoppedAt"`
+	Note      *string    `json:"note" binding:"omitempty,max=255"`
+}
+
+// updateTodoTimeEntry godoc
+//
+//	@Summary		Edits a time entry of a todo
+//	@Description	Updates the start, stop and/or note of a time entry tracked by the user; setting the stop of a running timer stops it
+//	@Tags			time tracking
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId		path		int								true	"Todo ID"		minimum(1)
+//	@Param			timeEntryId	path		int								true	"Time entry ID"	minimum(1)
+//	@Param			X-User-ID	header		string							true	"User ID"
+//	@Param			entry		body		updateTodoTimeEntryRequestBody	true	"Time entry start/stop/note"
+//	@Success		200			{object}	db.TimeEntry
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/time-entries/{timeEntryId} [patch]
+func (server *Server) updateTodoTimeEntry(ctx *gin.Context) {
+	var reqURIParams updateTodoTimeEntryRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	var reqBody updateTodoTimeEntryRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if reqBody.StartedAt == nil && reqBody.StoppedAt == nil && reqBody.Note == nil {
+		NewHTTPError(ctx, http.StatusBadRequest, updateTimeEntryInvalidBodyError)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	entry := server.fetchTimeEntryAndHandleErrors(ctx, reqURIParams.TodoID, reqURIParams.TimeEntryID, userID)
+	if entry == nil {
+		return
+	}
+
+	updatedEntry, err := server.store.UpdateTimeEntry(ctx, db.UpdateTimeEntryParams{
+		StartedAt: reqBody.StartedAt,
+		StoppedAt: reqBody.StoppedAt,
+		Note:      reqBody.Note,
+		ID:        reqURIParams.TimeEntryID,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.CheckViolation {
+			NewHTTPError(ctx, http.StatusBadRequest, timeEntryInvalidRangeError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, updatedEntry)
+}
+
+type deleteTodoTimeEntryRequest struct {
+	getTodoTimeEntryRequest
+}
+
+// deleteTodoTimeEntry godoc
+//
+//	@Summary		Deletes a time entry of a todo
+//	@Description	Delete time entry tracked by the user by TimeEntryID
+//	@Tags			time tracking
+//	@Param			todoId		path	int		true	"Todo ID"		minimum(1)
+//	@Param			timeEntryId	path	int		true	"Time entry ID"	minimum(1)
+//	@Param			X-User-ID	header	string	true	"User ID"
+//	@Success		200
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/time-entries/{timeEntryId} [delete]
+func (server *Server) deleteTodoTimeEntry(ctx *gin.Context) {
+	var req deleteTodoTimeEntryRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	entry := server.fetchTimeEntryAndHandleErrors(ctx, req.TodoID, req.TimeEntryID, userID)
+	if entry == nil {
+		return
+	}
+
+	if err := server.store.DeleteTimeEntry(ctx, req.TimeEntryID); err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+// fetchTimeEntryAndHandleErrors fetches the time entry, making sure the todo exists,
+// the entry belongs to it and was tracked by the user
+func (server *Server) fetchTimeEntryAndHandleErrors(ctx *gin.Context, todoID, entryID int64, userID string) *db.TimeEntry {
+	todo := server.fetchTodoAndHandleErrors(ctx, todoID)
+	if todo == nil {
+		return nil
+	}
+
+	entry, err := server.store.GetTimeEntry(ctx, entryID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTimeEntry,
+				id:           entryID,
+			})
+			return nil
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	if entry.TodoID != todoID {
+		NewHTTPError(ctx, http.StatusForbidden, newTimeEntryNotAssociatedWithTodoError(todoID, entryID))
+		return nil
+	}
+
+	if entry.UserID != userID {
+		NewHTTPError(ctx, http.StatusForbidden, newTimeEntryOfOtherUserError(entryID))
+		return nil
+	}
+
+	return &entry
+}
@@ -0,0 +1,451 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomTimeEntryOfTodo(todo db.Todo, userID string) db.TimeEntry {
+	startedAt := time.Now().Add(-time.Duration(util.RandomInt(60, 3600)) * time.Second).UTC().Truncate(time.Second)
+	stoppedAt := startedAt.Add(time.Duration(util.RandomInt(1, 60)) * time.Minute)
+
+	return db.TimeEntry{
+		ID:        util.RandomInt(1, 1000),
+		TodoID:    todo.ID,
+		UserID:    userID,
+		StartedAt: startedAt,
+		StoppedAt: &stoppedAt,
+		Note:      util.RandomString(10),
+	}
+}
+
+func assertBodyMatchTimeEntry(t *testing.T, body *bytes.Buffer, entry db.TimeEntry) {
+	var gotEntry db.TimeEntry
+	err := json.Unmarshal(body.Bytes(), &gotEntry)
+	assert.NoError(t, err)
+	assert.Equal(t, entry, gotEntry)
+}
+
+func TestStartTodoTimerAPI(t *testing.T) {
+	todo := RandomTodo()
+	userID := RandomUserID()
+	entry := RandomTimeEntryOfTodo(todo, userID)
+	entry.StoppedAt = nil
+
+	tcs := []struct {
+		name               string
+		userID             string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.StartTimerParams{
+					TodoID: todo.ID,
+					UserID: userID,
+				}
+				store.EXPECT().StartTimer(gomock.Any(), gomock.Eq(arg)).Times(1).Return(entry, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTimeEntry(t, recorder.Body, entry)
+			},
+		},
+		{
+			name:   "MissingUser",
+			userID: "",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().StartTimer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: userIDMissingError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "TimerAlreadyRunning",
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().
+					StartTimer(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TimeEntry{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			errorExpected: true,
+			expectedError: timerAlreadyRunningError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/timer/start", todo.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			assert.NoError(t, err)
+			request.Header.Set(UserIDHeader, tc.userID)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestStopTodoTimerAPI(t *testing.T) {
+	todo := RandomTodo()
+	userID := RandomUserID()
+	entry := RandomTimeEntryOfTodo(todo, userID)
+
+	tcs := []struct {
+		name               string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.StopTimerParams{
+					TodoID: todo.ID,
+					UserID: userID,
+				}
+				store.EXPECT().StopTimer(gomock.Any(), gomock.Eq(arg)).Times(1).Return(entry, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTimeEntry(t, recorder.Body, entry)
+			},
+		},
+		{
+			name: "NoRunningTimer",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().StopTimer(gomock.Any(), gomock.Any()).Times(1).Return(db.TimeEntry{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: noRunningTimerError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/timer/stop", todo.ID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			assert.NoError(t, err)
+			request.Header.Set(UserIDHeader, userID)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestCreateTodoTimeEntryAPI(t *testing.T) {
+	todo := RandomTodo()
+	userID := RandomUserID()
+	entry := RandomTimeEntryOfTodo(todo, userID)
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"startedAt": entry.StartedAt,
+				"stoppedAt": entry.StoppedAt,
+				"note":      entry.Note,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.CreateTimeEntryParams{
+					TodoID:    todo.ID,
+					UserID:    userID,
+					StartedAt: entry.StartedAt,
+					StoppedAt: entry.StoppedAt,
+					Note:      entry.Note,
+				}
+				store.EXPECT().CreateTimeEntry(gomock.Any(), gomock.Eq(arg)).Times(1).Return(entry, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTimeEntry(t, recorder.Body, entry)
+			},
+		},
+		{
+			name: "StoppedBeforeStarted",
+			body: gin.H{
+				"startedAt": entry.StoppedAt,
+				"stoppedAt": entry.StartedAt,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateTimeEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/time-entries", todo.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+			request.Header.Set(UserIDHeader, userID)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestUpdateTodoTimeEntryAPI(t *testing.T) {
+	todo := RandomTodo()
+	userID := RandomUserID()
+	entry := RandomTimeEntryOfTodo(todo, userID)
+	otherUserEntry := RandomTimeEntryOfTodo(todo, RandomUserID())
+	otherTodoEntry := RandomTimeEntryOfTodo(todo, userID)
+	otherTodoEntry.TodoID = todo.ID + 1
+
+	note := util.RandomString(10)
+	updatedEntry := entry
+	updatedEntry.Note = note
+
+	tcs := []struct {
+		name               string
+		entryID            int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:    "OK",
+			entryID: entry.ID,
+			body:    gin.H{"note": note},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetTimeEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(entry, nil)
+				arg := db.UpdateTimeEntryParams{
+					Note: &note,
+					ID:   entry.ID,
+				}
+				store.EXPECT().UpdateTimeEntry(gomock.Any(), gomock.Eq(arg)).Times(1).Return(updatedEntry, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTimeEntry(t, recorder.Body, updatedEntry)
+			},
+		},
+		{
+			name:    "EmptyBody",
+			entryID: entry.ID,
+			body:    gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTimeEntry(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateTimeEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: updateTimeEntryInvalidBodyError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:    "EntryOfOtherUser",
+			entryID: otherUserEntry.ID,
+			body:    gin.H{"note": note},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetTimeEntry(gomock.Any(), gomock.Eq(otherUserEntry.ID)).Times(1).Return(otherUserEntry, nil)
+				store.EXPECT().UpdateTimeEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newTimeEntryOfOtherUserError(otherUserEntry.ID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:    "EntryOfOtherTodo",
+			entryID: otherTodoEntry.ID,
+			body:    gin.H{"note": note},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetTimeEntry(gomock.Any(), gomock.Eq(otherTodoEntry.ID)).Times(1).Return(otherTodoEntry, nil)
+				store.EXPECT().UpdateTimeEntry(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newTimeEntryNotAssociatedWithTodoError(todo.ID, otherTodoEntry.ID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusForbidden, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:    "InvalidRange",
+			entryID: entry.ID,
+			body:    gin.H{"stoppedAt": entry.StartedAt.Add(-time.Minute)},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().GetTimeEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(entry, nil)
+				store.EXPECT().
+					UpdateTimeEntry(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.TimeEntry{}, &pgconn.PgError{Code: db.CheckViolation})
+			},
+			errorExpected: true,
+			expectedError: timeEntryInvalidRangeError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/time-entries/%d", todo.ID, tc.entryID)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+			request.Header.Set(UserIDHeader, userID)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestDeleteTodoTimeEntryAPI(t *testing.T) {
+	todo := RandomTodo()
+	userID := RandomUserID()
+	entry := RandomTimeEntryOfTodo(todo, userID)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+	store.EXPECT().GetTimeEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(entry, nil)
+	store.EXPECT().DeleteTimeEntry(gomock.Any(), gomock.Eq(entry.ID)).Times(1).Return(nil)
+
+	server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	url := fmt.Sprintf("/todos/%d/time-entries/%d", todo.ID, entry.ID)
+	request, err := http.NewRequest(http.MethodDelete, url, nil)
+	assert.NoError(t, err)
+	request.Header.Set(UserIDHeader, userID)
+
+	server.router.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -2,10 +2,15 @@ package api
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/todoquery"
 )
 
 type getTodoRequest struct {
@@ -15,11 +20,13 @@ type getTodoRequest struct {
 // GetTodo godoc
 //
 //	@Summary		Returns a Todo
-//	@Description	Get todo by TodoID
+//	@Description	Get todo by TodoID; 'fields' trims the todo down to a sparse fieldset, always keeping todoId, and 'include=attachments' embeds the metadata of its attachments
 //	@Tags			todos
 //	@Produce		json
-//	@Param			todoId	path		int	true	"Todo ID"	minimum(1)
-//	@Success		200		{object}	db.Todo
+//	@Param			todoId	path		int		true	"Todo ID"	minimum(1)
+//	@Param			fields	query		string	false	"comma separated fields of the todo to return, such as 'title,status'"
+//	@Param			include	query		string	false	"comma separated relations to embed"	Enums(attachments)
+//	@Success		200		{object}	todoResponse
 //	@Failure		400
 //	@Failure		404
 //	@Failure		500
@@ -31,27 +38,53 @@ func (server *Server) getTodo(ctx *gin.Context) {
 		return
 	}
 
+	var options todoResponseOptions
+	if err := ctx.ShouldBindQuery(&options); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	shape, err := options.shape()
+	if err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
 	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
 	if todo == nil {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, todo)
+	resp := server.buildShapedTodoResponsesAndHandleErrors(ctx, []db.Todo{*todo}, shape)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, shape.trim(resp[0]))
 }
 
 type createTodoRequest struct {
-	Title string `json:"title" binding:"required,max=255"`
+	Title       string     `json:"title" binding:"required,max=255"`
+	DueAt       *time.Time `json:"dueAt"`
+	DueTimezone *string    `json:"dueTimezone" binding:"omitempty,timezone"`
+	Priority    int16      `json:"priority" binding:"min=0,max=3"`
+	ParentID    *int64     `json:"parentId" binding:"omitempty,min=1"`
+	ProjectID   *int64     `json:"projectId" binding:"omitempty,min=1"`
+	Description string     `json:"description" binding:"max=10000"`
+	// DuplicateCheck looks for open todos with a similar title: 'warn' reports them along with the created todo,
+	// 'strict' doesn't create the todo if there are any
+	DuplicateCheck string `json:"duplicateCheck" binding:"omitempty,oneof=warn strict"`
 }
 
 // createTodo godoc
 //
 //	@Summary		Creates a Todo
-//	@Description	Creates a todo with the specified title
+//	@Description	Creates a todo with the specified title, optional due date, priority (0 none, 1 low, 2 medium, 3 high), parent todo, project and Markdown description; the todo starts in the first state of its workflow and is placed at the end of the manual order
 //	@Tags			todos
 //	@Accept			json
 //	@Produce		json
-//	@Param			todo	body		createTodoRequest	true	"Todo title"
-//	@Success		200		{object}	db.Todo
+//	@Param			todo	body		createTodoRequest	true	"Todo title/due date/priority/parent/project/description"
+//	@Success		200		{object}	todoResponse
 //	@Failure		400
 //	@Failure		500
 //	@Router			/todos [post]
@@ -62,34 +95,226 @@ func (server *Server) createTodo(ctx *gin.Context) {
 		return
 	}
 
+	if req.ProjectID != nil {
+		_, err := server.store.GetProject(ctx, *req.ProjectID)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				NewHTTPError(ctx, http.StatusBadRequest, unknownProjectError)
+				return
+			}
+
+			NewHTTPError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	var possibleDuplicates []similarTodoResponse
+	if req.DuplicateCheck != "" {
+		possibleDuplicates = server.listSimilarTodosAndHandleErrors(ctx, req.Title, server.duplicateThreshold(), DefaultMaxSimilarTodos)
+		if possibleDuplicates == nil {
+			return
+		}
+
+		if req.DuplicateCheck == DuplicateCheckStrict && len(possibleDuplicates) > 0 {
+			ctx.JSON(http.StatusConflict, duplicateTodoConflictResponse{
+				HTTPError: HTTPError{
+					Message: newPossibleDuplicateTodosError(len(possibleDuplicates)).Error(),
+				},
+				PossibleDuplicates: possibleDuplicates,
+			})
+			return
+		}
+	}
+
 	result, err := server.store.CreateTodoTx(ctx, db.CreateTodoTxParams{
-		TodoTitle: req.Title,
-		Storage:   server.storage,
+		TodoTitle:   req.Title,
+		DueAt:       req.DueAt,
+		DueTimezone: req.DueTimezone,
+		Priority:    req.Priority,
+		ParentID:    req.ParentID,
+		ProjectID:   req.ProjectID,
+		Description: req.Description,
+		Storage:     server.storage,
 	})
 	if err != nil {
+		if db.ErrorCode(err) == db.ForeignKeyViolation {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownParentTodoError)
+			return
+		}
+
 		NewHTTPError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, result.Todo)
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, createTodoResponse{
+		todoResponse:       *resp,
+		PossibleDuplicates: possibleDuplicates,
+	})
 }
 
 type listTodoRequest struct {
-	PageID   int32 `form:"pageId" binding:"required,min=1"`
-	PageSize int32 `form:"pageSize" binding:"required,min=5,max=10"`
+	listTodoPaging
+	listTodoQuery
+}
+
+// listTodoPaging pages through the listed todos
+type listTodoPaging struct {
+	PageID    int32  `form:"pageId" binding:"omitempty,min=1"`
+	PageSize  int32  `form:"pageSize" binding:"omitempty,min=1"`
+	Cursor    string `form:"cursor" binding:"max=2048"`
+	WithTotal bool   `form:"withTotal"`
+}
+
+// listTodoQuery selects the listed todos and their order; smart lists save it
+type listTodoQuery struct {
+	Tags     []int64 `form:"tags" binding:"omitempty,dive,min=1"`
+	TagMatch string  `form:"tagMatch" binding:"omitempty,oneof=any all"`
+	Overdue  *bool   `form:"overdue"`
+	Archived bool    `form:"archived"`
+	Assignee string  `form:"assignee" binding:"max=64"`
+	Watching bool    `form:"watching"`
+	listTodoFilters
+}
+
+// listTodoFilters narrow down and sort the listed todos
+type listTodoFilters struct {
+	Statuses       []string   `form:"status" binding:"omitempty,dive,min=1,max=20"`
+	CreatedFrom    *time.Time `form:"createdFrom"`
+	CreatedTo      *time.Time `form:"createdTo"`
+	UpdatedFrom    *time.Time `form:"updatedFrom"`
+	UpdatedTo      *time.Time `form:"updatedTo"`
+	Title          string     `form:"title" binding:"max=255"`
+	HasAttachments *bool      `form:"hasAttachments"`
+	MinFileCount   *int32     `form:"minFileCount" binding:"omitempty,min=0"`
+	MaxFileCount   *int32     `form:"maxFileCount" binding:"omitempty,min=0"`
+	Sort           string     `form:"sort" binding:"max=255"`
+	// Query holds filters in the compact query language, such as 'status:incomplete tag:work -tag:someday'
+	Query string `form:"q" binding:"max=1024"`
+}
+
+// apply validates the filters and the sort order, by any of the sort fields, and sets them on the list parameters
+func (filters listTodoFilters) apply(arg *db.ListTodosParams, sortFields []string) error {
+	if filters.CreatedFrom != nil && filters.CreatedTo != nil && filters.CreatedTo.Before(*filters.CreatedFrom) {
+		return newFilterInvalidRangeError("createdFrom", "createdTo")
+	}
+	if filters.UpdatedFrom != nil && filters.UpdatedTo != nil && filters.UpdatedTo.Before(*filters.UpdatedFrom) {
+		return newFilterInvalidRangeError("updatedFrom", "updatedTo")
+	}
+	if filters.MinFileCount != nil && filters.MaxFileCount != nil && *filters.MaxFileCount < *filters.MinFileCount {
+		return newFilterInvalidRangeError("minFileCount", "maxFileCount")
+	}
+
+	sort, err := parseTodoSort(filters.Sort, sortFields)
+	if err != nil {
+		return err
+	}
+
+	if len(filters.Statuses) > 0 {
+		arg.Statuses = filters.Statuses
+	}
+	arg.CreatedFrom = filters.CreatedFrom
+	arg.CreatedTo = filters.CreatedTo
+	arg.UpdatedFrom = filters.UpdatedFrom
+	arg.UpdatedTo = filters.UpdatedTo
+	if filters.Title != "" {
+		arg.TitleContains = &filters.Title
+	}
+	arg.HasAttachments = filters.HasAttachments
+	arg.MinFileCount = filters.MinFileCount
+	arg.MaxFileCount = filters.MaxFileCount
+	arg.Sort = sort
+
+	if filters.Query != "" {
+		query, err := todoquery.Parse(filters.Query)
+		if err != nil {
+			return err
+		}
+
+		if err := query.Apply(arg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseTodoSort parses a comma separated list of sort fields, each prefixed with '-' for descending order
+func parseTodoSort(sort string, sortFields []string) ([]db.TodoSort, error) {
+	if sort == "" {
+		return nil, nil
+	}
+
+	fields := strings.Split(sort, ",")
+	result := make([]db.TodoSort, 0, len(fields))
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		// '+' decodes to a space in query strings
+		field = strings.TrimPrefix(strings.TrimSpace(field), "+")
+
+		descending := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+
+		if !slices.Contains(sortFields, field) {
+			return nil, newUnknownSortFieldError(field, sortFields)
+		}
+		if seen[field] {
+			return nil, newDuplicateSortFieldError(field)
+		}
+		seen[field] = true
+
+		result = append(result, db.TodoSort{
+			Field:      field,
+			Descending: descending,
+		})
+	}
+
+	return result, nil
 }
 
 // listTodo godoc
 //
 //	@Summary		List todos
-//	@Description	List todos based on page ID and page size
+//	@Description	List todos page by page, optionally filtered by any/all of the tags, overdue state, assignee, the todos watched by the user, statuses, creation/update time ranges, title substring and attachments; archived todos are only listed with 'archived' set.
+//	@Description	Todos are listed in their manual order unless sorted by a comma separated list of fields, each prefixed with '-' for descending order, such as 'sort=-createdAt,title'. Sort fields: createdAt, updatedAt, title, status, dueAt, completedAt, priority, fileCount, position.
+//	@Description	Filters can also be typed in the compact query language with 'q', such as 'q=status:incomplete tag:work created:>2024-06-01 -tag:someday "quarterly report"': words and quoted texts match the title or description, '-' negates a term, and invalid queries are reported along with the column of the error.
+//	@Description	'fields' trims the todos down to a sparse fieldset, such as 'fields=title,status', always keeping todoId, and 'include=attachments' embeds the metadata of their attachments, loaded in a single query for the whole page.
+//	@Description	Without 'pageId', the todos are paged by cursors: the page lists the cursors of the next and previous pages, also given as RFC 8288 Link headers, and the total count of the todos when 'withTotal' is set. With 'pageId', the page of 5 to 10 todos is skipped to by offset and returned as an array, for backward compatibility
 //	@Tags			todos
 //	@Produce		json
 //
-//	@Param			pageId		query	int	true	"page ID"	minimum(1)
-//	@Param			pageSize	query	int	true	"page size"	minimum(5)	maximum(10)
+//	@Param			pageId			query	int			false	"page ID; pages by offset"		minimum(1)
+//	@Param			pageSize		query	int			false	"page size; 5 to 10 when paging by offset, up to the configured maximum page size otherwise"	minimum(1)	default(20)
+//	@Param			cursor			query	string		false	"cursor of the page, taken from the previous page"
+//	@Param			withTotal		query	bool		false	"total count of the todos in the response and the X-Total-Count header"	default(false)
+//	@Param			tags			query	[]int		false	"tag IDs"						collectionFormat(multi)
+//	@Param			tagMatch		query	string		false	"match any or all of the tags"	Enums(any, all)	default(any)
+//	@Param			overdue			query	bool		false	"overdue todos only if true, not overdue todos only if false"
+//	@Param			archived		query	bool		false	"archived todos instead of the active ones"	default(false)
+//	@Param			assignee		query	string		false	"todos assigned to the user; 'me' stands for the user identified by the X-User-ID header"
+//	@Param			watching		query	bool		false	"todos watched by the user identified by the X-User-ID header only"	default(false)
+//	@Param			status			query	[]string	false	"statuses"															collectionFormat(multi)
+//	@Param			createdFrom		query	string		false	"todos created at or after the time"								format(date-time)
+//	@Param			createdTo		query	string		false	"todos created before the time"										format(date-time)
+//	@Param			updatedFrom		query	string		false	"todos updated at or after the time"								format(date-time)
+//	@Param			updatedTo		query	string		false	"todos updated before the time"										format(date-time)
+//	@Param			title			query	string		false	"case insensitive title substring"									maxlength(255)
+//	@Param			hasAttachments	query	bool		false	"todos with attachments only if true, without attachments only if false"
+//	@Param			minFileCount	query	int			false	"minimum number of attachments"	minimum(0)
+//	@Param			maxFileCount	query	int			false	"maximum number of attachments"	minimum(0)
+//	@Param			sort			query	string		false	"sort fields, such as '-createdAt,title'"
+//	@Param			fields			query	string		false	"comma separated fields of the todos to return, such as 'title,status'; todoId is always returned"
+//	@Param			include			query	string		false	"comma separated relations to embed, loaded in a single query for the whole page"	Enums(attachments)
+//	@Param			q				query	string		false	"filters in the compact query language, such as 'status:incomplete tag:work created:>2024-06-01 -tag:someday \"quarterly report\"'; fields: status, tag, created, updated, files, is:overdue, has:attachments"	maxlength(1024)
+//	@Param			X-User-ID		header	string		false	"User ID"
 //
-//	@Success		200			{array}	db.Todo
+//	@Success		200				{object}	listTodoPageResponse{todos=[]todoResponse}
+//	@Header			200				{string}	Link			"first, prev and next pages"
+//	@Header			200				{integer}	X-Total-Count	"total count of the todos with 'withTotal'"
 //	@Failure		400
 //	@Failure		500
 //	@Router			/todos [get]
@@ -101,16 +326,150 @@ func (server *Server) listTodo(ctx *gin.Context) {
 		return
 	}
 
-	todos, err := server.store.ListTodos(ctx, db.ListTodosParams{
-		Limit:  req.PageSize,
-		Offset: (req.PageID - 1) * req.PageSize,
-	})
+	var options todoResponseOptions
+	if err := ctx.ShouldBindQuery(&options); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	server.listTodoPage(ctx, req, options)
+}
+
+// listTodoPage lists the page of todos of the request, the array alone when paging by offset, shaped by the options
+func (server *Server) listTodoPage(ctx *gin.Context, req listTodoRequest, options todoResponseOptions) {
+	shape, err := options.shape()
+	if err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	arg := server.listTodosParamsAndHandleErrors(ctx, req, db.TodoSortFields, nil)
+	if arg == nil {
+		return
+	}
+
+	var totalCount *int64
+	if req.WithTotal {
+		count, err := server.store.CountTodos(ctx, *arg)
+		if err != nil {
+			NewHTTPError(ctx, http.StatusInternalServerError, err)
+			return
+		}
+
+		totalCount = setTotalCountHeader(ctx, count)
+	}
+
+	todos, err := server.store.ListTodos(ctx, *arg)
 	if err != nil {
 		NewHTTPError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, todos)
+	// Paging by offset is kept as it was
+	if req.PageID != 0 {
+		resp := server.buildShapedTodoResponsesAndHandleErrors(ctx, todos, shape)
+		if resp == nil {
+			return
+		}
+
+		ctx.JSON(http.StatusOK, shape.trimAll(resp))
+		return
+	}
+
+	todos, nextCursor, prevCursor := pageRows(ctx, todos, *arg, func(todo db.Todo, backward bool) string {
+		return encodeTodoCursor(db.NewTodoCursor(todo, arg.Sort, backward), arg.Sort)
+	})
+
+	resp := server.buildShapedTodoResponsesAndHandleErrors(ctx, todos, shape)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, listTodoPageResponse{
+		Todos:      shape.trimAll(resp),
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+		TotalCount: totalCount,
+	})
+}
+
+// listTodosParamsAndHandleErrors validates the paging, filters and sort order of the request, by any of the sort
+// fields, and returns the parameters listing the page; paging by cursor fetches one more todo, which tells whether
+// there is a page beyond. The cursors are bound to the sort order of the request, or to the default one. Writes the
+// error response and returns nil otherwise
+func (server *Server) listTodosParamsAndHandleErrors(ctx *gin.Context, req listTodoRequest, sortFields []string, defaultSort []db.TodoSort) *db.ListTodosParams {
+	offsetPaging := req.PageID != 0
+	if offsetPaging {
+		if req.Cursor != "" {
+			NewHTTPError(ctx, http.StatusBadRequest, cursorWithPageIDError)
+			return nil
+		}
+		if req.PageSize < 5 || req.PageSize > 10 {
+			NewHTTPError(ctx, http.StatusBadRequest, pageSizeInvalidError)
+			return nil
+		}
+	} else {
+		if req.PageSize == 0 {
+			req.PageSize = min(DefaultPageSize, server.maxPageSize())
+		}
+		if req.PageSize > server.maxPageSize() {
+			NewHTTPError(ctx, http.StatusBadRequest, newPageSizeTooLargeError(server.maxPageSize()))
+			return nil
+		}
+	}
+
+	arg := db.ListTodosParams{
+		MatchAllTags: req.TagMatch == TagMatchAll,
+		Overdue:      req.Overdue,
+		Archived:     req.Archived,
+	}
+	if len(req.Tags) > 0 {
+		arg.TagIds = uniqueIDs(req.Tags)
+	}
+	if err := req.listTodoFilters.apply(&arg, sortFields); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return nil
+	}
+	if req.Assignee != "" {
+		arg.Assignee = &req.Assignee
+	}
+
+	// Both 'assignee=me' and 'watching' refer to the user of the request
+	if req.Assignee == AssigneeMe || req.Watching {
+		userID := currentUserAndHandleErrors(ctx)
+		if userID == "" {
+			return nil
+		}
+
+		if req.Assignee == AssigneeMe {
+			arg.Assignee = &userID
+		}
+		if req.Watching {
+			arg.Watcher = &userID
+		}
+	}
+
+	if offsetPaging {
+		arg.Limit = req.PageSize
+		arg.Offset = (req.PageID - 1) * req.PageSize
+		return &arg
+	}
+
+	if len(arg.Sort) == 0 {
+		arg.Sort = defaultSort
+	}
+	if req.Cursor != "" {
+		cursor, err := decodeTodoCursor(req.Cursor, arg.Sort)
+		if err != nil {
+			NewHTTPError(ctx, http.StatusBadRequest, err)
+			return nil
+		}
+
+		arg.Cursor = cursor
+	}
+	arg.Limit = req.PageSize + 1
+
+	return &arg
 }
 
 type updateTodoRequestURIParams struct {
@@ -118,22 +477,28 @@ type updateTodoRequestURIParams struct {
 }
 
 type updateTodoRequestBody struct {
-	Title  *string `json:"title" binding:"omitempty,max=255"`
-	Status *string `json:"status" binding:"omitempty,todoStatus"`
+	Title       *string      `json:"title" binding:"omitempty,max=255"`
+	Status      *string      `json:"status" binding:"omitempty,min=1,max=20"`
+	DueAt       optionalTime `json:"dueAt" swaggertype:"string" format:"date-time" extensions:"x-nullable"`
+	DueTimezone *string      `json:"dueTimezone" binding:"omitempty,timezone"`
+	Priority    *int16       `json:"priority" binding:"omitempty,min=0,max=3"`
+	Description *string      `json:"description" binding:"omitempty,max=10000"`
+	Force       bool         `json:"force"`
 }
 
 // updateTodoTitleStatus godoc
 //
-//	@Summary		Updated the todo title/status
-//	@Description	Updates the todo title/status
+//	@Summary		Updated the todo title/status/due date/priority/description
+//	@Description	Updates the todo title/status/due date/priority/description; a null dueAt clears the due date. The status must be a state of the todo's workflow reachable from its current status, and every status change is recorded. A todo with open blockers can't be completed unless 'force' is set. Completing a recurring todo creates its next instance
 //	@Tags			todos
 //	@Accept			json
 //	@Produce		json
 //	@Param			todoId	path		int						true	"Todo ID"	minimum(1)
-//	@Param			todo	body		updateTodoRequestBody	true	"Todo title/status"
-//	@Success		200		{object}	db.Todo
+//	@Param			todo	body		updateTodoRequestBody	true	"Todo title/status/due date/priority/description"
+//	@Success		200		{object}	todoResponse
 //	@Failure		400
 //	@Failure		404
+//	@Failure		409
 //	@Failure		500
 //	@Router			/todos/{todoId} [patch]
 func (server *Server) updateTodoTitleStatus(ctx *gin.Context) {
@@ -151,16 +516,27 @@ func (server *Server) updateTodoTitleStatus(ctx *gin.Context) {
 		return
 	}
 
-	// Update at least one of title or status
-	if reqBody.Title == nil && reqBody.Status == nil {
+	// Update at least one of title, status, due date, priority or description
+	if reqBody.Title == nil && reqBody.Status == nil && !reqBody.DueAt.Present && reqBody.DueTimezone == nil && reqBody.Priority == nil && reqBody.Description == nil {
 		NewHTTPError(ctx, http.StatusBadRequest, updateTodoTitleStatusInvalidBodyError)
 		return
 	}
 
-	todo, err := server.store.UpdateTodoTitleStatus(ctx, db.UpdateTodoTitleStatusParams{
-		ID:     reqURIParams.TodoID,
-		Title:  reqBody.Title,
-		Status: reqBody.Status,
+	result, err := server.store.UpdateTodoTx(ctx, db.UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: db.UpdateTodoTitleStatusParams{
+			ID:          reqURIParams.TodoID,
+			Title:       reqBody.Title,
+			Status:      reqBody.Status,
+			UpdateDueAt: reqBody.DueAt.Present,
+			DueAt:       reqBody.DueAt.Value,
+			DueTimezone: reqBody.DueTimezone,
+			Priority:    reqBody.Priority,
+			Description: reqBody.Description,
+		},
+		// Optionally keep a todo open until all of its subtasks are complete
+		RequireCompleteSubtasks: server.config.RequireCompleteSubtasks,
+		Force:                   reqBody.Force,
+		Storage:                 server.storage,
 	})
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
@@ -171,11 +547,123 @@ func (server *Server) updateTodoTitleStatus(ctx *gin.Context) {
 			return
 		}
 
+		if errors.Is(err, db.ErrUnknownTodoStatus) {
+			NewHTTPError(ctx, http.StatusBadRequest, unknownTodoStatusError)
+			return
+		}
+
+		if errors.Is(err, db.ErrTodoTransitionNotAllowed) {
+			NewHTTPError(ctx, http.StatusConflict, newTodoTransitionNotAllowedError(*reqBody.Status))
+			return
+		}
+
+		if errors.Is(err, db.ErrTodoBlocked) {
+			NewHTTPError(ctx, http.StatusConflict, todoBlockedError)
+			return
+		}
+
+		if errors.Is(err, db.ErrTodoHasIncompleteSubtasks) {
+			NewHTTPError(ctx, http.StatusConflict, incompleteSubtasksError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	server.notifyTodoChange(ctx, result.Todo.ID,
+		fmt.Sprintf("Todo updated: %s", result.Todo.Title),
+		fmt.Sprintf("Todo '%s' was updated: %s", result.Todo.Title, strings.Join(updatedTodoFields(reqBody), ", ")),
+	)
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type moveTodoRequestURIParams struct {
+	getTodoRequest
+}
+
+type moveTodoRequestBody struct {
+	Before *int64 `json:"before" binding:"omitempty,min=1"`
+	After  *int64 `json:"after" binding:"omitempty,min=1"`
+}
+
+// moveTodo godoc
+//
+//	@Summary		Moves a Todo
+//	@Description	Places the todo right before or right after the anchor todo in the manual order; exactly one of 'before' or 'after' must be provided
+//	@Tags			todos
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId	path		int					true	"Todo ID"	minimum(1)
+//	@Param			anchor	body		moveTodoRequestBody	true	"Anchor todo ID"
+//	@Success		200		{object}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/move [post]
+func (server *Server) moveTodo(ctx *gin.Context) {
+	var reqURIParams moveTodoRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody moveTodoRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// Exactly one anchor is expected
+	if (reqBody.Before == nil) == (reqBody.After == nil) {
+		NewHTTPError(ctx, http.StatusBadRequest, moveTodoInvalidBodyError)
+		return
+	}
+
+	anchorID := reqBody.Before
+	if anchorID == nil {
+		anchorID = reqBody.After
+	}
+	if *anchorID == reqURIParams.TodoID {
+		NewHTTPError(ctx, http.StatusBadRequest, moveTodoRelativeToItselfError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	result, err := server.store.MoveTodoTx(ctx, db.MoveTodoTxParams{
+		TodoID:   reqURIParams.TodoID,
+		BeforeID: reqBody.Before,
+		AfterID:  reqBody.After,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           *anchorID,
+			})
+			return
+		}
+
 		NewHTTPError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, todo)
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
 }
 
 type deleteTodoRequest struct {
@@ -184,8 +672,8 @@ type deleteTodoRequest struct {
 
 // deleteTodo godoc
 //
-//	@Summary		Deletes a Todo
-//	@Description	Delete todo by TodoID
+//	@Summary		Trashes a Todo
+//	@Description	Moves the todo along with all of its subtasks to the trash; trashed todos can be restored until they are purged with their attachments after the retention period
 //	@Tags			todos
 //	@Accept			json
 //	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
@@ -206,15 +694,16 @@ func (server *Server) deleteTodo(ctx *gin.Context) {
 		return
 	}
 
-	err := server.store.DeleteTodoTx(ctx, db.DeleteTodoTxParams{
-		TodoID:  req.TodoID,
-		Storage: server.storage,
-	})
-	if err != nil {
+	if err := server.store.TrashTodo(ctx, req.TodoID); err != nil {
 		NewHTTPError(ctx, http.StatusInternalServerError, err)
 		return
 	}
 
+	server.notifyTodoChange(ctx, todo.ID,
+		fmt.Sprintf("Todo trashed: %s", todo.Title),
+		fmt.Sprintf("Todo '%s' was moved to the trash", todo.Title),
+	)
+
 	ctx.JSON(http.StatusOK, nil)
 }
 
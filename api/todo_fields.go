@@ -0,0 +1,140 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+// todoIncludes are the relations the todo responses can embed
+var todoIncludes = []string{TodoIncludeAttachments}
+
+// todoFields are the fields of the todo responses a sparse fieldset can select
+var todoFields = slices.DeleteFunc(jsonKeys(reflect.TypeOf(todoResponse{})), func(key string) bool {
+	return slices.Contains(todoIncludes, key)
+})
+
+// jsonKeys lists the JSON keys of the fields of the struct, those of its embedded structs included
+func jsonKeys(t reflect.Type) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			keys = append(keys, jsonKeys(field.Type)...)
+			continue
+		}
+
+		if key, _, _ := strings.Cut(field.Tag.Get("json"), ","); key != "" && key != "-" {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// todoResponseOptions trim the todo responses down to a sparse fieldset and embed relations of the todos
+type todoResponseOptions struct {
+	Fields  string `form:"fields" binding:"max=1024"`
+	Include string `form:"include" binding:"max=255"`
+}
+
+// todoShape is the validated form of the todo response options
+type todoShape struct {
+	// fields are the selected fields, every field being kept when nil
+	fields             []string
+	includeAttachments bool
+}
+
+// shape validates the comma separated lists of fields and relations
+func (options todoResponseOptions) shape() (todoShape, error) {
+	var shape todoShape
+
+	fields, err := parseCommaSeparated(options.Fields, todoFields, newUnknownTodoFieldError)
+	if err != nil {
+		return shape, err
+	}
+	shape.fields = fields
+
+	includes, err := parseCommaSeparated(options.Include, todoIncludes, newUnknownTodoIncludeError)
+	if err != nil {
+		return shape, err
+	}
+	shape.includeAttachments = slices.Contains(includes, TodoIncludeAttachments)
+
+	return shape, nil
+}
+
+// parseCommaSeparated parses a comma separated list of the allowed values, ignoring blanks and repetitions; returns
+// nil if the list is empty
+func parseCommaSeparated[E error](list string, allowed []string, newUnknownError func(value string, allowed []string) E) ([]string, error) {
+	var values []string
+	for _, value := range strings.Split(list, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" || slices.Contains(values, value) {
+			continue
+		}
+
+		if !slices.Contains(allowed, value) {
+			return nil, newUnknownError(value, allowed)
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// embedAttachments embeds the metadata of their attachments into the todo responses, which lists every attachment
+// of the todos
+func embedAttachments(resp []todoResponse, attachments []db.Attachment) {
+	attachmentsByTodoID := make(map[int64][]getTodoAttachmentMetadataResponse)
+	for _, attachment := range attachments {
+		attachmentsByTodoID[attachment.TodoID] = append(attachmentsByTodoID[attachment.TodoID], getTodoAttachmentMetadataResponse{
+			ID:       attachment.ID,
+			TodoID:   attachment.TodoID,
+			Filename: attachment.OriginalFilename,
+		})
+	}
+
+	for i := range resp {
+		todoAttachments := attachmentsByTodoID[resp[i].ID]
+		if todoAttachments == nil {
+			todoAttachments = []getTodoAttachmentMetadataResponse{}
+		}
+		resp[i].Attachments = &todoAttachments
+	}
+}
+
+// trim trims the todo response down to the selected fields, keeping the ID of the todo and the embedded relations;
+// the response is kept as it is without a sparse fieldset
+func (shape todoShape) trim(resp todoResponse) any {
+	if shape.fields == nil {
+		return resp
+	}
+
+	// Marshalling the response and unmarshalling it into a map can't fail
+	data, _ := json.Marshal(resp)
+	var all map[string]json.RawMessage
+	_ = json.Unmarshal(data, &all)
+
+	trimmed := make(map[string]json.RawMessage, len(shape.fields)+2)
+	for key, value := range all {
+		if key == "todoId" || slices.Contains(todoIncludes, key) || slices.Contains(shape.fields, key) {
+			trimmed[key] = value
+		}
+	}
+
+	return trimmed
+}
+
+// trimAll trims each of the todo responses down to the selected fields
+func (shape todoShape) trimAll(resp []todoResponse) []any {
+	trimmed := make([]any, 0, len(resp))
+	for _, todo := range resp {
+		trimmed = append(trimmed, shape.trim(todo))
+	}
+
+	return trimmed
+}
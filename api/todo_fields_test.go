@@ -0,0 +1,70 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoResponseOptionsShape(t *testing.T) {
+	tcs := []struct {
+		name          string
+		options       todoResponseOptions
+		expectedShape todoShape
+		expectedError error
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name:          "Fields",
+			options:       todoResponseOptions{Fields: " title,,status,title ", Include: "attachments"},
+			expectedShape: todoShape{fields: []string{"title", "status"}, includeAttachments: true},
+		},
+		{
+			name:          "DerivedField",
+			options:       todoResponseOptions{Fields: "checklist"},
+			expectedShape: todoShape{fields: []string{"checklist"}},
+		},
+		{
+			name:          "IncludeAsField",
+			options:       todoResponseOptions{Fields: "attachments"},
+			expectedError: newUnknownTodoFieldError("attachments", todoFields),
+		},
+		{
+			name:          "UnknownInclude",
+			options:       todoResponseOptions{Include: "tags"},
+			expectedError: newUnknownTodoIncludeError("tags", todoIncludes),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			shape, err := tc.options.shape()
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedShape, shape)
+		})
+	}
+}
+
+func TestTodoShapeTrim(t *testing.T) {
+	resp := todoResponse{Todo: RandomTodo(), DescriptionHTML: "<p>notes</p>"}
+
+	require.Equal(t, resp, todoShape{}.trim(resp))
+
+	data, err := json.Marshal(todoShape{fields: []string{"descriptionHtml"}}.trim(resp))
+	require.NoError(t, err)
+
+	var trimmed map[string]any
+	require.NoError(t, json.Unmarshal(data, &trimmed))
+	require.Equal(t, map[string]any{
+		"todoId":          float64(resp.ID),
+		"descriptionHtml": "<p>notes</p>",
+	}, trimmed)
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/markdown"
+)
+
+// todoResponse is a todo along with the fields derived from it
+type todoResponse struct {
+	db.Todo
+	DescriptionHTML            string           `json:"descriptionHtml"`
+	BrokenAttachmentReferences []string         `json:"brokenAttachmentReferences"`
+	Overdue                    bool             `json:"overdue"`
+	Blocked                    bool             `json:"blocked"`
+	Subtasks                   subtasksSummary  `json:"subtasks"`
+	Checklist                  checklistSummary `json:"checklist"`
+	// Attachments are embedded on request only
+	Attachments *[]getTodoAttachmentMetadataResponse `json:"attachments,omitempty"`
+}
+
+// subtasksSummary counts the direct subtasks of a todo
+type subtasksSummary struct {
+	Total     int32 `json:"total"`
+	Completed int32 `json:"completed"`
+}
+
+// checklistSummary counts the checklist items of a todo
+type checklistSummary struct {
+	Total   int32 `json:"total"`
+	Checked int32 `json:"checked"`
+}
+
+// newTodoResponse builds the response of the todo; attachmentIDs are the attachments of the todo which its description may reference
+func newTodoResponse(todo db.Todo, rollup db.ListTodoRollupsRow, attachmentIDs []int64) todoResponse {
+	now := time.Now()
+
+	// Render due date in the todo's timezone
+	if todo.DueAt != nil && todo.DueTimezone != nil {
+		if loc, err := time.LoadLocation(*todo.DueTimezone); err == nil {
+			dueAt := todo.DueAt.In(loc)
+			todo.DueAt = &dueAt
+		}
+	}
+
+	descriptionHTML, brokenReferences := markdown.ToHTMLWithAttachments(todo.Description, func(attachmentID int64) (string, bool) {
+		return attachmentURL(todo.ID, attachmentID), slices.Contains(attachmentIDs, attachmentID)
+	})
+
+	return todoResponse{
+		Todo:                       todo,
+		DescriptionHTML:            descriptionHTML,
+		BrokenAttachmentReferences: brokenReferences,
+		Overdue:                    isOverdue(todo, now),
+		Blocked:                    rollup.OpenBlockers > 0,
+		Subtasks: subtasksSummary{
+			Total:     rollup.ChildrenTotal,
+			Completed: rollup.ChildrenCompleted,
+		},
+		Checklist: checklistSummary{
+			Total:   rollup.ChecklistTotal,
+			Checked: rollup.ChecklistChecked,
+		},
+	}
+}
+
+func newTodoResponses(todos []db.Todo, rollups []db.ListTodoRollupsRow, attachments []db.Attachment) []todoResponse {
+	rollupByTodoID := make(map[int64]db.ListTodoRollupsRow, len(rollups))
+	for _, rollup := range rollups {
+		rollupByTodoID[rollup.TodoID] = rollup
+	}
+
+	attachmentIDsByTodoID := make(map[int64][]int64)
+	for _, attachment := range attachments {
+		attachmentIDsByTodoID[attachment.TodoID] = append(attachmentIDsByTodoID[attachment.TodoID], attachment.ID)
+	}
+
+	resp := make([]todoResponse, 0, len(todos))
+	for _, todo := range todos {
+		resp = append(resp, newTodoResponse(todo, rollupByTodoID[todo.ID], attachmentIDsByTodoID[todo.ID]))
+	}
+
+	return resp
+}
+
+func isOverdue(todo db.Todo, now time.Time) bool {
+	return todo.DueAt != nil && todo.DueAt.Before(now) && todo.CompletedAt == nil
+}
+
+// attachmentURL is the download URL of an attachment of the todo
+func attachmentURL(todoID, attachmentID int64) string {
+	return fmt.Sprintf("/todos/%d/attachments/%d", todoID, attachmentID)
+}
+
+// buildTodoResponsesAndHandleErrors fetches the roll-ups of the todos, and the attachments of those whose description
+// references any, in a single query each and builds their responses; writes the error response and returns nil on failure
+func (server *Server) buildTodoResponsesAndHandleErrors(ctx *gin.Context, todos []db.Todo) []todoResponse {
+	return server.buildShapedTodoResponsesAndHandleErrors(ctx, todos, todoShape{})
+}
+
+// buildShapedTodoResponsesAndHandleErrors builds the responses of the todos the way buildTodoResponsesAndHandleErrors
+// does, fetching the attachments of every todo in the same single query when the shape embeds them
+func (server *Server) buildShapedTodoResponsesAndHandleErrors(ctx *gin.Context, todos []db.Todo, shape todoShape) []todoResponse {
+	todoIDs := make([]int64, 0, len(todos))
+	for _, todo := range todos {
+		todoIDs = append(todoIDs, todo.ID)
+	}
+
+	rollups, err := server.store.ListTodoRollups(ctx, todoIDs)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	attachmentTodoIDs := todoIDs
+	if !shape.includeAttachments {
+		attachmentTodoIDs = nil
+		for _, todo := range todos {
+			if markdown.HasAttachmentReferences(todo.Description) {
+				attachmentTodoIDs = append(attachmentTodoIDs, todo.ID)
+			}
+		}
+	}
+
+	var attachments []db.Attachment
+	if len(attachmentTodoIDs) > 0 {
+		attachments, err = server.store.ListAttachmentsOfTodos(ctx, attachmentTodoIDs)
+		if err != nil {
+			NewHTTPError(ctx, http.StatusInternalServerError, err)
+			return nil
+		}
+	}
+
+	resp := newTodoResponses(todos, rollups, attachments)
+	if shape.includeAttachments {
+		embedAttachments(resp, attachments)
+	}
+
+	return resp
+}
+
+func (server *Server) buildTodoResponseAndHandleErrors(ctx *gin.Context, todo db.Todo) *todoResponse {
+	resp := server.buildTodoResponsesAndHandleErrors(ctx, []db.Todo{todo})
+	if resp == nil {
+		return nil
+	}
+
+	return &resp[0]
+}
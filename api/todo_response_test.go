@@ -0,0 +1,126 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTodoResponseOverdue(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tcs := []struct {
+		name            string
+		dueAt           *time.Time
+		completedAt     *time.Time
+		expectedOverdue bool
+	}{
+		{
+			name:            "NoDueDate",
+			dueAt:           nil,
+			completedAt:     nil,
+			expectedOverdue: false,
+		},
+		{
+			name:            "DueInFuture",
+			dueAt:           &future,
+			completedAt:     nil,
+			expectedOverdue: false,
+		},
+		{
+			name:            "DueInPast",
+			dueAt:           &past,
+			completedAt:     nil,
+			expectedOverdue: true,
+		},
+		{
+			name:            "DueInPastButComplete",
+			dueAt:           &past,
+			completedAt:     &past,
+			expectedOverdue: false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			todo := RandomTodo()
+			todo.DueAt = tc.dueAt
+			todo.CompletedAt = tc.completedAt
+
+			require.Equal(t, tc.expectedOverdue, newTodoResponse(todo, db.ListTodoRollupsRow{}, nil).Overdue)
+		})
+	}
+}
+
+func TestNewTodoResponseBlocked(t *testing.T) {
+	todo := RandomTodo()
+
+	require.False(t, newTodoResponse(todo, db.ListTodoRollupsRow{}, nil).Blocked)
+	require.True(t, newTodoResponse(todo, db.ListTodoRollupsRow{OpenBlockers: 1}, nil).Blocked)
+}
+
+func TestNewTodoResponseDueTimezone(t *testing.T) {
+	dueAt := time.Date(2030, time.January, 1, 10, 0, 0, 0, time.UTC)
+	timezone := "Asia/Kolkata"
+
+	todo := RandomTodo()
+	todo.DueAt = &dueAt
+	todo.DueTimezone = &timezone
+
+	resp := newTodoResponse(todo, db.ListTodoRollupsRow{}, nil)
+	require.True(t, dueAt.Equal(*resp.DueAt))
+	require.Equal(t, timezone, resp.DueAt.Location().String())
+}
+
+func TestNewTodoResponsesSubtasks(t *testing.T) {
+	parent := RandomTodo()
+	leaf := RandomTodo()
+	leaf.ID = parent.ID + 1
+
+	rollups := []db.ListTodoRollupsRow{
+		{TodoID: parent.ID, ChildrenTotal: 3, ChildrenCompleted: 1},
+	}
+
+	resp := newTodoResponses([]db.Todo{parent, leaf}, rollups, nil)
+	require.Len(t, resp, 2)
+	require.Equal(t, subtasksSummary{Total: 3, Completed: 1}, resp[0].Subtasks)
+	require.Equal(t, subtasksSummary{}, resp[1].Subtasks)
+}
+
+func TestNewTodoResponsesChecklist(t *testing.T) {
+	todo := RandomTodo()
+
+	rollups := []db.ListTodoRollupsRow{
+		{TodoID: todo.ID, ChecklistTotal: 4, ChecklistChecked: 3},
+	}
+
+	resp := newTodoResponses([]db.Todo{todo}, rollups, nil)
+	require.Len(t, resp, 1)
+	require.Equal(t, checklistSummary{Total: 4, Checked: 3}, resp[0].Checklist)
+}
+
+func TestNewTodoResponsesDescription(t *testing.T) {
+	todo := RandomTodo()
+	todo.Description = "**Steps**\n\n![diagram](attachment:42) and [spec](attachment:7)"
+	other := RandomTodo()
+	other.ID = todo.ID + 1
+
+	attachments := []db.Attachment{
+		{ID: 42, TodoID: todo.ID},
+		{ID: 7, TodoID: other.ID},
+	}
+
+	resp := newTodoResponses([]db.Todo{todo, other}, nil, attachments)
+	require.Len(t, resp, 2)
+	require.Equal(t, todo.Description, resp[0].Description)
+	require.Contains(t, resp[0].DescriptionHTML, "<strong>Steps</strong>")
+	require.Contains(t, resp[0].DescriptionHTML, attachmentURL(todo.ID, 42))
+
+	// Attachments of other todos can't be referenced
+	require.Equal(t, []string{"attachment:7"}, resp[0].BrokenAttachmentReferences)
+	require.Empty(t, resp[1].DescriptionHTML)
+	require.Empty(t, resp[1].BrokenAttachmentReferences)
+}
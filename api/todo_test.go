@@ -8,13 +8,18 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
 	mockdb "github.com/jaingounchained/todo/db/mock"
 	db "github.com/jaingounchained/todo/db/sqlc"
+	mockNotification "github.com/jaingounchained/todo/notification/mock"
 	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/todoquery"
 	"github.com/jaingounchained/todo/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -25,9 +30,24 @@ func RandomTodo() db.Todo {
 		Title:     util.RandomString(10),
 		Status:    util.RandomStatus(),
 		FileCount: 0,
+		Priority:  int16(util.RandomInt(0, 3)),
+		Position:  util.RandomInt(1, 1000) * db.TodoPositionGap,
 	}
 }
 
+// expectTodoRollups stubs the roll-up lookup done while building the responses of the todos
+func expectTodoRollups(store *mockdb.MockStore, todos ...db.Todo) {
+	todoIDs := make([]int64, 0, len(todos))
+	for _, todo := range todos {
+		todoIDs = append(todoIDs, todo.ID)
+	}
+
+	store.EXPECT().
+		ListTodoRollups(gomock.Any(), gomock.Eq(todoIDs)).
+		Times(1).
+		Return([]db.ListTodoRollupsRow{}, nil)
+}
+
 func assertBodyMatchTodo(t *testing.T, body *bytes.Buffer, todo db.Todo) {
 	data, err := io.ReadAll(body)
 	assert.NoError(t, err)
@@ -62,10 +82,14 @@ func assertBodyMatchError(t *testing.T, body *bytes.Buffer, expectedError error)
 
 func TestGetTodoAPI(t *testing.T) {
 	todo := RandomTodo()
+	describedTodo := RandomTodo()
+	describedTodo.Description = "![diagram](attachment:42)"
+	attachments := []db.Attachment{{ID: 42, TodoID: describedTodo.ID}}
 
 	tcs := []struct {
 		name               string
 		todoID             int64
+		query              url.Values
 		buildDBStub        func(store *mockdb.MockStore)
 		checkOKResponse    func(recorder *httptest.ResponseRecorder)
 		errorExpected      bool
@@ -80,12 +104,86 @@ func TestGetTodoAPI(t *testing.T) {
 					GetTodo(gomock.Any(), gomock.Eq(todo.ID)).
 					Times(1).
 					Return(todo, nil)
+				expectTodoRollups(store, todo)
 			},
 			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, recorder.Code)
 				assertBodyMatchTodo(t, recorder.Body, todo)
 			},
 		},
+		{
+			name:   "OKDescriptionAttachments",
+			todoID: describedTodo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTodo(gomock.Any(), gomock.Eq(describedTodo.ID)).
+					Times(1).
+					Return(describedTodo, nil)
+				expectTodoRollups(store, describedTodo)
+				store.EXPECT().
+					ListAttachmentsOfTodos(gomock.Any(), gomock.Eq([]int64{describedTodo.ID})).
+					Times(1).
+					Return(attachments, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp todoResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Contains(t, resp.DescriptionHTML, attachmentURL(describedTodo.ID, 42))
+				assert.Empty(t, resp.BrokenAttachmentReferences)
+			},
+		},
+		{
+			name:   "OKFieldsAndAttachments",
+			todoID: describedTodo.ID,
+			query:  url.Values{"fields": {"title, overdue"}, "include": {"attachments"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTodo(gomock.Any(), gomock.Eq(describedTodo.ID)).
+					Times(1).
+					Return(describedTodo, nil)
+				expectTodoRollups(store, describedTodo)
+				store.EXPECT().
+					ListAttachmentsOfTodos(gomock.Any(), gomock.Eq([]int64{describedTodo.ID})).
+					Times(1).
+					Return([]db.Attachment{{ID: 42, TodoID: describedTodo.ID, OriginalFilename: "diagram.png"}}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp map[string]any
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Equal(t, map[string]any{
+					"todoId":  float64(describedTodo.ID),
+					"title":   describedTodo.Title,
+					"overdue": false,
+					"attachments": []any{map[string]any{
+						"attachmentId": float64(42),
+						"todoId":       float64(describedTodo.ID),
+						"filename":     "diagram.png",
+					}},
+				}, resp)
+			},
+		},
+		{
+			name:   "UnknownField",
+			todoID: todo.ID,
+			query:  url.Values{"fields": {"title,storageFilename"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTodo(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newUnknownTodoFieldError("storageFilename", todoFields),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, expectedError error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, expectedError)
+			},
+		},
 		{
 			name:   "NotFound",
 			todoID: todo.ID,
@@ -147,12 +245,13 @@ func TestGetTodoAPI(t *testing.T) {
 			tc.buildDBStub(store)
 
 			// start test server and send request
-			server := NewGinHandler(store, nil, nil)
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("/todos/%d", tc.todoID)
 			request, err := http.NewRequest(http.MethodGet, url, nil)
 			assert.NoError(t, err)
+			request.URL.RawQuery = tc.query.Encode()
 
 			server.router.ServeHTTP(recorder, request)
 			// check response/error
@@ -167,6 +266,8 @@ func TestGetTodoAPI(t *testing.T) {
 
 func TestCreateTodoAPI(t *testing.T) {
 	todo := RandomTodo()
+	duplicate := RandomTodo()
+	project := RandomProject()
 
 	tcs := []struct {
 		name               string
@@ -191,12 +292,233 @@ func TestCreateTodoAPI(t *testing.T) {
 					CreateTodoTx(gomock.Any(), gomock.Eq(arg)).
 					Times(1).
 					Return(db.CreateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name: "OKWithPriority",
+			body: gin.H{
+				"title":    todo.Title,
+				"priority": todo.Priority,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				arg := db.CreateTodoTxParams{
+					TodoTitle: todo.Title,
+					Priority:  todo.Priority,
+					Storage:   mockStorage,
+				}
+				store.EXPECT().
+					CreateTodoTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CreateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name: "OKDuplicateCheckWarn",
+			body: gin.H{
+				"title":          todo.Title,
+				"duplicateCheck": DuplicateCheckWarn,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Eq(db.ListSimilarTodosParams{
+						Title:      todo.Title,
+						Threshold:  DefaultDuplicateThreshold,
+						MaxResults: DefaultMaxSimilarTodos,
+					})).
+					Times(1).
+					Return([]db.ListSimilarTodosRow{{Todo: duplicate, Similarity: 0.8}}, nil)
+				expectTodoRollups(store, duplicate)
+
+				arg := db.CreateTodoTxParams{
+					TodoTitle: todo.Title,
+					Storage:   mockStorage,
+				}
+				store.EXPECT().
+					CreateTodoTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CreateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp struct {
+					db.Todo
+					PossibleDuplicates []struct {
+						db.Todo
+						Similarity float32 `json:"similarity"`
+					} `json:"possibleDuplicates"`
+				}
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				assert.Equal(t, todo, resp.Todo)
+				assert.Len(t, resp.PossibleDuplicates, 1)
+				assert.Equal(t, duplicate, resp.PossibleDuplicates[0].Todo)
+				assert.Equal(t, float32(0.8), resp.PossibleDuplicates[0].Similarity)
+			},
+		},
+		{
+			name: "OKDuplicateCheckStrictWithoutDuplicates",
+			body: gin.H{
+				"title":          todo.Title,
+				"duplicateCheck": DuplicateCheckStrict,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListSimilarTodosRow{}, nil)
+
+				store.EXPECT().
+					CreateTodoTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.NotContains(t, recorder.Body.String(), "possibleDuplicates")
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name: "PossibleDuplicates",
+			body: gin.H{
+				"title":          todo.Title,
+				"duplicateCheck": DuplicateCheckStrict,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListSimilarTodosRow{{Todo: duplicate, Similarity: 0.8}}, nil)
+				expectTodoRollups(store, duplicate)
+
+				store.EXPECT().
+					CreateTodoTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newPossibleDuplicateTodosError(1),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, expectedError error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+
+				var resp duplicateTodoConflictResponse
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				assert.Equal(t, expectedError.Error(), resp.Message)
+				assert.Len(t, resp.PossibleDuplicates, 1)
+				assert.Equal(t, duplicate.ID, resp.PossibleDuplicates[0].ID)
+			},
+		},
+		{
+			name: "InvalidDuplicateCheck",
+			body: gin.H{
+				"title":          todo.Title,
+				"duplicateCheck": "block",
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().
+					ListSimilarTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateTodoTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, expectedError error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UnknownParent",
+			body: gin.H{
+				"title":    todo.Title,
+				"parentId": todo.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				arg := db.CreateTodoTxParams{
+					TodoTitle: todo.Title,
+					ParentID:  &todo.ID,
+					Storage:   mockStorage,
+				}
+				store.EXPECT().
+					CreateTodoTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CreateTodoTxResult{}, &pgconn.PgError{Code: db.ForeignKeyViolation})
+			},
+			errorExpected: true,
+			expectedError: unknownParentTodoError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, expectedError error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, expectedError)
+			},
+		},
+		{
+			name: "OKWithProject",
+			body: gin.H{
+				"title":     todo.Title,
+				"projectId": project.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetProject(gomock.Any(), gomock.Eq(project.ID)).Times(1).Return(project, nil)
+				arg := db.CreateTodoTxParams{
+					TodoTitle: todo.Title,
+					ProjectID: &project.ID,
+					Storage:   mockStorage,
+				}
+				store.EXPECT().
+					CreateTodoTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CreateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
 			},
 			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, recorder.Code)
 				assertBodyMatchTodo(t, recorder.Body, todo)
 			},
 		},
+		{
+			name: "UnknownProject",
+			body: gin.H{
+				"title":     todo.Title,
+				"projectId": project.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().GetProject(gomock.Any(), gomock.Eq(project.ID)).Times(1).Return(db.Project{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: unknownProjectError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, expectedError error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, expectedError)
+			},
+		},
+		{
+			name: "InvalidPriority",
+			body: gin.H{
+				"title":    todo.Title,
+				"priority": 4,
+			},
+			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
+				store.EXPECT().
+					CreateTodoTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, expectedError error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name: "InvalidRequestTitleTooLong",
 			body: gin.H{
@@ -256,7 +578,7 @@ func TestCreateTodoAPI(t *testing.T) {
 			tc.buildDBStub(store, mockStorage)
 
 			// start test server and send request
-			server := NewGinHandler(store, mockStorage, nil)
+			server := NewGinHandler(util.Config{}, store, mockStorage, nil, nil)
 			recorder := httptest.NewRecorder()
 
 			// Marshal body data to JSON
@@ -288,12 +610,28 @@ func TestListTodoAPI(t *testing.T) {
 	type Query struct {
 		pageID   int
 		pageSize int
+		tags     []int64
+		tagMatch string
+		archived bool
+		assignee string
+		watching bool
+		filters  url.Values
 	}
 
+	userID := RandomUserID()
+	assignee := RandomUserID()
+	createdFrom := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	title := "50%_off"
+	hasAttachments := true
+	minFileCount := int32(1)
+	maxFileCount := int32(3)
+
 	tcs := []struct {
 		name               string
 		todoID             int64
 		query              Query
+		userID             string
 		buildDBStub        func(store *mockdb.MockStore)
 		checkOKResponse    func(recorder *httptest.ResponseRecorder)
 		errorExpected      bool
@@ -316,6 +654,7 @@ func TestListTodoAPI(t *testing.T) {
 					ListTodos(gomock.Any(), gomock.Eq(arg)).
 					Times(1).
 					Return(todos, nil)
+				expectTodoRollups(store, todos...)
 			},
 			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, recorder.Code)
@@ -323,68 +662,474 @@ func TestListTodoAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "InternalError",
+			name: "OKMatchAllTags",
 			query: Query{
-				pageID:   1,
+				pageID:   2,
 				pageSize: n,
+				tags:     []int64{1, 2, 2},
+				tagMatch: TagMatchAll,
 			},
 			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					TagIds:       []int64{1, 2},
+					MatchAllTags: true,
+					Limit:        int32(n),
+					Offset:       int32(n),
+				}
+
 				store.EXPECT().
-					ListTodos(gomock.Any(), gomock.Any()).
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
 					Times(1).
-					Return([]db.Todo{}, sql.ErrConnDone)
+					Return(todos, nil)
+				expectTodoRollups(store, todos...)
 			},
-			errorExpected: true,
-			expectedError: sql.ErrConnDone,
-			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
-				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
-				assertBodyMatchError(t, recorder.Body, err)
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodos(t, recorder.Body, todos)
 			},
 		},
 		{
-			name: "InvalidPageID",
+			name: "OKArchived",
 			query: Query{
-				pageID:   -1,
+				pageID:   1,
 				pageSize: n,
+				archived: true,
 			},
 			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Archived: true,
+					Limit:    int32(n),
+					Offset:   0,
+				}
+
 				store.EXPECT().
-					ListTodos(gomock.Any(), gomock.Any()).
-					Times(0)
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos...)
 			},
-			errorExpected: true,
-			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
-				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodos(t, recorder.Body, todos)
 			},
 		},
 		{
-			name: "InvalidPageSize",
+			name: "OKAssignee",
 			query: Query{
 				pageID:   1,
-				pageSize: 100,
+				pageSize: n,
+				assignee: assignee,
 			},
 			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Assignee: &assignee,
+					Limit:    int32(n),
+					Offset:   0,
+				}
+
 				store.EXPECT().
-					ListTodos(gomock.Any(), gomock.Any()).
-					Times(0)
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos...)
 			},
-			errorExpected: true,
-			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
-				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodos(t, recorder.Body, todos)
 			},
 		},
-	}
-
-	for _, tc := range tcs {
-		t.Run(tc.name, func(t *testing.T) {
-			ctrl := gomock.NewController(t)
-			defer ctrl.Finish()
+		{
+			name: "OKAssignedToMeAndWatching",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				assignee: AssigneeMe,
+				watching: true,
+			},
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Assignee: &userID,
+					Watcher:  &userID,
+					Limit:    int32(n),
+					Offset:   0,
+				}
 
-			store := mockdb.NewMockStore(ctrl)
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodos(t, recorder.Body, todos)
+			},
+		},
+		{
+			name: "OKFiltersAndSort",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters: url.Values{
+					"status":         {"todo", "done"},
+					"createdFrom":    {createdFrom.Format(time.RFC3339)},
+					"createdTo":      {createdTo.Format(time.RFC3339)},
+					"title":          {title},
+					"hasAttachments": {"true"},
+					"minFileCount":   {"1"},
+					"maxFileCount":   {"3"},
+					"sort":           {"-createdAt, title,+priority"},
+				},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Statuses:       []string{"todo", "done"},
+					CreatedFrom:    &createdFrom,
+					CreatedTo:      &createdTo,
+					TitleContains:  &title,
+					HasAttachments: &hasAttachments,
+					MinFileCount:   &minFileCount,
+					MaxFileCount:   &maxFileCount,
+					Sort: []db.TodoSort{
+						{Field: db.TodoSortFieldCreatedAt, Descending: true},
+						{Field: db.TodoSortFieldTitle},
+						{Field: db.TodoSortFieldPriority},
+					},
+					Limit:  int32(n),
+					Offset: 0,
+				}
+
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodos(t, recorder.Body, todos)
+			},
+		},
+		{
+			name: "UnknownSortField",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters:  url.Values{"sort": {"-createdAt,id; DROP TABLE todos"}},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newUnknownSortFieldError("id; DROP TABLE todos", db.TodoSortFields),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "DuplicateSortField",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters:  url.Values{"sort": {"title,-title"}},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newDuplicateSortFieldError(db.TodoSortFieldTitle),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "OKQuery",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters: url.Values{
+					"q": {`status:incomplete tag:work created:>=2024-03-01 -tag:someday "quarterly report"`},
+				},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Statuses:         []string{"incomplete"},
+					TagNames:         []string{"work"},
+					ExcludedTagNames: []string{"someday"},
+					CreatedFrom:      &createdFrom,
+					TextTerms:        []string{"quarterly report"},
+					Limit:            int32(n),
+					Offset:           0,
+				}
+
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodos(t, recorder.Body, todos)
+			},
+		},
+		{
+			name: "OKFieldsAndAttachments",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters:  url.Values{"fields": {"title,status"}, "include": {"attachments"}},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Limit:  int32(n),
+					Offset: 0,
+				}
+
+				todoIDs := make([]int64, 0, len(todos))
+				for _, todo := range todos {
+					todoIDs = append(todoIDs, todo.ID)
+				}
+
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos...)
+				store.EXPECT().
+					ListAttachmentsOfTodos(gomock.Any(), gomock.Eq(todoIDs)).
+					Times(1).
+					Return([]db.Attachment{{ID: 7, TodoID: todos[1].ID, OriginalFilename: "notes.txt"}}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var resp []map[string]any
+				err := json.Unmarshal(recorder.Body.Bytes(), &resp)
+				assert.NoError(t, err)
+				assert.Len(t, resp, len(todos))
+				for i, todo := range todos {
+					attachments := []any{}
+					if i == 1 {
+						attachments = []any{map[string]any{
+							"attachmentId": float64(7),
+							"todoId":       float64(todo.ID),
+							"filename":     "notes.txt",
+						}}
+					}
+
+					assert.Equal(t, map[string]any{
+						"todoId":      float64(todo.ID),
+						"title":       todo.Title,
+						"status":      todo.Status,
+						"attachments": attachments,
+					}, resp[i])
+				}
+			},
+		},
+		{
+			name: "UnknownIncludedRelation",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters:  url.Values{"include": {"attachments,comments"}},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newUnknownTodoIncludeError("comments", todoIncludes),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InvalidQuery",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters:  url.Values{"q": {`tag:work "quarterly report`}},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: &todoquery.SyntaxError{Column: 10, Msg: "missing closing '\"' of the quoted text"},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InvalidCreatedRange",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters: url.Values{
+					"createdFrom": {createdTo.Format(time.RFC3339)},
+					"createdTo":   {createdFrom.Format(time.RFC3339)},
+				},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newFilterInvalidRangeError("createdFrom", "createdTo"),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InvalidFileCountRange",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters:  url.Values{"minFileCount": {"3"}, "maxFileCount": {"1"}},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newFilterInvalidRangeError("minFileCount", "maxFileCount"),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InvalidCreatedFrom",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				filters:  url.Values{"createdFrom": {"yesterday"}},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "WatchingWithoutUser",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				watching: true,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: userIDMissingError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InvalidTagMatch",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+				tags:     []int64{1},
+				tagMatch: "some",
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InternalError",
+			query: Query{
+				pageID:   1,
+				pageSize: n,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Todo{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InvalidPageID",
+			query: Query{
+				pageID:   -1,
+				pageSize: n,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidPageSize",
+			query: Query{
+				pageID:   1,
+				pageSize: 100,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
 			tc.buildDBStub(store)
 
 			// start test server and send request
-			server := NewGinHandler(store, nil, nil)
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
 			recorder := httptest.NewRecorder()
 
 			url := "/todos"
@@ -395,7 +1140,28 @@ func TestListTodoAPI(t *testing.T) {
 			q := request.URL.Query()
 			q.Add("pageId", fmt.Sprintf("%d", tc.query.pageID))
 			q.Add("pageSize", fmt.Sprintf("%d", tc.query.pageSize))
+			for _, tag := range tc.query.tags {
+				q.Add("tags", fmt.Sprintf("%d", tag))
+			}
+			if tc.query.tagMatch != "" {
+				q.Add("tagMatch", tc.query.tagMatch)
+			}
+			if tc.query.archived {
+				q.Add("archived", "true")
+			}
+			if tc.query.assignee != "" {
+				q.Add("assignee", tc.query.assignee)
+			}
+			if tc.query.watching {
+				q.Add("watching", "true")
+			}
+			for key, values := range tc.query.filters {
+				for _, value := range values {
+					q.Add(key, value)
+				}
+			}
 			request.URL.RawQuery = q.Encode()
+			request.Header.Set(UserIDHeader, tc.userID)
 
 			server.router.ServeHTTP(recorder, request)
 			// check response/error
@@ -408,10 +1174,246 @@ func TestListTodoAPI(t *testing.T) {
 	}
 }
 
+func TestListTodoCursorPagingAPI(t *testing.T) {
+	pageSize := 2
+	todos := make([]db.Todo, 0)
+	for i := 0; i < pageSize+1; i++ {
+		todos = append(todos, RandomTodo())
+	}
+
+	sort := []db.TodoSort{{Field: db.TodoSortFieldCreatedAt, Descending: true}}
+	nextCursor := encodeTodoCursor(db.NewTodoCursor(todos[1], sort, false), sort)
+	prevCursor := encodeTodoCursor(db.NewTodoCursor(todos[1], sort, true), sort)
+	nextTodoCursor := db.NewTodoCursor(todos[1], sort, false)
+	prevTodoCursor := db.NewTodoCursor(todos[1], sort, true)
+
+	type Page struct {
+		Todos      []db.Todo `json:"todos"`
+		NextCursor *string   `json:"nextCursor"`
+		PrevCursor *string   `json:"prevCursor"`
+		TotalCount *int64    `json:"totalCount"`
+	}
+
+	tcs := []struct {
+		name               string
+		query              url.Values
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:  "OKFirstPage",
+			query: url.Values{"pageSize": {"2"}, "sort": {"-createdAt"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Sort:  sort,
+					Limit: int32(pageSize + 1),
+				}
+
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos[:pageSize]...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var page Page
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &page))
+				assert.Equal(t, todos[:pageSize], page.Todos)
+				assert.Equal(t, &nextCursor, page.NextCursor)
+				assert.Nil(t, page.PrevCursor)
+				assert.Nil(t, page.TotalCount)
+
+				link := recorder.Header().Get(LinkHeader)
+				assert.Contains(t, link, `rel="first"`)
+				assert.Contains(t, link, "cursor="+nextCursor+"&pageSize=2&sort=-createdAt>; rel=\"next\"")
+				assert.NotContains(t, link, `rel="prev"`)
+			},
+		},
+		{
+			name:  "OKNextPage",
+			query: url.Values{"pageSize": {"2"}, "sort": {"-createdAt"}, "cursor": {nextCursor}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Sort:   sort,
+					Cursor: &nextTodoCursor,
+					Limit:  int32(pageSize + 1),
+				}
+
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos[2:], nil)
+				expectTodoRollups(store, todos[2:]...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var page Page
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &page))
+				assert.Equal(t, todos[2:], page.Todos)
+				assert.Nil(t, page.NextCursor)
+				assert.Equal(t, encodeTodoCursor(db.NewTodoCursor(todos[2], sort, true), sort), *page.PrevCursor)
+				assert.Contains(t, recorder.Header().Get(LinkHeader), `rel="prev"`)
+			},
+		},
+		{
+			name:  "OKPrevPage",
+			query: url.Values{"pageSize": {"2"}, "sort": {"-createdAt"}, "cursor": {prevCursor}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Sort:   sort,
+					Cursor: &prevTodoCursor,
+					Limit:  int32(pageSize + 1),
+				}
+
+				// The todos before the cursor come in the sort order, the extra one first
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos[1:]...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+
+				var page Page
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &page))
+				assert.Equal(t, todos[1:], page.Todos)
+				assert.Equal(t, encodeTodoCursor(db.NewTodoCursor(todos[2], sort, false), sort), *page.NextCursor)
+				assert.Equal(t, encodeTodoCursor(db.NewTodoCursor(todos[1], sort, true), sort), *page.PrevCursor)
+			},
+		},
+		{
+			name:  "OKWithTotal",
+			query: url.Values{"withTotal": {"true"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTodosParams{
+					Limit: DefaultPageSize + 1,
+				}
+
+				store.EXPECT().
+					CountTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(int64(42), nil)
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(todos, nil)
+				expectTodoRollups(store, todos...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assert.Equal(t, "42", recorder.Header().Get(TotalCountHeader))
+
+				var page Page
+				assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &page))
+				assert.Equal(t, todos, page.Todos)
+				assert.Nil(t, page.NextCursor)
+				assert.Equal(t, int64(42), *page.TotalCount)
+			},
+		},
+		{
+			name:  "InvalidCursor",
+			query: url.Values{"cursor": {"not a cursor"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: cursorInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:  "CursorSortMismatch",
+			query: url.Values{"sort": {"title"}, "cursor": {nextCursor}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: cursorSortMismatchError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:  "CursorWithPageID",
+			query: url.Values{"pageId": {"1"}, "pageSize": {"5"}, "cursor": {nextCursor}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: cursorWithPageIDError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:  "PageSizeTooLarge",
+			query: url.Values{"pageSize": {"101"}},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTodos(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			errorExpected: true,
+			expectedError: newPageSizeTooLargeError(DefaultMaxPageSize),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := "/todos?" + tc.query.Encode()
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
 func TestUpdateTodoAPI(t *testing.T) {
 	todo := RandomTodo()
 	updatedTitle := util.RandomString(10)
 	updatedStatus := util.RandomStatus()
+	unknownStatus := util.RandomString(10)
+	updatedDueAt := time.Date(2030, time.January, 1, 10, 0, 0, 0, time.UTC)
+	updatedDueTimezone := "Europe/Berlin"
+	updatedPriority := int16(3)
 
 	tcs := []struct {
 		name               string
@@ -427,7 +1429,7 @@ func TestUpdateTodoAPI(t *testing.T) {
 			name:   "InvalidID",
 			todoID: 0,
 			buildDBStub: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			errorExpected: true,
 			expectedError: todoIDInvalidError,
@@ -443,25 +1445,117 @@ func TestUpdateTodoAPI(t *testing.T) {
 				"title": util.RandomString(256),
 			},
 			buildDBStub: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "InvalidStatusString",
+			todoID: todo.ID,
+			body: gin.H{
+				"status": util.RandomString(21),
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "UnknownStatus",
+			todoID: todo.ID,
+			body: gin.H{
+				"status": unknownStatus,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateTodoTitleStatusParams{
+					ID:     todo.ID,
+					Status: &unknownStatus,
+				}
+				store.EXPECT().
+					UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg})).
+					Times(1).
+					Return(db.UpdateTodoTxResult{}, db.ErrUnknownTodoStatus)
+			},
+			errorExpected: true,
+			expectedError: unknownTodoStatusError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "TransitionNotAllowed",
+			todoID: todo.ID,
+			body: gin.H{
+				"status": updatedStatus,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateTodoTitleStatusParams{
+					ID:     todo.ID,
+					Status: &updatedStatus,
+				}
+				store.EXPECT().
+					UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg})).
+					Times(1).
+					Return(db.UpdateTodoTxResult{}, db.ErrTodoTransitionNotAllowed)
+			},
+			errorExpected: true,
+			expectedError: newTodoTransitionNotAllowedError(updatedStatus),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "Blocked",
+			todoID: todo.ID,
+			body: gin.H{
+				"status": updatedStatus,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateTodoTitleStatusParams{
+					ID:     todo.ID,
+					Status: &updatedStatus,
+				}
+				store.EXPECT().
+					UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg})).
+					Times(1).
+					Return(db.UpdateTodoTxResult{}, db.ErrTodoBlocked)
 			},
 			errorExpected: true,
+			expectedError: todoBlockedError,
 			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
-				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
 			},
 		},
 		{
-			name:   "InvalidStatusString",
+			name:   "BlockedForced",
 			todoID: todo.ID,
 			body: gin.H{
-				"status": util.RandomString(10),
+				"status": updatedStatus,
+				"force":  true,
 			},
 			buildDBStub: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Any()).Times(0)
+				arg := db.UpdateTodoTitleStatusParams{
+					ID:     todo.ID,
+					Status: &updatedStatus,
+				}
+				store.EXPECT().
+					UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg, Force: true})).
+					Times(1).
+					Return(db.UpdateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
 			},
-			errorExpected: true,
-			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
-				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
 			},
 		},
 		{
@@ -471,7 +1565,7 @@ func TestUpdateTodoAPI(t *testing.T) {
 				"status": util.RandomInt(1, 1000),
 			},
 			buildDBStub: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			errorExpected: true,
 			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
@@ -483,7 +1577,7 @@ func TestUpdateTodoAPI(t *testing.T) {
 			todoID: todo.ID,
 			body:   gin.H{},
 			buildDBStub: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			errorExpected: true,
 			expectedError: updateTodoTitleStatusInvalidBodyError,
@@ -500,7 +1594,7 @@ func TestUpdateTodoAPI(t *testing.T) {
 			},
 			todoID: todo.ID,
 			buildDBStub: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Any()).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Any()).Times(1).Return(db.UpdateTodoTxResult{}, db.ErrRecordNotFound)
 			},
 			errorExpected: true,
 			expectedError: &ResourceNotFoundError{
@@ -520,7 +1614,7 @@ func TestUpdateTodoAPI(t *testing.T) {
 			},
 			todoID: todo.ID,
 			buildDBStub: func(store *mockdb.MockStore) {
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Any()).Times(1).Return(db.Todo{}, sql.ErrConnDone)
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Any()).Times(1).Return(db.UpdateTodoTxResult{}, sql.ErrConnDone)
 			},
 			errorExpected: true,
 			expectedError: sql.ErrConnDone,
@@ -540,7 +1634,8 @@ func TestUpdateTodoAPI(t *testing.T) {
 					ID:    todo.ID,
 					Title: &updatedTitle,
 				}
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Eq(arg)).Times(1).Return(todo, nil)
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg})).Times(1).Return(db.UpdateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
 			},
 			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, recorder.Code)
@@ -558,13 +1653,102 @@ func TestUpdateTodoAPI(t *testing.T) {
 					ID:     todo.ID,
 					Status: &updatedStatus,
 				}
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Eq(arg)).Times(1).Return(todo, nil)
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg})).Times(1).Return(db.UpdateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name:   "OKDueDateUpdate",
+			todoID: todo.ID,
+			body: gin.H{
+				"dueAt":       updatedDueAt,
+				"dueTimezone": updatedDueTimezone,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateTodoTitleStatusParams{
+					ID:          todo.ID,
+					UpdateDueAt: true,
+					DueAt:       &updatedDueAt,
+					DueTimezone: &updatedDueTimezone,
+				}
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg})).Times(1).Return(db.UpdateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name:   "OKClearDueDate",
+			todoID: todo.ID,
+			body: gin.H{
+				"dueAt": nil,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateTodoTitleStatusParams{
+					ID:          todo.ID,
+					UpdateDueAt: true,
+				}
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg})).Times(1).Return(db.UpdateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name:   "OKPriorityUpdate",
+			todoID: todo.ID,
+			body: gin.H{
+				"priority": updatedPriority,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateTodoTitleStatusParams{
+					ID:       todo.ID,
+					Priority: &updatedPriority,
+				}
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg})).Times(1).Return(db.UpdateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
 			},
 			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, recorder.Code)
 				assertBodyMatchTodo(t, recorder.Body, todo)
 			},
 		},
+		{
+			name:   "InvalidPriority",
+			todoID: todo.ID,
+			body: gin.H{
+				"priority": -1,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:   "InvalidDueTimezone",
+			todoID: todo.ID,
+			body: gin.H{
+				"dueTimezone": "Mars/Olympus",
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 		{
 			name:   "OKTitleAndStatusUpdate",
 			todoID: todo.ID,
@@ -578,7 +1762,8 @@ func TestUpdateTodoAPI(t *testing.T) {
 					Title:  &updatedTitle,
 					Status: &updatedStatus,
 				}
-				store.EXPECT().UpdateTodoTitleStatus(gomock.Any(), gomock.Eq(arg)).Times(1).Return(todo, nil)
+				store.EXPECT().UpdateTodoTx(gomock.Any(), gomock.Eq(db.UpdateTodoTxParams{UpdateTodoTitleStatusParams: arg})).Times(1).Return(db.UpdateTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
 			},
 			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, recorder.Code)
@@ -595,8 +1780,11 @@ func TestUpdateTodoAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildDBStub(store)
 
+			dispatcher := mockNotification.NewMockDispatcher(ctrl)
+			dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
 			// start test server and send request
-			server := NewGinHandler(store, nil, nil)
+			server := NewGinHandler(util.Config{}, store, nil, dispatcher, nil)
 			recorder := httptest.NewRecorder()
 
 			// Marshal body data to JSON
@@ -618,6 +1806,219 @@ func TestUpdateTodoAPI(t *testing.T) {
 	}
 }
 
+func TestMoveTodoAPI(t *testing.T) {
+	todo := RandomTodo()
+	anchor := RandomTodo()
+	for anchor.ID == todo.ID {
+		anchor = RandomTodo()
+	}
+
+	movedTodo := todo
+	movedTodo.Position = anchor.Position - db.TodoPositionGap/2
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OKBefore",
+			todoID: todo.ID,
+			body: gin.H{
+				"before": anchor.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.MoveTodoTxParams{
+					TodoID:   todo.ID,
+					BeforeID: &anchor.ID,
+				}
+				store.EXPECT().MoveTodoTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.MoveTodoTxResult{Todo: movedTodo}, nil)
+				expectTodoRollups(store, movedTodo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, movedTodo)
+			},
+		},
+		{
+			name:   "OKAfter",
+			todoID: todo.ID,
+			body: gin.H{
+				"after": anchor.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.MoveTodoTxParams{
+					TodoID:  todo.ID,
+					AfterID: &anchor.ID,
+				}
+				store.EXPECT().MoveTodoTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.MoveTodoTxResult{Todo: movedTodo}, nil)
+				expectTodoRollups(store, movedTodo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, movedTodo)
+			},
+		},
+		{
+			name:   "InvalidID",
+			todoID: 0,
+			body: gin.H{
+				"before": anchor.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().MoveTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: todoIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "NoAnchor",
+			todoID: todo.ID,
+			body:   gin.H{},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().MoveTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: moveTodoInvalidBodyError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "BothAnchors",
+			todoID: todo.ID,
+			body: gin.H{
+				"before": anchor.ID,
+				"after":  anchor.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().MoveTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: moveTodoInvalidBodyError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "RelativeToItself",
+			todoID: todo.ID,
+			body: gin.H{
+				"after": todo.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().MoveTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: moveTodoRelativeToItselfError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "TodoNotFound",
+			todoID: todo.ID,
+			body: gin.H{
+				"before": anchor.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().MoveTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "AnchorNotFound",
+			todoID: todo.ID,
+			body: gin.H{
+				"before": anchor.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().MoveTodoTx(gomock.Any(), gomock.Any()).Times(1).Return(db.MoveTodoTxResult{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTodo,
+				id:           anchor.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: todo.ID,
+			body: gin.H{
+				"after": anchor.ID,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().MoveTodoTx(gomock.Any(), gomock.Any()).Times(1).Return(db.MoveTodoTxResult{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/move", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
 func TestDeleteTodoAPI(t *testing.T) {
 	todo := RandomTodo()
 
@@ -635,7 +2036,7 @@ func TestDeleteTodoAPI(t *testing.T) {
 			todoID: 0,
 			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
 				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
-				store.EXPECT().DeleteTodoTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TrashTodo(gomock.Any(), gomock.Any()).Times(0)
 			},
 			errorExpected: true,
 			expectedError: todoIDInvalidError,
@@ -649,7 +2050,7 @@ func TestDeleteTodoAPI(t *testing.T) {
 			todoID: todo.ID,
 			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
 				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
-				store.EXPECT().DeleteTodoTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TrashTodo(gomock.Any(), gomock.Any()).Times(0)
 			},
 			errorExpected: true,
 			expectedError: &ResourceNotFoundError{
@@ -666,11 +2067,8 @@ func TestDeleteTodoAPI(t *testing.T) {
 			todoID: todo.ID,
 			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
 				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
-				arg := db.DeleteTodoTxParams{
-					TodoID:  todo.ID,
-					Storage: mockStorage,
-				}
-				store.EXPECT().DeleteTodoTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(sql.ErrConnDone)
+				store.EXPECT().TrashTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(sql.ErrConnDone)
+				store.EXPECT().DeleteTodoTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			errorExpected: true,
 			expectedError: sql.ErrConnDone,
@@ -684,11 +2082,8 @@ func TestDeleteTodoAPI(t *testing.T) {
 			todoID: todo.ID,
 			buildDBStub: func(store *mockdb.MockStore, mockStorage *mockStorage.MockStorage) {
 				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
-				arg := db.DeleteTodoTxParams{
-					TodoID:  todo.ID,
-					Storage: mockStorage,
-				}
-				store.EXPECT().DeleteTodoTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+				store.EXPECT().TrashTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(nil)
+				store.EXPECT().DeleteTodoTx(gomock.Any(), gomock.Any()).Times(0)
 			},
 			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, recorder.Code)
@@ -706,8 +2101,11 @@ func TestDeleteTodoAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildDBStub(store, mockStorage)
 
+			dispatcher := mockNotification.NewMockDispatcher(ctrl)
+			dispatcher.EXPECT().Dispatch(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
 			// start test server and send request
-			server := NewGinHandler(store, mockStorage, nil)
+			server := NewGinHandler(util.Config{}, store, mockStorage, dispatcher, nil)
 			recorder := httptest.NewRecorder()
 
 			url := fmt.Sprintf("/todos/%d", tc.todoID)
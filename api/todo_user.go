@@ -0,0 +1,276 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type listTodoAssigneesRequest struct {
+	getTodoRequest
+}
+
+// listTodoAssignees godoc
+//
+//	@Summary		List assignees of a todo
+//	@Description	List the users assigned to the todo, in the order they were assigned
+//	@Tags			assignees
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	db.TodoAssignee
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/assignees [get]
+func (server *Server) listTodoAssignees(ctx *gin.Context) {
+	var req listTodoAssigneesRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	assignees, err := server.store.ListTodoAssignees(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, assignees)
+}
+
+type addTodoAssigneesRequestURIParams struct {
+	getTodoRequest
+}
+
+type addTodoAssigneesRequestBody struct {
+	UserIDs []string `json:"userIds" binding:"required,min=1,dive,min=1,max=64"`
+}
+
+// addTodoAssignees godoc
+//
+//	@Summary		Assigns users to a todo
+//	@Description	Assigns the users to the todo; users who are already assigned stay assigned
+//	@Tags			assignees
+//	@Accept			json
+//	@Produce		json
+//	@Param			todoId		path	int							true	"Todo ID"	minimum(1)
+//	@Param			assignees	body	addTodoAssigneesRequestBody	true	"User IDs"
+//	@Success		200			{array}	db.TodoAssignee
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/assignees [post]
+func (server *Server) addTodoAssignees(ctx *gin.Context) {
+	var reqURIParams addTodoAssigneesRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	var reqBody addTodoAssigneesRequestBody
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, reqURIParams.TodoID)
+	if todo == nil {
+		return
+	}
+
+	err := server.store.AddTodoAssignees(ctx, db.AddTodoAssigneesParams{
+		TodoID:  reqURIParams.TodoID,
+		UserIds: reqBody.UserIDs,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	assignees, err := server.store.ListTodoAssignees(ctx, reqURIParams.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, assignees)
+}
+
+type removeTodoAssigneeRequest struct {
+	getTodoRequest
+	UserID string `uri:"userId" binding:"required,max=64"`
+}
+
+// removeTodoAssignee godoc
+//
+//	@Summary		Unassigns a user from a todo
+//	@Description	Removes the user from the assignees of the todo
+//	@Tags			assignees
+//	@Param			todoId	path	int		true	"Todo ID"	minimum(1)
+//	@Param			userId	path	string	true	"User ID"
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/assignees/{userId} [delete]
+func (server *Server) removeTodoAssignee(ctx *gin.Context) {
+	var req removeTodoAssigneeRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	rows, err := server.store.RemoveTodoAssignee(ctx, db.RemoveTodoAssigneeParams{
+		TodoID: req.TodoID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	if rows == 0 {
+		NewHTTPError(ctx, http.StatusNotFound, newUserNotAssignedError(req.TodoID, req.UserID))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type listTodoWatchersRequest struct {
+	getTodoRequest
+}
+
+// listTodoWatchers godoc
+//
+//	@Summary		List watchers of a todo
+//	@Description	List the users watching the todo, in the order they started watching
+//	@Tags			watchers
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	db.TodoWatcher
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/watchers [get]
+func (server *Server) listTodoWatchers(ctx *gin.Context) {
+	var req listTodoWatchersRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	watchers, err := server.store.ListTodoWatchers(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, watchers)
+}
+
+type watchTodoRequest struct {
+	getTodoRequest
+}
+
+// watchTodo godoc
+//
+//	@Summary		Watches a todo
+//	@Description	Makes the user watch the todo, getting notified about its changes; watching a todo twice has no effect
+//	@Tags			watchers
+//	@Param			todoId		path	int		true	"Todo ID"	minimum(1)
+//	@Param			X-User-ID	header	string	true	"User ID"
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/watch [post]
+func (server *Server) watchTodo(ctx *gin.Context) {
+	var req watchTodoRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	err := server.store.AddTodoWatcher(ctx, db.AddTodoWatcherParams{
+		TodoID: req.TodoID,
+		UserID: userID,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type unwatchTodoRequest struct {
+	getTodoRequest
+}
+
+// unwatchTodo godoc
+//
+//	@Summary		Stops watching a todo
+//	@Description	Stops notifying the user about the changes of the todo, unless they're assigned to it
+//	@Tags			watchers
+//	@Param			todoId		path	int		true	"Todo ID"	minimum(1)
+//	@Param			X-User-ID	header	string	true	"User ID"
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/unwatch [post]
+func (server *Server) unwatchTodo(ctx *gin.Context) {
+	var req unwatchTodoRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	userID := currentUserAndHandleErrors(ctx)
+	if userID == "" {
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	err := server.store.RemoveTodoWatcher(ctx, db.RemoveTodoWatcherParams{
+		TodoID: req.TodoID,
+		UserID: userID,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
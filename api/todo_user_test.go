@@ -0,0 +1,375 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/notification"
+	mockNotification "github.com/jaingounchained/todo/notification/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func assertBodyMatchAssignees(t *testing.T, body *bytes.Buffer, assignees []db.TodoAssignee) {
+	var gotAssignees []db.TodoAssignee
+	err := json.Unmarshal(body.Bytes(), &gotAssignees)
+	assert.NoError(t, err)
+	assert.Equal(t, assignees, gotAssignees)
+}
+
+func TestAddTodoAssigneesAPI(t *testing.T) {
+	todo := RandomTodo()
+	userIDs := []string{RandomUserID(), RandomUserID()}
+	assignees := []db.TodoAssignee{
+		{TodoID: todo.ID, UserID: userIDs[0]},
+		{TodoID: todo.ID, UserID: userIDs[1]},
+	}
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"userIds": userIDs,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.AddTodoAssigneesParams{
+					TodoID:  todo.ID,
+					UserIds: userIDs,
+				}
+				store.EXPECT().AddTodoAssignees(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+				store.EXPECT().ListTodoAssignees(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(assignees, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchAssignees(t, recorder.Body, assignees)
+			},
+		},
+		{
+			name: "NoUserIDs",
+			body: gin.H{
+				"userIds": []string{},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().AddTodoAssignees(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "UserIDTooLong",
+			body: gin.H{
+				"userIds": []string{util.RandomString(MaxUserIDLength + 1)},
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().AddTodoAssignees(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "TodoNotFound",
+			body: gin.H{
+				"userIds": userIDs,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(db.Todo{}, db.ErrRecordNotFound)
+				store.EXPECT().AddTodoAssignees(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d/assignees", todo.ID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestRemoveTodoAssigneeAPI(t *testing.T) {
+	todo := RandomTodo()
+	userID := RandomUserID()
+
+	tcs := []struct {
+		name               string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.RemoveTodoAssigneeParams{
+					TodoID: todo.ID,
+					UserID: userID,
+				}
+				store.EXPECT().RemoveTodoAssignee(gomock.Any(), gomock.Eq(arg)).Times(1).Return(int64(1), nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NotAssigned",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().RemoveTodoAssignee(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+			},
+			errorExpected: true,
+			expectedError: newUserNotAssignedError(todo.ID, userID),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/assignees/%s", todo.ID, userID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestWatchTodoAPI(t *testing.T) {
+	todo := RandomTodo()
+	userID := RandomUserID()
+
+	tcs := []struct {
+		name               string
+		action             string
+		userID             string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "Watch",
+			action: "watch",
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.AddTodoWatcherParams{
+					TodoID: todo.ID,
+					UserID: userID,
+				}
+				store.EXPECT().AddTodoWatcher(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "Unwatch",
+			action: "unwatch",
+			userID: userID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				arg := db.RemoveTodoWatcherParams{
+					TodoID: todo.ID,
+					UserID: userID,
+				}
+				store.EXPECT().RemoveTodoWatcher(gomock.Any(), gomock.Eq(arg)).Times(1).Return(nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:   "MissingUser",
+			action: "watch",
+			userID: "",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().AddTodoWatcher(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: userIDMissingError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/%s", todo.ID, tc.action)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			assert.NoError(t, err)
+			request.Header.Set(UserIDHeader, tc.userID)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestTodoChangeNotificationAPI(t *testing.T) {
+	todo := RandomTodo()
+	userID := RandomUserID()
+	updatedTitle := util.RandomString(10)
+	updatedTodo := todo
+	updatedTodo.Title = updatedTitle
+
+	tcs := []struct {
+		name                 string
+		method               string
+		body                 gin.H
+		buildDBStub          func(store *mockdb.MockStore)
+		expectedNotification notification.Notification
+	}{
+		{
+			name:   "Update",
+			method: http.MethodPatch,
+			body: gin.H{
+				"title":    updatedTitle,
+				"priority": 2,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateTodoTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateTodoTxResult{Todo: updatedTodo}, nil)
+				expectTodoRollups(store, updatedTodo)
+			},
+			expectedNotification: notification.Notification{
+				TodoID:  todo.ID,
+				Subject: fmt.Sprintf("Todo updated: %s", updatedTitle),
+				Message: fmt.Sprintf("Todo '%s' was updated: title, priority", updatedTitle),
+			},
+		},
+		{
+			name:   "Trash",
+			method: http.MethodDelete,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(todo, nil)
+				store.EXPECT().TrashTodo(gomock.Any(), gomock.Eq(todo.ID)).Times(1).Return(nil)
+			},
+			expectedNotification: notification.Notification{
+				TodoID:  todo.ID,
+				Subject: fmt.Sprintf("Todo trashed: %s", todo.Title),
+				Message: fmt.Sprintf("Todo '%s' was moved to the trash", todo.Title),
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			dispatcher := mockNotification.NewMockDispatcher(ctrl)
+			dispatcher.EXPECT().
+				Dispatch(gomock.Any(), gomock.Eq(tc.expectedNotification), gomock.Eq(userID)).
+				Times(1)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, dispatcher, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/todos/%d", todo.ID)
+			request, err := http.NewRequest(tc.method, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+			request.Header.Set(UserIDHeader, userID)
+
+			server.router.ServeHTTP(recorder, request)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	}
+}
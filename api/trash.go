@@ -0,0 +1,101 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+type listTrashRequest struct {
+	PageID   int32 `form:"pageId" binding:"required,min=1"`
+	PageSize int32 `form:"pageSize" binding:"required,min=5,max=10"`
+}
+
+// listTrash godoc
+//
+//	@Summary		List trashed todos
+//	@Description	List the todos in the trash, most recently trashed first, based on page ID and page size
+//	@Tags			trash
+//	@Produce		json
+//	@Param			pageId		query	int	true	"page ID"	minimum(1)
+//	@Param			pageSize	query	int	true	"page size"	minimum(5)	maximum(10)
+//	@Success		200			{array}	todoResponse
+//	@Failure		400
+//	@Failure		500
+//	@Router			/trash [get]
+func (server *Server) listTrash(ctx *gin.Context) {
+	var req listTrashRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	todos, err := server.store.ListTrashedTodos(ctx, db.ListTrashedTodosParams{
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	})
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponsesAndHandleErrors(ctx, todos)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+type restoreTodoRequest struct {
+	getTodoRequest
+}
+
+// restoreTodo godoc
+//
+//	@Summary		Restores a trashed Todo
+//	@Description	Moves the todo out of the trash along with the subtasks trashed with it; a subtask can't be restored while its parent is in the trash
+//	@Tags			trash
+//	@Produce		json
+//	@Param			todoId	path		int	true	"Todo ID"	minimum(1)
+//	@Success		200		{object}	todoResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/todos/{todoId}/restore [post]
+func (server *Server) restoreTodo(ctx *gin.Context) {
+	var req restoreTodoRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	result, err := server.store.RestoreTodoTx(ctx, req.TodoID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceTrashedTodo,
+				id:           req.TodoID,
+			})
+			return
+		}
+
+		if errors.Is(err, db.ErrTodoParentTrashed) {
+			NewHTTPError(ctx, http.StatusConflict, todoParentTrashedError)
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := server.buildTodoResponseAndHandleErrors(ctx, result.Todo)
+	if resp == nil {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
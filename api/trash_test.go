@@ -0,0 +1,221 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomTrashedTodo() db.Todo {
+	todo := RandomTodo()
+	deletedAt := time.Now().UTC().Truncate(time.Second)
+	todo.DeletedAt = &deletedAt
+	return todo
+}
+
+func TestListTrashAPI(t *testing.T) {
+	todos := []db.Todo{RandomTrashedTodo(), RandomTrashedTodo()}
+
+	tcs := []struct {
+		name               string
+		query              string
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		checkErrorResponse func(recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "pageId=2&pageSize=5",
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.ListTrashedTodosParams{
+					Limit:  5,
+					Offset: 5,
+				}
+				store.EXPECT().ListTrashedTodos(gomock.Any(), gomock.Eq(arg)).Times(1).Return(todos, nil)
+				expectTodoRollups(store, todos...)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodos(t, recorder.Body, todos)
+			},
+		},
+		{
+			name:  "InvalidPageSize",
+			query: "pageId=1&pageSize=20",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().ListTrashedTodos(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalError",
+			query: "pageId=1&pageSize=5",
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().ListTrashedTodos(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/trash?"+tc.query, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestRestoreTodoAPI(t *testing.T) {
+	todo := RandomTodo()
+
+	tcs := []struct {
+		name               string
+		todoID             int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:   "OK",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreTodoTx(gomock.Any(), gomock.Eq(todo.ID)).
+					Times(1).
+					Return(db.RestoreTodoTxResult{Todo: todo}, nil)
+				expectTodoRollups(store, todo)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchTodo(t, recorder.Body, todo)
+			},
+		},
+		{
+			name:   "InvalidID",
+			todoID: 0,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().RestoreTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: todoIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "NotInTrash",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreTodoTx(gomock.Any(), gomock.Eq(todo.ID)).
+					Times(1).
+					Return(db.RestoreTodoTxResult{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceTrashedTodo,
+				id:           todo.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "ParentTrashed",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreTodoTx(gomock.Any(), gomock.Eq(todo.ID)).
+					Times(1).
+					Return(db.RestoreTodoTxResult{}, db.ErrTodoParentTrashed)
+			},
+			errorExpected: true,
+			expectedError: todoParentTrashedError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:   "InternalError",
+			todoID: todo.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RestoreTodoTx(gomock.Any(), gomock.Eq(todo.ID)).
+					Times(1).
+					Return(db.RestoreTodoTxResult{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/todos/%d/restore", tc.todoID)
+			request, err := http.NewRequest(http.MethodPost, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// currentUserAndHandleErrors returns the user the request acts for, identified by the X-User-ID header
+// as the service doesn't authenticate its callers; writes the error response and returns an empty string
+// if the header is missing or too long
+func currentUserAndHandleErrors(ctx *gin.Context) string {
+	userID := strings.TrimSpace(ctx.GetHeader(UserIDHeader))
+	if userID == "" || len(userID) > MaxUserIDLength {
+		NewHTTPError(ctx, http.StatusBadRequest, userIDMissingError)
+		return ""
+	}
+
+	return userID
+}
@@ -0,0 +1,357 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+// workflowResponse is a workflow along with its states, in order, and the allowed transitions between them
+type workflowResponse struct {
+	db.Workflow
+	States      []db.WorkflowState      `json:"states"`
+	Transitions []db.WorkflowTransition `json:"transitions"`
+}
+
+type getWorkflowRequest struct {
+	WorkflowID int64 `uri:"workflowId" binding:"required,min=1"`
+}
+
+// getWorkflow godoc
+//
+//	@Summary		Returns a workflow
+//	@Description	Get workflow by WorkflowID along with its states and allowed transitions
+//	@Tags			workflows
+//	@Produce		json
+//	@Param			workflowId	path		int	true	"Workflow ID"	minimum(1)
+//	@Success		200			{object}	workflowResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/workflows/{workflowId} [get]
+func (server *Server) getWorkflow(ctx *gin.Context) {
+	var req getWorkflowRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, workflowIDInvalidError)
+		return
+	}
+
+	workflow := server.fetchWorkflowAndHandleErrors(ctx, req.WorkflowID)
+	if workflow == nil {
+		return
+	}
+
+	states, err := server.store.ListWorkflowStates(ctx, req.WorkflowID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	transitions, err := server.store.ListWorkflowTransitions(ctx, req.WorkflowID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, workflowResponse{
+		Workflow:    *workflow,
+		States:      states,
+		Transitions: transitions,
+	})
+}
+
+// listWorkflows godoc
+//
+//	@Summary		List workflows
+//	@Description	List all the workflows ordered by name; the default workflow applies to todos outside of a project and to projects without a workflow
+//	@Tags			workflows
+//	@Produce		json
+//	@Success		200	{array}	db.Workflow
+//	@Failure		500
+//	@Router			/workflows [get]
+func (server *Server) listWorkflows(ctx *gin.Context) {
+	workflows, err := server.store.ListWorkflows(ctx)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, workflows)
+}
+
+type workflowStateRequest struct {
+	Name     string `json:"name" binding:"required,max=20"`
+	Terminal bool   `json:"terminal"`
+}
+
+type workflowTransitionRequest struct {
+	From string `json:"from" binding:"required,max=20"`
+	To   string `json:"to" binding:"required,max=20"`
+}
+
+type workflowDefinitionRequest struct {
+	Name        string                      `json:"name" binding:"required,max=64"`
+	States      []workflowStateRequest      `json:"states" binding:"required,min=1,dive"`
+	Transitions []workflowTransitionRequest `json:"transitions" binding:"omitempty,dive"`
+}
+
+// definition validates the states and transitions of the workflow, dropping duplicate transitions
+func (req workflowDefinitionRequest) definition() ([]db.WorkflowState, []db.WorkflowTransition, error) {
+	if req.States[0].Terminal {
+		return nil, nil, initialWorkflowStateTerminalError
+	}
+
+	states := make([]db.WorkflowState, 0, len(req.States))
+	seenStates := make(map[string]bool, len(req.States))
+	for _, state := range req.States {
+		if seenStates[state.Name] {
+			return nil, nil, newDuplicateWorkflowStateError(state.Name)
+		}
+		seenStates[state.Name] = true
+
+		states = append(states, db.WorkflowState{
+			Name:     state.Name,
+			Terminal: state.Terminal,
+		})
+	}
+
+	transitions := make([]db.WorkflowTransition, 0, len(req.Transitions))
+	seenTransitions := make(map[workflowTransitionRequest]bool, len(req.Transitions))
+	for _, transition := range req.Transitions {
+		for _, state := range []string{transition.From, transition.To} {
+			if !seenStates[state] {
+				return nil, nil, newUnknownWorkflowStateError(state)
+			}
+		}
+		if transition.From == transition.To {
+			return nil, nil, newWorkflowSelfTransitionError(transition.From)
+		}
+
+		if seenTransitions[transition] {
+			continue
+		}
+		seenTransitions[transition] = true
+
+		transitions = append(transitions, db.WorkflowTransition{
+			FromState: transition.From,
+			ToState:   transition.To,
+		})
+	}
+
+	return states, transitions, nil
+}
+
+// createWorkflow godoc
+//
+//	@Summary		Creates a workflow
+//	@Description	Creates a workflow with the specified states and allowed transitions; the first state is the initial status of new todos and can't be terminal, and todos entering a terminal state count as completed
+//	@Tags			workflows
+//	@Accept			json
+//	@Produce		json
+//	@Param			workflow	body		workflowDefinitionRequest	true	"Workflow name/states/transitions"
+//	@Success		200			{object}	workflowResponse
+//	@Failure		400
+//	@Failure		409
+//	@Failure		500
+//	@Router			/workflows [post]
+func (server *Server) createWorkflow(ctx *gin.Context) {
+	var req workflowDefinitionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	states, transitions, err := req.definition()
+	if err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := server.store.CreateWorkflowTx(ctx, db.CreateWorkflowTxParams{
+		Name:        req.Name,
+		States:      states,
+		Transitions: transitions,
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, newWorkflowNameAlreadyExistError(req.Name))
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, workflowResponse{
+		Workflow:    result.Workflow,
+		States:      result.States,
+		Transitions: result.Transitions,
+	})
+}
+
+type updateWorkflowRequestURIParams struct {
+	getWorkflowRequest
+}
+
+// updateWorkflow godoc
+//
+//	@Summary		Replaces a workflow
+//	@Description	Replaces the name, states and allowed transitions of a workflow; todos whose status is no longer a state of their workflow may move to any state
+//	@Tags			workflows
+//	@Accept			json
+//	@Produce		json
+//	@Param			workflowId	path		int							true	"Workflow ID"	minimum(1)
+//	@Param			workflow	body		workflowDefinitionRequest	true	"Workflow name/states/transitions"
+//	@Success		200			{object}	workflowResponse
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/workflows/{workflowId} [put]
+func (server *Server) updateWorkflow(ctx *gin.Context) {
+	var reqURIParams updateWorkflowRequestURIParams
+	if err := ctx.ShouldBindUri(&reqURIParams); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, workflowIDInvalidError)
+		return
+	}
+
+	var reqBody workflowDefinitionRequest
+	if err := ctx.ShouldBindJSON(&reqBody); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	states, transitions, err := reqBody.definition()
+	if err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := server.store.UpdateWorkflowTx(ctx, db.UpdateWorkflowTxParams{
+		ID:          reqURIParams.WorkflowID,
+		Name:        reqBody.Name,
+		States:      states,
+		Transitions: transitions,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceWorkflow,
+				id:           reqURIParams.WorkflowID,
+			})
+			return
+		}
+
+		if db.ErrorCode(err) == db.UniqueViolation {
+			NewHTTPError(ctx, http.StatusConflict, newWorkflowNameAlreadyExistError(reqBody.Name))
+			return
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, workflowResponse{
+		Workflow:    result.Workflow,
+		States:      result.States,
+		Transitions: result.Transitions,
+	})
+}
+
+type deleteWorkflowRequest struct {
+	getWorkflowRequest
+}
+
+// deleteWorkflow godoc
+//
+//	@Summary		Deletes a workflow
+//	@Description	Delete workflow by WorkflowID; projects using it fall back to the default workflow, which can't be deleted
+//	@Tags			workflows
+//	@Param			workflowId	path	int	true	"Workflow ID"	minimum(1)
+//	@Success		200
+//	@Failure		400
+//	@Failure		404
+//	@Failure		409
+//	@Failure		500
+//	@Router			/workflows/{workflowId} [delete]
+func (server *Server) deleteWorkflow(ctx *gin.Context) {
+	var req deleteWorkflowRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, workflowIDInvalidError)
+		return
+	}
+
+	workflow := server.fetchWorkflowAndHandleErrors(ctx, req.WorkflowID)
+	if workflow == nil {
+		return
+	}
+
+	if workflow.IsDefault {
+		NewHTTPError(ctx, http.StatusConflict, deleteDefaultWorkflowError)
+		return
+	}
+
+	if err := server.store.DeleteWorkflow(ctx, req.WorkflowID); err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, nil)
+}
+
+type listTodoTransitionsRequest struct {
+	getTodoRequest
+}
+
+// listTodoTransitions godoc
+//
+//	@Summary		List status transitions of a todo
+//	@Description	List every status change of the todo, oldest first
+//	@Tags			todos
+//	@Produce		json
+//	@Param			todoId	path	int	true	"Todo ID"	minimum(1)
+//	@Success		200		{array}	db.TodoTransition
+//	@Failure		400
+//	@Failure		404
+//	@Failure		500
+//	@Router			/todos/{todoId}/transitions [get]
+func (server *Server) listTodoTransitions(ctx *gin.Context) {
+	var req listTodoTransitionsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		NewHTTPError(ctx, http.StatusBadRequest, todoIDInvalidError)
+		return
+	}
+
+	todo := server.fetchTodoAndHandleErrors(ctx, req.TodoID)
+	if todo == nil {
+		return
+	}
+
+	transitions, err := server.store.ListTodoTransitions(ctx, req.TodoID)
+	if err != nil {
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transitions)
+}
+
+func (server *Server) fetchWorkflowAndHandleErrors(ctx *gin.Context, workflowID int64) *db.Workflow {
+	workflow, err := server.store.GetWorkflow(ctx, workflowID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			NewHTTPError(ctx, http.StatusNotFound, &ResourceNotFoundError{
+				resourceType: ResourceWorkflow,
+				id:           workflowID,
+			})
+			return nil
+		}
+
+		NewHTTPError(ctx, http.StatusInternalServerError, err)
+		return nil
+	}
+
+	return &workflow
+}
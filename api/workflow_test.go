@@ -0,0 +1,433 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func RandomWorkflow() db.Workflow {
+	return db.Workflow{
+		ID:   util.RandomInt(1, 1000),
+		Name: util.RandomString(10),
+	}
+}
+
+// randomWorkflowDefinition returns a backlog -> in-progress -> done workflow definition
+func randomWorkflowDefinition() ([]db.WorkflowState, []db.WorkflowTransition) {
+	states := []db.WorkflowState{
+		{Name: "backlog"},
+		{Name: "in-progress"},
+		{Name: "done", Terminal: true},
+	}
+	transitions := []db.WorkflowTransition{
+		{FromState: "backlog", ToState: "in-progress"},
+		{FromState: "in-progress", ToState: "done"},
+	}
+
+	return states, transitions
+}
+
+func workflowDefinitionBody(name string, states []db.WorkflowState, transitions []db.WorkflowTransition) gin.H {
+	stateBodies := make([]gin.H, 0, len(states))
+	for _, state := range states {
+		stateBodies = append(stateBodies, gin.H{"name": state.Name, "terminal": state.Terminal})
+	}
+
+	transitionBodies := make([]gin.H, 0, len(transitions))
+	for _, transition := range transitions {
+		transitionBodies = append(transitionBodies, gin.H{"from": transition.FromState, "to": transition.ToState})
+	}
+
+	return gin.H{
+		"name":        name,
+		"states":      stateBodies,
+		"transitions": transitionBodies,
+	}
+}
+
+func assertBodyMatchWorkflow(t *testing.T, body *bytes.Buffer, workflow workflowResponse) {
+	data, err := io.ReadAll(body)
+	assert.NoError(t, err)
+
+	var gotWorkflow workflowResponse
+	err = json.Unmarshal(data, &gotWorkflow)
+	assert.NoError(t, err)
+	assert.Equal(t, workflow.ID, gotWorkflow.ID)
+	assert.Equal(t, workflow.Name, gotWorkflow.Name)
+	assert.Equal(t, workflow.States, gotWorkflow.States)
+	assert.Equal(t, workflow.Transitions, gotWorkflow.Transitions)
+}
+
+func TestCreateWorkflowAPI(t *testing.T) {
+	workflow := RandomWorkflow()
+	states, transitions := randomWorkflowDefinition()
+	result := db.CreateWorkflowTxResult{
+		Workflow:    workflow,
+		States:      states,
+		Transitions: transitions,
+	}
+
+	tcs := []struct {
+		name               string
+		body               gin.H
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name: "OK",
+			body: workflowDefinitionBody(workflow.Name, states, transitions),
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.CreateWorkflowTxParams{
+					Name:        workflow.Name,
+					States:      states,
+					Transitions: transitions,
+				}
+				store.EXPECT().CreateWorkflowTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchWorkflow(t, recorder.Body, workflowResponse{
+					Workflow:    workflow,
+					States:      states,
+					Transitions: transitions,
+				})
+			},
+		},
+		{
+			name: "OKDuplicateTransitionsDropped",
+			body: workflowDefinitionBody(workflow.Name, states, append(transitions, transitions[0])),
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.CreateWorkflowTxParams{
+					Name:        workflow.Name,
+					States:      states,
+					Transitions: transitions,
+				}
+				store.EXPECT().CreateWorkflowTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "StatesAbsent",
+			body: gin.H{
+				"name": workflow.Name,
+			},
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWorkflowTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InitialStateTerminal",
+			body: workflowDefinitionBody(workflow.Name, []db.WorkflowState{{Name: "done", Terminal: true}}, nil),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWorkflowTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: initialWorkflowStateTerminalError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "DuplicateState",
+			body: workflowDefinitionBody(workflow.Name, append(states, states[1]), transitions),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWorkflowTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newDuplicateWorkflowStateError(states[1].Name),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "TransitionToUnknownState",
+			body: workflowDefinitionBody(workflow.Name, states, []db.WorkflowTransition{{FromState: "backlog", ToState: "review"}}),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWorkflowTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newUnknownWorkflowStateError("review"),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "SelfTransition",
+			body: workflowDefinitionBody(workflow.Name, states, []db.WorkflowTransition{{FromState: "backlog", ToState: "backlog"}}),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWorkflowTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: newWorkflowSelfTransitionError("backlog"),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "DuplicateName",
+			body: workflowDefinitionBody(workflow.Name, states, transitions),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWorkflowTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CreateWorkflowTxResult{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			errorExpected: true,
+			expectedError: newWorkflowNameAlreadyExistError(workflow.Name),
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name: "InternalError",
+			body: workflowDefinitionBody(workflow.Name, states, transitions),
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateWorkflowTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CreateWorkflowTxResult{}, sql.ErrConnDone)
+			},
+			errorExpected: true,
+			expectedError: sql.ErrConnDone,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(tc.body)
+			assert.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/workflows", bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestUpdateWorkflowAPI(t *testing.T) {
+	workflow := RandomWorkflow()
+	states, transitions := randomWorkflowDefinition()
+
+	tcs := []struct {
+		name               string
+		workflowID         int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:       "OK",
+			workflowID: workflow.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				arg := db.UpdateWorkflowTxParams{
+					ID:          workflow.ID,
+					Name:        workflow.Name,
+					States:      states,
+					Transitions: transitions,
+				}
+				store.EXPECT().
+					UpdateWorkflowTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateWorkflowTxResult{Workflow: workflow, States: states, Transitions: transitions}, nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+				assertBodyMatchWorkflow(t, recorder.Body, workflowResponse{
+					Workflow:    workflow,
+					States:      states,
+					Transitions: transitions,
+				})
+			},
+		},
+		{
+			name:       "InvalidID",
+			workflowID: 0,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateWorkflowTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: workflowIDInvalidError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:       "NotFound",
+			workflowID: workflow.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateWorkflowTx(gomock.Any(), gomock.Any()).Times(1).Return(db.UpdateWorkflowTxResult{}, db.ErrRecordNotFound)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceWorkflow,
+				id:           workflow.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			// Marshal body data to JSON
+			data, err := json.Marshal(workflowDefinitionBody(workflow.Name, states, transitions))
+			assert.NoError(t, err)
+
+			url := fmt.Sprintf("/workflows/%d", tc.workflowID)
+			request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
+
+func TestDeleteWorkflowAPI(t *testing.T) {
+	workflow := RandomWorkflow()
+	defaultWorkflow := RandomWorkflow()
+	defaultWorkflow.IsDefault = true
+
+	tcs := []struct {
+		name               string
+		workflowID         int64
+		buildDBStub        func(store *mockdb.MockStore)
+		checkOKResponse    func(recorder *httptest.ResponseRecorder)
+		errorExpected      bool
+		expectedError      error
+		checkErrorResponse func(recorder *httptest.ResponseRecorder, err error)
+	}{
+		{
+			name:       "OK",
+			workflowID: workflow.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetWorkflow(gomock.Any(), gomock.Eq(workflow.ID)).Times(1).Return(workflow, nil)
+				store.EXPECT().DeleteWorkflow(gomock.Any(), gomock.Eq(workflow.ID)).Times(1).Return(nil)
+			},
+			checkOKResponse: func(recorder *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:       "DefaultWorkflow",
+			workflowID: defaultWorkflow.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetWorkflow(gomock.Any(), gomock.Eq(defaultWorkflow.ID)).Times(1).Return(defaultWorkflow, nil)
+				store.EXPECT().DeleteWorkflow(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: deleteDefaultWorkflowError,
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusConflict, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+		{
+			name:       "NotFound",
+			workflowID: workflow.ID,
+			buildDBStub: func(store *mockdb.MockStore) {
+				store.EXPECT().GetWorkflow(gomock.Any(), gomock.Eq(workflow.ID)).Times(1).Return(db.Workflow{}, db.ErrRecordNotFound)
+				store.EXPECT().DeleteWorkflow(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+			expectedError: &ResourceNotFoundError{
+				resourceType: ResourceWorkflow,
+				id:           workflow.ID,
+			},
+			checkErrorResponse: func(recorder *httptest.ResponseRecorder, err error) {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assertBodyMatchError(t, recorder.Body, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildDBStub(store)
+
+			// start test server and send request
+			server := NewGinHandler(util.Config{}, store, nil, nil, nil)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/workflows/%d", tc.workflowID)
+			request, err := http.NewRequest(http.MethodDelete, url, nil)
+			assert.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			// check response/error
+			if tc.errorExpected {
+				tc.checkErrorResponse(recorder, tc.expectedError)
+			} else {
+				tc.checkOKResponse(recorder)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+CREATE TABLE "tags" (
+    "id" bigserial PRIMARY KEY,
+    "name" varchar(64) NOT NULL UNIQUE,
+    "color" varchar(7) NOT NULL DEFAULT '#808080',
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "todo_tags" (
+    "todo_id" bigint NOT NULL,
+    "tag_id" bigint NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY (todo_id, tag_id),
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "todo_tags" ("tag_id");
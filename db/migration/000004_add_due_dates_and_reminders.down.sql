@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS reminders;
+
+ALTER TABLE todos
+DROP COLUMN due_timezone;
+ALTER TABLE todos
+DROP COLUMN due_at;
@@ -0,0 +1,17 @@
+ALTER TABLE todos
+ADD COLUMN due_at timestamptz;
+ALTER TABLE todos
+ADD COLUMN due_timezone varchar(64);
+
+CREATE INDEX ON "todos" ("due_at");
+
+CREATE TABLE "reminders" (
+    "id" bigserial PRIMARY KEY,
+    "todo_id" bigint NOT NULL,
+    "offset_minutes" integer NOT NULL,
+    "fired_at" timestamptz,
+    "fired_for_due_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    UNIQUE (todo_id, offset_minutes),
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+ALTER TABLE todos
+DROP COLUMN position;
+ALTER TABLE todos
+DROP COLUMN priority;
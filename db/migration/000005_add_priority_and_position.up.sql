@@ -0,0 +1,10 @@
+ALTER TABLE todos
+ADD COLUMN priority smallint NOT NULL DEFAULT 0;
+
+-- Manual ordering rank; ranks are spaced by 65536 so a move only rewrites the moved row
+ALTER TABLE todos
+ADD COLUMN position bigint NOT NULL DEFAULT 0;
+
+UPDATE todos SET position = id * 65536;
+
+CREATE INDEX ON "todos" ("position", "id");
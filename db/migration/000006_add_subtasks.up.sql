@@ -0,0 +1,4 @@
+ALTER TABLE todos
+ADD COLUMN parent_id bigint REFERENCES todos (id) ON DELETE CASCADE;
+
+CREATE INDEX ON "todos" ("parent_id");
@@ -0,0 +1,4 @@
+ALTER TABLE todos
+DROP COLUMN recurrence_id;
+
+DROP TABLE IF EXISTS recurrences;
@@ -0,0 +1,12 @@
+CREATE TABLE "recurrences" (
+    "id" bigserial PRIMARY KEY,
+    "rrule" varchar(255) NOT NULL,
+    "dtstart" timestamptz NOT NULL,
+    "timezone" varchar(64) NOT NULL,
+    "copy_attachments" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+-- Only the current instance of a series points to its recurrence
+ALTER TABLE todos
+ADD COLUMN recurrence_id bigint UNIQUE REFERENCES recurrences (id) ON DELETE SET NULL;
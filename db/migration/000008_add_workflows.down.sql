@@ -0,0 +1,12 @@
+ALTER TABLE todos
+ALTER COLUMN status SET DEFAULT 'incomplete';
+
+ALTER TABLE todos
+DROP COLUMN completed_at,
+DROP COLUMN project_id;
+
+DROP TABLE IF EXISTS todo_transitions;
+DROP TABLE IF EXISTS projects;
+DROP TABLE IF EXISTS workflow_transitions;
+DROP TABLE IF EXISTS workflow_states;
+DROP TABLE IF EXISTS workflows;
@@ -0,0 +1,71 @@
+CREATE TABLE "workflows" (
+    "id" bigserial PRIMARY KEY,
+    "name" varchar(64) NOT NULL UNIQUE,
+    "is_default" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+-- The global workflow applies to todos outside of a project and to projects without a workflow
+CREATE UNIQUE INDEX ON "workflows" ("is_default") WHERE is_default;
+
+-- The first state of a workflow is the initial status of new todos
+CREATE TABLE "workflow_states" (
+    "workflow_id" bigint NOT NULL,
+    "name" varchar(20) NOT NULL,
+    "terminal" boolean NOT NULL DEFAULT false,
+    "position" integer NOT NULL,
+    PRIMARY KEY (workflow_id, name),
+    FOREIGN KEY (workflow_id) REFERENCES workflows (id) ON DELETE CASCADE
+);
+
+CREATE TABLE "workflow_transitions" (
+    "workflow_id" bigint NOT NULL,
+    "from_state" varchar(20) NOT NULL,
+    "to_state" varchar(20) NOT NULL,
+    PRIMARY KEY (workflow_id, from_state, to_state),
+    FOREIGN KEY (workflow_id, from_state) REFERENCES workflow_states (workflow_id, name) ON DELETE CASCADE,
+    FOREIGN KEY (workflow_id, to_state) REFERENCES workflow_states (workflow_id, name) ON DELETE CASCADE
+);
+
+CREATE TABLE "projects" (
+    "id" bigserial PRIMARY KEY,
+    "name" varchar(255) NOT NULL,
+    "workflow_id" bigint REFERENCES workflows (id) ON DELETE SET NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "todo_transitions" (
+    "id" bigserial PRIMARY KEY,
+    "todo_id" bigint NOT NULL,
+    "from_state" varchar(20) NOT NULL,
+    "to_state" varchar(20) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "todo_transitions" ("todo_id", "created_at");
+
+-- completed_at is set while a todo is in a terminal state of its workflow
+ALTER TABLE todos
+ADD COLUMN project_id bigint REFERENCES projects (id) ON DELETE SET NULL,
+ADD COLUMN completed_at timestamptz;
+
+CREATE INDEX ON "todos" ("project_id");
+
+ALTER TABLE todos
+ALTER COLUMN status DROP DEFAULT;
+
+-- The global workflow keeps the statuses todos had so far
+INSERT INTO workflows (name, is_default) VALUES ('default', true);
+
+INSERT INTO workflow_states (workflow_id, name, terminal, position)
+SELECT id, 'incomplete', false, 0 FROM workflows WHERE is_default
+UNION ALL
+SELECT id, 'complete', true, 1 FROM workflows WHERE is_default;
+
+INSERT INTO workflow_transitions (workflow_id, from_state, to_state)
+SELECT id, 'incomplete', 'complete' FROM workflows WHERE is_default
+UNION ALL
+SELECT id, 'complete', 'incomplete' FROM workflows WHERE is_default;
+
+UPDATE todos SET completed_at = created_at WHERE status = 'complete';
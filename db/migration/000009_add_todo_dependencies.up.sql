@@ -0,0 +1,12 @@
+-- todo_id can't be completed while blocked_by_id is open
+CREATE TABLE "todo_dependencies" (
+    "todo_id" bigint NOT NULL,
+    "blocked_by_id" bigint NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY (todo_id, blocked_by_id),
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+    FOREIGN KEY (blocked_by_id) REFERENCES todos (id) ON DELETE CASCADE,
+    CHECK (todo_id <> blocked_by_id)
+);
+
+CREATE INDEX ON "todo_dependencies" ("blocked_by_id");
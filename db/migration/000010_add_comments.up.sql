@@ -0,0 +1,22 @@
+CREATE TABLE "comments" (
+    "id" bigserial PRIMARY KEY,
+    "todo_id" bigint NOT NULL,
+    -- Markdown, rendered to HTML on read
+    "body" text NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "edited_at" timestamptz,
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "comments" ("todo_id", "id");
+
+-- Attachments of the todo referenced by a comment
+CREATE TABLE "comment_attachments" (
+    "comment_id" bigint NOT NULL,
+    "attachment_id" bigint NOT NULL,
+    PRIMARY KEY (comment_id, attachment_id),
+    FOREIGN KEY (comment_id) REFERENCES comments (id) ON DELETE CASCADE,
+    FOREIGN KEY (attachment_id) REFERENCES attachments (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "comment_attachments" ("attachment_id");
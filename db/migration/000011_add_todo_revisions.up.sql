@@ -0,0 +1,10 @@
+-- changes maps each changed field to its JSON encoded value before and after the change
+CREATE TABLE "todo_revisions" (
+    "id" bigserial PRIMARY KEY,
+    "todo_id" bigint NOT NULL,
+    "changes" jsonb NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "todo_revisions" ("todo_id", "id");
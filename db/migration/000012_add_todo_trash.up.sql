@@ -0,0 +1,5 @@
+-- Trashed todos are kept out of the normal queries until they get restored or purged
+ALTER TABLE todos
+ADD COLUMN deleted_at timestamptz;
+
+CREATE INDEX ON "todos" ("deleted_at") WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,5 @@
+-- Archived todos are kept out of the default todo list; they stay reachable through the archive view
+ALTER TABLE todos
+ADD COLUMN archived_at timestamptz;
+
+CREATE INDEX ON "todos" ("archived_at") WHERE archived_at IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Long-form Markdown description of the todo
+ALTER TABLE todos
+ADD COLUMN description text NOT NULL DEFAULT '';
@@ -0,0 +1,12 @@
+CREATE TABLE "checklist_items" (
+    "id" bigserial PRIMARY KEY,
+    "todo_id" bigint NOT NULL,
+    "text" varchar(255) NOT NULL,
+    "checked" boolean NOT NULL DEFAULT false,
+    -- Sparse ordering within the todo's checklist
+    "position" bigint NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "checklist_items" ("todo_id", "position");
@@ -0,0 +1,20 @@
+CREATE TABLE "time_entries" (
+    "id" bigserial PRIMARY KEY,
+    "todo_id" bigint NOT NULL,
+    -- Identifier of the user who tracked the time, as sent in the X-User-ID header
+    "user_id" varchar(64) NOT NULL,
+    "started_at" timestamptz NOT NULL,
+    -- NULL while the timer is running
+    "stopped_at" timestamptz,
+    "note" varchar(255) NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+    CHECK (stopped_at IS NULL OR stopped_at > started_at)
+);
+
+CREATE INDEX ON "time_entries" ("todo_id", "started_at");
+
+CREATE INDEX ON "time_entries" ("started_at");
+
+-- At most one running timer per user
+CREATE UNIQUE INDEX ON "time_entries" ("user_id") WHERE stopped_at IS NULL;
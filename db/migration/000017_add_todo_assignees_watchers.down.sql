@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS todo_watchers;
+
+DROP TABLE IF EXISTS todo_assignees;
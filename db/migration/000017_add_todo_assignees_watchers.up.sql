@@ -0,0 +1,19 @@
+CREATE TABLE "todo_assignees" (
+    "todo_id" bigint NOT NULL,
+    "user_id" varchar(64) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY (todo_id, user_id),
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "todo_assignees" ("user_id");
+
+CREATE TABLE "todo_watchers" (
+    "todo_id" bigint NOT NULL,
+    "user_id" varchar(64) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY (todo_id, user_id),
+    FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "todo_watchers" ("user_id");
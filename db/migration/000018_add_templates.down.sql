@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS template_attachments;
+
+DROP TABLE IF EXISTS template_tags;
+
+DROP TABLE IF EXISTS templates;
@@ -0,0 +1,33 @@
+CREATE TABLE "templates" (
+    "id" bigserial PRIMARY KEY,
+    "name" varchar(64) NOT NULL UNIQUE,
+    -- Title, description, checklist and subtasks may hold placeholders such as {{date}},
+    -- substituted when the template gets instantiated
+    "title" varchar(255) NOT NULL,
+    "description" text NOT NULL DEFAULT '',
+    "priority" smallint NOT NULL DEFAULT 0,
+    "checklist" varchar(255)[] NOT NULL DEFAULT '{}',
+    "subtasks" varchar(255)[] NOT NULL DEFAULT '{}',
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "template_tags" (
+    "template_id" bigint NOT NULL,
+    "tag_id" bigint NOT NULL,
+    PRIMARY KEY (template_id, tag_id),
+    FOREIGN KEY (template_id) REFERENCES templates (id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "template_tags" ("tag_id");
+
+CREATE TABLE "template_attachments" (
+    "id" bigserial PRIMARY KEY,
+    "template_id" bigint NOT NULL,
+    "original_filename" varchar(255) NOT NULL,
+    "storage_filename" varchar(255) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    FOREIGN KEY (template_id) REFERENCES templates (id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON "template_attachments" ("template_id");
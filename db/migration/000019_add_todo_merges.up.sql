@@ -0,0 +1,3 @@
+-- A todo merged into another one is closed and points to the todo it was merged into
+ALTER TABLE todos
+ADD COLUMN merged_into_id bigint REFERENCES todos (id) ON DELETE SET NULL;
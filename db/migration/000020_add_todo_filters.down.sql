@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS todos_title_idx;
+
+DROP INDEX IF EXISTS todos_status_idx;
+
+DROP INDEX IF EXISTS todos_updated_at_idx;
+
+DROP INDEX IF EXISTS todos_created_at_idx;
+
+ALTER TABLE todos
+DROP COLUMN IF EXISTS updated_at;
@@ -0,0 +1,16 @@
+-- Last change of the todo's own fields; existing todos count as unchanged since their creation
+ALTER TABLE todos
+ADD COLUMN updated_at timestamptz NOT NULL DEFAULT (now());
+
+UPDATE todos SET updated_at = created_at;
+
+-- Indexes for the filters and sort fields of the todo list
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX ON "todos" ("created_at");
+
+CREATE INDEX ON "todos" ("updated_at");
+
+CREATE INDEX ON "todos" ("status");
+
+CREATE INDEX ON "todos" USING gin ("title" gin_trgm_ops);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS todos_search_simple_idx;
+
+DROP INDEX IF EXISTS todos_search_english_idx;
+
+DROP INDEX IF EXISTS todos_search_french_idx;
+
+DROP INDEX IF EXISTS todos_search_german_idx;
+
+DROP INDEX IF EXISTS todos_search_spanish_idx;
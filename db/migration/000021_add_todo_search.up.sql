@@ -0,0 +1,11 @@
+-- Full-text search indexes over the weighted title and description of the todos, one per search language;
+-- the expressions must match the search vector of the queries
+CREATE INDEX todos_search_simple_idx ON todos USING gin ((setweight(to_tsvector('simple'::regconfig, title), 'A') || setweight(to_tsvector('simple'::regconfig, description), 'B')));
+
+CREATE INDEX todos_search_english_idx ON todos USING gin ((setweight(to_tsvector('english'::regconfig, title), 'A') || setweight(to_tsvector('english'::regconfig, description), 'B')));
+
+CREATE INDEX todos_search_french_idx ON todos USING gin ((setweight(to_tsvector('french'::regconfig, title), 'A') || setweight(to_tsvector('french'::regconfig, description), 'B')));
+
+CREATE INDEX todos_search_german_idx ON todos USING gin ((setweight(to_tsvector('german'::regconfig, title), 'A') || setweight(to_tsvector('german'::regconfig, description), 'B')));
+
+CREATE INDEX todos_search_spanish_idx ON todos USING gin ((setweight(to_tsvector('spanish'::regconfig, title), 'A') || setweight(to_tsvector('spanish'::regconfig, description), 'B')));
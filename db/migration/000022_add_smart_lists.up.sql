@@ -0,0 +1,12 @@
+CREATE TABLE "smart_lists" (
+    "id" bigserial PRIMARY KEY,
+    -- Identifier of the user who saved the smart list, as sent in the X-User-ID header
+    "user_id" varchar(64) NOT NULL,
+    "name" varchar(64) NOT NULL,
+    -- Filter and sort parameters of the todo list, as a URL query string such as 'overdue=true&sort=-createdAt';
+    -- validated again whenever the smart list is read, as the filters of the todo list may have changed since
+    "query" varchar(2048) NOT NULL,
+    "pinned" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    UNIQUE (user_id, name)
+);
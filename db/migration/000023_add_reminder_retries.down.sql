@@ -0,0 +1,4 @@
+ALTER TABLE reminders
+DROP COLUMN IF EXISTS retry_at;
+ALTER TABLE reminders
+DROP COLUMN IF EXISTS failed_attempts;
@@ -0,0 +1,5 @@
+-- Reminders that failed to notify are retried with a backoff, after the reminders that never failed
+ALTER TABLE reminders
+ADD COLUMN failed_attempts integer NOT NULL DEFAULT 0;
+ALTER TABLE reminders
+ADD COLUMN retry_at timestamptz;
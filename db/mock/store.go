@@ -7,6 +7,7 @@ package mockdb
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	db "github.com/jaingounchained/todo/db/sqlc"
@@ -35,179 +36,2357 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AddCommentAttachments mocks base method.
+func (m *MockStore) AddCommentAttachments(arg0 context.Context, arg1 db.AddCommentAttachmentsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCommentAttachments", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCommentAttachments indicates an expected call of AddCommentAttachments.
+func (mr *MockStoreMockRecorder) AddCommentAttachments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCommentAttachments", reflect.TypeOf((*MockStore)(nil).AddCommentAttachments), arg0, arg1)
+}
+
+// AddTagsToTemplate mocks base method.
+func (m *MockStore) AddTagsToTemplate(arg0 context.Context, arg1 db.AddTagsToTemplateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagsToTemplate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTagsToTemplate indicates an expected call of AddTagsToTemplate.
+func (mr *MockStoreMockRecorder) AddTagsToTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsToTemplate", reflect.TypeOf((*MockStore)(nil).AddTagsToTemplate), arg0, arg1)
+}
+
+// AddTagsToTodo mocks base method.
+func (m *MockStore) AddTagsToTodo(arg0 context.Context, arg1 db.AddTagsToTodoParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagsToTodo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTagsToTodo indicates an expected call of AddTagsToTodo.
+func (mr *MockStoreMockRecorder) AddTagsToTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsToTodo", reflect.TypeOf((*MockStore)(nil).AddTagsToTodo), arg0, arg1)
+}
+
+// AddTodoAssignees mocks base method.
+func (m *MockStore) AddTodoAssignees(arg0 context.Context, arg1 db.AddTodoAssigneesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTodoAssignees", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTodoAssignees indicates an expected call of AddTodoAssignees.
+func (mr *MockStoreMockRecorder) AddTodoAssignees(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTodoAssignees", reflect.TypeOf((*MockStore)(nil).AddTodoAssignees), arg0, arg1)
+}
+
+// AddTodoDependency mocks base method.
+func (m *MockStore) AddTodoDependency(arg0 context.Context, arg1 db.AddTodoDependencyParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTodoDependency", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTodoDependency indicates an expected call of AddTodoDependency.
+func (mr *MockStoreMockRecorder) AddTodoDependency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTodoDependency", reflect.TypeOf((*MockStore)(nil).AddTodoDependency), arg0, arg1)
+}
+
+// AddTodoDependencyTx mocks base method.
+func (m *MockStore) AddTodoDependencyTx(arg0 context.Context, arg1 db.AddTodoDependencyTxParams) (db.AddTodoDependencyTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTodoDependencyTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AddTodoDependencyTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTodoDependencyTx indicates an expected call of AddTodoDependencyTx.
+func (mr *MockStoreMockRecorder) AddTodoDependencyTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTodoDependencyTx", reflect.TypeOf((*MockStore)(nil).AddTodoDependencyTx), arg0, arg1)
+}
+
+// AddTodoWatcher mocks base method.
+func (m *MockStore) AddTodoWatcher(arg0 context.Context, arg1 db.AddTodoWatcherParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTodoWatcher", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddTodoWatcher indicates an expected call of AddTodoWatcher.
+func (mr *MockStoreMockRecorder) AddTodoWatcher(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTodoWatcher", reflect.TypeOf((*MockStore)(nil).AddTodoWatcher), arg0, arg1)
+}
+
+// ArchiveCompletedTodos mocks base method.
+func (m *MockStore) ArchiveCompletedTodos(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveCompletedTodos", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveCompletedTodos indicates an expected call of ArchiveCompletedTodos.
+func (mr *MockStoreMockRecorder) ArchiveCompletedTodos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveCompletedTodos", reflect.TypeOf((*MockStore)(nil).ArchiveCompletedTodos), arg0, arg1)
+}
+
+// ArchiveTodo mocks base method.
+func (m *MockStore) ArchiveTodo(arg0 context.Context, arg1 int64) (db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTodo", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveTodo indicates an expected call of ArchiveTodo.
+func (mr *MockStoreMockRecorder) ArchiveTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTodo", reflect.TypeOf((*MockStore)(nil).ArchiveTodo), arg0, arg1)
+}
+
+// CloneTodoTx mocks base method.
+func (m *MockStore) CloneTodoTx(arg0 context.Context, arg1 db.CloneTodoTxParams) (db.CloneTodoTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneTodoTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CloneTodoTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneTodoTx indicates an expected call of CloneTodoTx.
+func (mr *MockStoreMockRecorder) CloneTodoTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneTodoTx", reflect.TypeOf((*MockStore)(nil).CloneTodoTx), arg0, arg1)
+}
+
+// CopyChecklistItems mocks base method.
+func (m *MockStore) CopyChecklistItems(arg0 context.Context, arg1 db.CopyChecklistItemsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyChecklistItems", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyChecklistItems indicates an expected call of CopyChecklistItems.
+func (mr *MockStoreMockRecorder) CopyChecklistItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyChecklistItems", reflect.TypeOf((*MockStore)(nil).CopyChecklistItems), arg0, arg1)
+}
+
+// CopyTemplateTags mocks base method.
+func (m *MockStore) CopyTemplateTags(arg0 context.Context, arg1 db.CopyTemplateTagsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTemplateTags", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyTemplateTags indicates an expected call of CopyTemplateTags.
+func (mr *MockStoreMockRecorder) CopyTemplateTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTemplateTags", reflect.TypeOf((*MockStore)(nil).CopyTemplateTags), arg0, arg1)
+}
+
+// CopyTodoReminders mocks base method.
+func (m *MockStore) CopyTodoReminders(arg0 context.Context, arg1 db.CopyTodoRemindersParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTodoReminders", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyTodoReminders indicates an expected call of CopyTodoReminders.
+func (mr *MockStoreMockRecorder) CopyTodoReminders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTodoReminders", reflect.TypeOf((*MockStore)(nil).CopyTodoReminders), arg0, arg1)
+}
+
+// CopyTodoTags mocks base method.
+func (m *MockStore) CopyTodoTags(arg0 context.Context, arg1 db.CopyTodoTagsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTodoTags", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyTodoTags indicates an expected call of CopyTodoTags.
+func (mr *MockStoreMockRecorder) CopyTodoTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTodoTags", reflect.TypeOf((*MockStore)(nil).CopyTodoTags), arg0, arg1)
+}
+
+// CountAttachmentsOfTodo mocks base method.
+func (m *MockStore) CountAttachmentsOfTodo(arg0 context.Context, arg1 db.CountAttachmentsOfTodoParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAttachmentsOfTodo", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAttachmentsOfTodo indicates an expected call of CountAttachmentsOfTodo.
+func (mr *MockStoreMockRecorder) CountAttachmentsOfTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAttachmentsOfTodo", reflect.TypeOf((*MockStore)(nil).CountAttachmentsOfTodo), arg0, arg1)
+}
+
+// CountSearchTodos mocks base method.
+func (m *MockStore) CountSearchTodos(arg0 context.Context, arg1 db.SearchTodosParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSearchTodos", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSearchTodos indicates an expected call of CountSearchTodos.
+func (mr *MockStoreMockRecorder) CountSearchTodos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSearchTodos", reflect.TypeOf((*MockStore)(nil).CountSearchTodos), arg0, arg1)
+}
+
+// CountTodos mocks base method.
+func (m *MockStore) CountTodos(arg0 context.Context, arg1 db.ListTodosParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTodos", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTodos indicates an expected call of CountTodos.
+func (mr *MockStoreMockRecorder) CountTodos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTodos", reflect.TypeOf((*MockStore)(nil).CountTodos), arg0, arg1)
+}
+
 // CreateAttachment mocks base method.
 func (m *MockStore) CreateAttachment(arg0 context.Context, arg1 db.CreateAttachmentParams) (db.Attachment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAttachment", arg0, arg1)
-	ret0, _ := ret[0].(db.Attachment)
+	ret := m.ctrl.Call(m, "CreateAttachment", arg0, arg1)
+	ret0, _ := ret[0].(db.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockStoreMockRecorder) CreateAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockStore)(nil).CreateAttachment), arg0, arg1)
+}
+
+// CreateChecklistItem mocks base method.
+func (m *MockStore) CreateChecklistItem(arg0 context.Context, arg1 db.CreateChecklistItemParams) (db.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChecklistItem", arg0, arg1)
+	ret0, _ := ret[0].(db.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChecklistItem indicates an expected call of CreateChecklistItem.
+func (mr *MockStoreMockRecorder) CreateChecklistItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChecklistItem", reflect.TypeOf((*MockStore)(nil).CreateChecklistItem), arg0, arg1)
+}
+
+// CreateComment mocks base method.
+func (m *MockStore) CreateComment(arg0 context.Context, arg1 db.CreateCommentParams) (db.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", arg0, arg1)
+	ret0, _ := ret[0].(db.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockStoreMockRecorder) CreateComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockStore)(nil).CreateComment), arg0, arg1)
+}
+
+// CreateCommentTx mocks base method.
+func (m *MockStore) CreateCommentTx(arg0 context.Context, arg1 db.CreateCommentTxParams) (db.CreateCommentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCommentTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateCommentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCommentTx indicates an expected call of CreateCommentTx.
+func (mr *MockStoreMockRecorder) CreateCommentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommentTx", reflect.TypeOf((*MockStore)(nil).CreateCommentTx), arg0, arg1)
+}
+
+// CreateProject mocks base method.
+func (m *MockStore) CreateProject(arg0 context.Context, arg1 db.CreateProjectParams) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockStoreMockRecorder) CreateProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockStore)(nil).CreateProject), arg0, arg1)
+}
+
+// CreateRecurrence mocks base method.
+func (m *MockStore) CreateRecurrence(arg0 context.Context, arg1 db.CreateRecurrenceParams) (db.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecurrence", arg0, arg1)
+	ret0, _ := ret[0].(db.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecurrence indicates an expected call of CreateRecurrence.
+func (mr *MockStoreMockRecorder) CreateRecurrence(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecurrence", reflect.TypeOf((*MockStore)(nil).CreateRecurrence), arg0, arg1)
+}
+
+// CreateReminder mocks base method.
+func (m *MockStore) CreateReminder(arg0 context.Context, arg1 db.CreateReminderParams) (db.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReminder", arg0, arg1)
+	ret0, _ := ret[0].(db.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReminder indicates an expected call of CreateReminder.
+func (mr *MockStoreMockRecorder) CreateReminder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReminder", reflect.TypeOf((*MockStore)(nil).CreateReminder), arg0, arg1)
+}
+
+// CreateSmartList mocks base method.
+func (m *MockStore) CreateSmartList(arg0 context.Context, arg1 db.CreateSmartListParams) (db.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSmartList", arg0, arg1)
+	ret0, _ := ret[0].(db.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSmartList indicates an expected call of CreateSmartList.
+func (mr *MockStoreMockRecorder) CreateSmartList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSmartList", reflect.TypeOf((*MockStore)(nil).CreateSmartList), arg0, arg1)
+}
+
+// CreateTag mocks base method.
+func (m *MockStore) CreateTag(arg0 context.Context, arg1 db.CreateTagParams) (db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", arg0, arg1)
+	ret0, _ := ret[0].(db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockStoreMockRecorder) CreateTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockStore)(nil).CreateTag), arg0, arg1)
+}
+
+// CreateTemplate mocks base method.
+func (m *MockStore) CreateTemplate(arg0 context.Context, arg1 db.CreateTemplateParams) (db.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", arg0, arg1)
+	ret0, _ := ret[0].(db.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockStoreMockRecorder) CreateTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockStore)(nil).CreateTemplate), arg0, arg1)
+}
+
+// CreateTemplateAttachment mocks base method.
+func (m *MockStore) CreateTemplateAttachment(arg0 context.Context, arg1 db.CreateTemplateAttachmentParams) (db.TemplateAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplateAttachment", arg0, arg1)
+	ret0, _ := ret[0].(db.TemplateAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplateAttachment indicates an expected call of CreateTemplateAttachment.
+func (mr *MockStoreMockRecorder) CreateTemplateAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplateAttachment", reflect.TypeOf((*MockStore)(nil).CreateTemplateAttachment), arg0, arg1)
+}
+
+// CreateTemplateTx mocks base method.
+func (m *MockStore) CreateTemplateTx(arg0 context.Context, arg1 db.CreateTemplateTxParams) (db.CreateTemplateTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplateTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateTemplateTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplateTx indicates an expected call of CreateTemplateTx.
+func (mr *MockStoreMockRecorder) CreateTemplateTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplateTx", reflect.TypeOf((*MockStore)(nil).CreateTemplateTx), arg0, arg1)
+}
+
+// CreateTimeEntry mocks base method.
+func (m *MockStore) CreateTimeEntry(arg0 context.Context, arg1 db.CreateTimeEntryParams) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTimeEntry indicates an expected call of CreateTimeEntry.
+func (mr *MockStoreMockRecorder) CreateTimeEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeEntry", reflect.TypeOf((*MockStore)(nil).CreateTimeEntry), arg0, arg1)
+}
+
+// CreateTodo mocks base method.
+func (m *MockStore) CreateTodo(arg0 context.Context, arg1 db.CreateTodoParams) (db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTodo", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTodo indicates an expected call of CreateTodo.
+func (mr *MockStoreMockRecorder) CreateTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTodo", reflect.TypeOf((*MockStore)(nil).CreateTodo), arg0, arg1)
+}
+
+// CreateTodoRevision mocks base method.
+func (m *MockStore) CreateTodoRevision(arg0 context.Context, arg1 db.CreateTodoRevisionParams) (db.TodoRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTodoRevision", arg0, arg1)
+	ret0, _ := ret[0].(db.TodoRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTodoRevision indicates an expected call of CreateTodoRevision.
+func (mr *MockStoreMockRecorder) CreateTodoRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTodoRevision", reflect.TypeOf((*MockStore)(nil).CreateTodoRevision), arg0, arg1)
+}
+
+// CreateTodoTransition mocks base method.
+func (m *MockStore) CreateTodoTransition(arg0 context.Context, arg1 db.CreateTodoTransitionParams) (db.TodoTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTodoTransition", arg0, arg1)
+	ret0, _ := ret[0].(db.TodoTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTodoTransition indicates an expected call of CreateTodoTransition.
+func (mr *MockStoreMockRecorder) CreateTodoTransition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTodoTransition", reflect.TypeOf((*MockStore)(nil).CreateTodoTransition), arg0, arg1)
+}
+
+// CreateTodoTx mocks base method.
+func (m *MockStore) CreateTodoTx(arg0 context.Context, arg1 db.CreateTodoTxParams) (db.CreateTodoTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTodoTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateTodoTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTodoTx indicates an expected call of CreateTodoTx.
+func (mr *MockStoreMockRecorder) CreateTodoTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTodoTx", reflect.TypeOf((*MockStore)(nil).CreateTodoTx), arg0, arg1)
+}
+
+// CreateWorkflow mocks base method.
+func (m *MockStore) CreateWorkflow(arg0 context.Context, arg1 string) (db.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkflow", arg0, arg1)
+	ret0, _ := ret[0].(db.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkflow indicates an expected call of CreateWorkflow.
+func (mr *MockStoreMockRecorder) CreateWorkflow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflow", reflect.TypeOf((*MockStore)(nil).CreateWorkflow), arg0, arg1)
+}
+
+// CreateWorkflowStates mocks base method.
+func (m *MockStore) CreateWorkflowStates(arg0 context.Context, arg1 db.CreateWorkflowStatesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkflowStates", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWorkflowStates indicates an expected call of CreateWorkflowStates.
+func (mr *MockStoreMockRecorder) CreateWorkflowStates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflowStates", reflect.TypeOf((*MockStore)(nil).CreateWorkflowStates), arg0, arg1)
+}
+
+// CreateWorkflowTransitions mocks base method.
+func (m *MockStore) CreateWorkflowTransitions(arg0 context.Context, arg1 db.CreateWorkflowTransitionsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkflowTransitions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWorkflowTransitions indicates an expected call of CreateWorkflowTransitions.
+func (mr *MockStoreMockRecorder) CreateWorkflowTransitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflowTransitions", reflect.TypeOf((*MockStore)(nil).CreateWorkflowTransitions), arg0, arg1)
+}
+
+// CreateWorkflowTx mocks base method.
+func (m *MockStore) CreateWorkflowTx(arg0 context.Context, arg1 db.CreateWorkflowTxParams) (db.CreateWorkflowTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkflowTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateWorkflowTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkflowTx indicates an expected call of CreateWorkflowTx.
+func (mr *MockStoreMockRecorder) CreateWorkflowTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflowTx", reflect.TypeOf((*MockStore)(nil).CreateWorkflowTx), arg0, arg1)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockStore) DeleteAttachment(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockStoreMockRecorder) DeleteAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockStore)(nil).DeleteAttachment), arg0, arg1)
+}
+
+// DeleteAttachmentTx mocks base method.
+func (m *MockStore) DeleteAttachmentTx(arg0 context.Context, arg1 db.DeleteAttachmentTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachmentTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachmentTx indicates an expected call of DeleteAttachmentTx.
+func (mr *MockStoreMockRecorder) DeleteAttachmentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachmentTx", reflect.TypeOf((*MockStore)(nil).DeleteAttachmentTx), arg0, arg1)
+}
+
+// DeleteAttachmentsOfTodo mocks base method.
+func (m *MockStore) DeleteAttachmentsOfTodo(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachmentsOfTodo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachmentsOfTodo indicates an expected call of DeleteAttachmentsOfTodo.
+func (mr *MockStoreMockRecorder) DeleteAttachmentsOfTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachmentsOfTodo", reflect.TypeOf((*MockStore)(nil).DeleteAttachmentsOfTodo), arg0, arg1)
+}
+
+// DeleteChecklistItem mocks base method.
+func (m *MockStore) DeleteChecklistItem(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChecklistItem", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChecklistItem indicates an expected call of DeleteChecklistItem.
+func (mr *MockStoreMockRecorder) DeleteChecklistItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChecklistItem", reflect.TypeOf((*MockStore)(nil).DeleteChecklistItem), arg0, arg1)
+}
+
+// DeleteComment mocks base method.
+func (m *MockStore) DeleteComment(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockStoreMockRecorder) DeleteComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockStore)(nil).DeleteComment), arg0, arg1)
+}
+
+// DeleteCommentAttachments mocks base method.
+func (m *MockStore) DeleteCommentAttachments(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCommentAttachments", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCommentAttachments indicates an expected call of DeleteCommentAttachments.
+func (mr *MockStoreMockRecorder) DeleteCommentAttachments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentAttachments", reflect.TypeOf((*MockStore)(nil).DeleteCommentAttachments), arg0, arg1)
+}
+
+// DeleteProject mocks base method.
+func (m *MockStore) DeleteProject(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockStoreMockRecorder) DeleteProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockStore)(nil).DeleteProject), arg0, arg1)
+}
+
+// DeleteRecurrence mocks base method.
+func (m *MockStore) DeleteRecurrence(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecurrence", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecurrence indicates an expected call of DeleteRecurrence.
+func (mr *MockStoreMockRecorder) DeleteRecurrence(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecurrence", reflect.TypeOf((*MockStore)(nil).DeleteRecurrence), arg0, arg1)
+}
+
+// DeleteReminder mocks base method.
+func (m *MockStore) DeleteReminder(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReminder", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReminder indicates an expected call of DeleteReminder.
+func (mr *MockStoreMockRecorder) DeleteReminder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockStore)(nil).DeleteReminder), arg0, arg1)
+}
+
+// DeleteSmartList mocks base method.
+func (m *MockStore) DeleteSmartList(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSmartList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSmartList indicates an expected call of DeleteSmartList.
+func (mr *MockStoreMockRecorder) DeleteSmartList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSmartList", reflect.TypeOf((*MockStore)(nil).DeleteSmartList), arg0, arg1)
+}
+
+// DeleteTag mocks base method.
+func (m *MockStore) DeleteTag(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockStoreMockRecorder) DeleteTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockStore)(nil).DeleteTag), arg0, arg1)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockStore) DeleteTemplate(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockStoreMockRecorder) DeleteTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockStore)(nil).DeleteTemplate), arg0, arg1)
+}
+
+// DeleteTemplateAttachment mocks base method.
+func (m *MockStore) DeleteTemplateAttachment(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateAttachment", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplateAttachment indicates an expected call of DeleteTemplateAttachment.
+func (mr *MockStoreMockRecorder) DeleteTemplateAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateAttachment", reflect.TypeOf((*MockStore)(nil).DeleteTemplateAttachment), arg0, arg1)
+}
+
+// DeleteTemplateAttachmentTx mocks base method.
+func (m *MockStore) DeleteTemplateAttachmentTx(arg0 context.Context, arg1 db.DeleteTemplateAttachmentTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateAttachmentTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplateAttachmentTx indicates an expected call of DeleteTemplateAttachmentTx.
+func (mr *MockStoreMockRecorder) DeleteTemplateAttachmentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateAttachmentTx", reflect.TypeOf((*MockStore)(nil).DeleteTemplateAttachmentTx), arg0, arg1)
+}
+
+// DeleteTemplateTags mocks base method.
+func (m *MockStore) DeleteTemplateTags(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateTags", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplateTags indicates an expected call of DeleteTemplateTags.
+func (mr *MockStoreMockRecorder) DeleteTemplateTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateTags", reflect.TypeOf((*MockStore)(nil).DeleteTemplateTags), arg0, arg1)
+}
+
+// DeleteTemplateTx mocks base method.
+func (m *MockStore) DeleteTemplateTx(arg0 context.Context, arg1 db.DeleteTemplateTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplateTx indicates an expected call of DeleteTemplateTx.
+func (mr *MockStoreMockRecorder) DeleteTemplateTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateTx", reflect.TypeOf((*MockStore)(nil).DeleteTemplateTx), arg0, arg1)
+}
+
+// DeleteTimeEntry mocks base method.
+func (m *MockStore) DeleteTimeEntry(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTimeEntry", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTimeEntry indicates an expected call of DeleteTimeEntry.
+func (mr *MockStoreMockRecorder) DeleteTimeEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTimeEntry", reflect.TypeOf((*MockStore)(nil).DeleteTimeEntry), arg0, arg1)
+}
+
+// DeleteTodo mocks base method.
+func (m *MockStore) DeleteTodo(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTodo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTodo indicates an expected call of DeleteTodo.
+func (mr *MockStoreMockRecorder) DeleteTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTodo", reflect.TypeOf((*MockStore)(nil).DeleteTodo), arg0, arg1)
+}
+
+// DeleteTodoTags mocks base method.
+func (m *MockStore) DeleteTodoTags(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTodoTags", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTodoTags indicates an expected call of DeleteTodoTags.
+func (mr *MockStoreMockRecorder) DeleteTodoTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTodoTags", reflect.TypeOf((*MockStore)(nil).DeleteTodoTags), arg0, arg1)
+}
+
+// DeleteTodoTx mocks base method.
+func (m *MockStore) DeleteTodoTx(arg0 context.Context, arg1 db.DeleteTodoTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTodoTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTodoTx indicates an expected call of DeleteTodoTx.
+func (mr *MockStoreMockRecorder) DeleteTodoTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTodoTx", reflect.TypeOf((*MockStore)(nil).DeleteTodoTx), arg0, arg1)
+}
+
+// DeleteWorkflow mocks base method.
+func (m *MockStore) DeleteWorkflow(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflow indicates an expected call of DeleteWorkflow.
+func (mr *MockStoreMockRecorder) DeleteWorkflow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflow", reflect.TypeOf((*MockStore)(nil).DeleteWorkflow), arg0, arg1)
+}
+
+// DeleteWorkflowStates mocks base method.
+func (m *MockStore) DeleteWorkflowStates(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowStates", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowStates indicates an expected call of DeleteWorkflowStates.
+func (mr *MockStoreMockRecorder) DeleteWorkflowStates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowStates", reflect.TypeOf((*MockStore)(nil).DeleteWorkflowStates), arg0, arg1)
+}
+
+// GetAttachment mocks base method.
+func (m *MockStore) GetAttachment(arg0 context.Context, arg1 int64) (db.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", arg0, arg1)
+	ret0, _ := ret[0].(db.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockStoreMockRecorder) GetAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockStore)(nil).GetAttachment), arg0, arg1)
+}
+
+// GetChecklistItem mocks base method.
+func (m *MockStore) GetChecklistItem(arg0 context.Context, arg1 int64) (db.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChecklistItem", arg0, arg1)
+	ret0, _ := ret[0].(db.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChecklistItem indicates an expected call of GetChecklistItem.
+func (mr *MockStoreMockRecorder) GetChecklistItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChecklistItem", reflect.TypeOf((*MockStore)(nil).GetChecklistItem), arg0, arg1)
+}
+
+// GetComment mocks base method.
+func (m *MockStore) GetComment(arg0 context.Context, arg1 int64) (db.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComment", arg0, arg1)
+	ret0, _ := ret[0].(db.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComment indicates an expected call of GetComment.
+func (mr *MockStoreMockRecorder) GetComment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockStore)(nil).GetComment), arg0, arg1)
+}
+
+// GetProject mocks base method.
+func (m *MockStore) GetProject(arg0 context.Context, arg1 int64) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockStoreMockRecorder) GetProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockStore)(nil).GetProject), arg0, arg1)
+}
+
+// GetRecurrence mocks base method.
+func (m *MockStore) GetRecurrence(arg0 context.Context, arg1 int64) (db.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecurrence", arg0, arg1)
+	ret0, _ := ret[0].(db.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecurrence indicates an expected call of GetRecurrence.
+func (mr *MockStoreMockRecorder) GetRecurrence(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecurrence", reflect.TypeOf((*MockStore)(nil).GetRecurrence), arg0, arg1)
+}
+
+// GetReminder mocks base method.
+func (m *MockStore) GetReminder(arg0 context.Context, arg1 int64) (db.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminder", arg0, arg1)
+	ret0, _ := ret[0].(db.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminder indicates an expected call of GetReminder.
+func (mr *MockStoreMockRecorder) GetReminder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminder", reflect.TypeOf((*MockStore)(nil).GetReminder), arg0, arg1)
+}
+
+// GetSmartList mocks base method.
+func (m *MockStore) GetSmartList(arg0 context.Context, arg1 int64) (db.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSmartList", arg0, arg1)
+	ret0, _ := ret[0].(db.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSmartList indicates an expected call of GetSmartList.
+func (mr *MockStoreMockRecorder) GetSmartList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSmartList", reflect.TypeOf((*MockStore)(nil).GetSmartList), arg0, arg1)
+}
+
+// GetTag mocks base method.
+func (m *MockStore) GetTag(arg0 context.Context, arg1 int64) (db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTag", arg0, arg1)
+	ret0, _ := ret[0].(db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTag indicates an expected call of GetTag.
+func (mr *MockStoreMockRecorder) GetTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTag", reflect.TypeOf((*MockStore)(nil).GetTag), arg0, arg1)
+}
+
+// GetTemplate mocks base method.
+func (m *MockStore) GetTemplate(arg0 context.Context, arg1 int64) (db.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", arg0, arg1)
+	ret0, _ := ret[0].(db.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockStoreMockRecorder) GetTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockStore)(nil).GetTemplate), arg0, arg1)
+}
+
+// GetTemplateAttachment mocks base method.
+func (m *MockStore) GetTemplateAttachment(arg0 context.Context, arg1 int64) (db.TemplateAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateAttachment", arg0, arg1)
+	ret0, _ := ret[0].(db.TemplateAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateAttachment indicates an expected call of GetTemplateAttachment.
+func (mr *MockStoreMockRecorder) GetTemplateAttachment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateAttachment", reflect.TypeOf((*MockStore)(nil).GetTemplateAttachment), arg0, arg1)
+}
+
+// GetTimeEntry mocks base method.
+func (m *MockStore) GetTimeEntry(arg0 context.Context, arg1 int64) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeEntry indicates an expected call of GetTimeEntry.
+func (mr *MockStoreMockRecorder) GetTimeEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeEntry", reflect.TypeOf((*MockStore)(nil).GetTimeEntry), arg0, arg1)
+}
+
+// GetTodo mocks base method.
+func (m *MockStore) GetTodo(arg0 context.Context, arg1 int64) (db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodo", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodo indicates an expected call of GetTodo.
+func (mr *MockStoreMockRecorder) GetTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodo", reflect.TypeOf((*MockStore)(nil).GetTodo), arg0, arg1)
+}
+
+// GetTodoForUpdate mocks base method.
+func (m *MockStore) GetTodoForUpdate(arg0 context.Context, arg1 int64) (db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoForUpdate indicates an expected call of GetTodoForUpdate.
+func (mr *MockStoreMockRecorder) GetTodoForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoForUpdate", reflect.TypeOf((*MockStore)(nil).GetTodoForUpdate), arg0, arg1)
+}
+
+// GetTodoPositionAfter mocks base method.
+func (m *MockStore) GetTodoPositionAfter(arg0 context.Context, arg1 db.GetTodoPositionAfterParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoPositionAfter", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoPositionAfter indicates an expected call of GetTodoPositionAfter.
+func (mr *MockStoreMockRecorder) GetTodoPositionAfter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoPositionAfter", reflect.TypeOf((*MockStore)(nil).GetTodoPositionAfter), arg0, arg1)
+}
+
+// GetTodoPositionBefore mocks base method.
+func (m *MockStore) GetTodoPositionBefore(arg0 context.Context, arg1 db.GetTodoPositionBeforeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoPositionBefore", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoPositionBefore indicates an expected call of GetTodoPositionBefore.
+func (mr *MockStoreMockRecorder) GetTodoPositionBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoPositionBefore", reflect.TypeOf((*MockStore)(nil).GetTodoPositionBefore), arg0, arg1)
+}
+
+// GetTodoRevision mocks base method.
+func (m *MockStore) GetTodoRevision(arg0 context.Context, arg1 int64) (db.TodoRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoRevision", arg0, arg1)
+	ret0, _ := ret[0].(db.TodoRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoRevision indicates an expected call of GetTodoRevision.
+func (mr *MockStoreMockRecorder) GetTodoRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoRevision", reflect.TypeOf((*MockStore)(nil).GetTodoRevision), arg0, arg1)
+}
+
+// GetTodoWorkflowID mocks base method.
+func (m *MockStore) GetTodoWorkflowID(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodoWorkflowID", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodoWorkflowID indicates an expected call of GetTodoWorkflowID.
+func (mr *MockStoreMockRecorder) GetTodoWorkflowID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodoWorkflowID", reflect.TypeOf((*MockStore)(nil).GetTodoWorkflowID), arg0, arg1)
+}
+
+// GetTrashedTodo mocks base method.
+func (m *MockStore) GetTrashedTodo(arg0 context.Context, arg1 int64) (db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedTodo", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedTodo indicates an expected call of GetTrashedTodo.
+func (mr *MockStoreMockRecorder) GetTrashedTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedTodo", reflect.TypeOf((*MockStore)(nil).GetTrashedTodo), arg0, arg1)
+}
+
+// GetWorkflow mocks base method.
+func (m *MockStore) GetWorkflow(arg0 context.Context, arg1 int64) (db.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflow", arg0, arg1)
+	ret0, _ := ret[0].(db.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflow indicates an expected call of GetWorkflow.
+func (mr *MockStoreMockRecorder) GetWorkflow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflow", reflect.TypeOf((*MockStore)(nil).GetWorkflow), arg0, arg1)
+}
+
+// GetWorkflowState mocks base method.
+func (m *MockStore) GetWorkflowState(arg0 context.Context, arg1 db.GetWorkflowStateParams) (db.WorkflowState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowState", arg0, arg1)
+	ret0, _ := ret[0].(db.WorkflowState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowState indicates an expected call of GetWorkflowState.
+func (mr *MockStoreMockRecorder) GetWorkflowState(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowState", reflect.TypeOf((*MockStore)(nil).GetWorkflowState), arg0, arg1)
+}
+
+// InstantiateTemplateTx mocks base method.
+func (m *MockStore) InstantiateTemplateTx(arg0 context.Context, arg1 db.InstantiateTemplateTxParams) (db.InstantiateTemplateTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstantiateTemplateTx", arg0, arg1)
+	ret0, _ := ret[0].(db.InstantiateTemplateTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstantiateTemplateTx indicates an expected call of InstantiateTemplateTx.
+func (mr *MockStoreMockRecorder) InstantiateTemplateTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstantiateTemplateTx", reflect.TypeOf((*MockStore)(nil).InstantiateTemplateTx), arg0, arg1)
+}
+
+// IsTodoAncestor mocks base method.
+func (m *MockStore) IsTodoAncestor(arg0 context.Context, arg1 db.IsTodoAncestorParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTodoAncestor", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTodoAncestor indicates an expected call of IsTodoAncestor.
+func (mr *MockStoreMockRecorder) IsTodoAncestor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTodoAncestor", reflect.TypeOf((*MockStore)(nil).IsTodoAncestor), arg0, arg1)
+}
+
+// IsTodoBlockedBy mocks base method.
+func (m *MockStore) IsTodoBlockedBy(arg0 context.Context, arg1 db.IsTodoBlockedByParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTodoBlockedBy", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTodoBlockedBy indicates an expected call of IsTodoBlockedBy.
+func (mr *MockStoreMockRecorder) IsTodoBlockedBy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTodoBlockedBy", reflect.TypeOf((*MockStore)(nil).IsTodoBlockedBy), arg0, arg1)
+}
+
+// IsWorkflowTransitionAllowed mocks base method.
+func (m *MockStore) IsWorkflowTransitionAllowed(arg0 context.Context, arg1 db.IsWorkflowTransitionAllowedParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowTransitionAllowed", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsWorkflowTransitionAllowed indicates an expected call of IsWorkflowTransitionAllowed.
+func (mr *MockStoreMockRecorder) IsWorkflowTransitionAllowed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowTransitionAllowed", reflect.TypeOf((*MockStore)(nil).IsWorkflowTransitionAllowed), arg0, arg1)
+}
+
+// ListAllComments mocks base method.
+func (m *MockStore) ListAllComments(arg0 context.Context, arg1 int64) ([]db.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllComments", arg0, arg1)
+	ret0, _ := ret[0].([]db.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllComments indicates an expected call of ListAllComments.
+func (mr *MockStoreMockRecorder) ListAllComments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllComments", reflect.TypeOf((*MockStore)(nil).ListAllComments), arg0, arg1)
+}
+
+// ListAllTodoDescendants mocks base method.
+func (m *MockStore) ListAllTodoDescendants(arg0 context.Context, arg1 int64) ([]db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllTodoDescendants", arg0, arg1)
+	ret0, _ := ret[0].([]db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllTodoDescendants indicates an expected call of ListAllTodoDescendants.
+func (mr *MockStoreMockRecorder) ListAllTodoDescendants(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllTodoDescendants", reflect.TypeOf((*MockStore)(nil).ListAllTodoDescendants), arg0, arg1)
+}
+
+// ListAttachmentOfTodo mocks base method.
+func (m *MockStore) ListAttachmentOfTodo(arg0 context.Context, arg1 int64) ([]db.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachmentOfTodo", arg0, arg1)
+	ret0, _ := ret[0].([]db.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachmentOfTodo indicates an expected call of ListAttachmentOfTodo.
+func (mr *MockStoreMockRecorder) ListAttachmentOfTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachmentOfTodo", reflect.TypeOf((*MockStore)(nil).ListAttachmentOfTodo), arg0, arg1)
+}
+
+// ListAttachmentsOfTodos mocks base method.
+func (m *MockStore) ListAttachmentsOfTodos(arg0 context.Context, arg1 []int64) ([]db.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachmentsOfTodos", arg0, arg1)
+	ret0, _ := ret[0].([]db.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachmentsOfTodos indicates an expected call of ListAttachmentsOfTodos.
+func (mr *MockStoreMockRecorder) ListAttachmentsOfTodos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachmentsOfTodos", reflect.TypeOf((*MockStore)(nil).ListAttachmentsOfTodos), arg0, arg1)
+}
+
+// ListChecklistItems mocks base method.
+func (m *MockStore) ListChecklistItems(arg0 context.Context, arg1 int64) ([]db.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChecklistItems", arg0, arg1)
+	ret0, _ := ret[0].([]db.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChecklistItems indicates an expected call of ListChecklistItems.
+func (mr *MockStoreMockRecorder) ListChecklistItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChecklistItems", reflect.TypeOf((*MockStore)(nil).ListChecklistItems), arg0, arg1)
+}
+
+// ListCommentAttachments mocks base method.
+func (m *MockStore) ListCommentAttachments(arg0 context.Context, arg1 []int64) ([]db.CommentAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommentAttachments", arg0, arg1)
+	ret0, _ := ret[0].([]db.CommentAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommentAttachments indicates an expected call of ListCommentAttachments.
+func (mr *MockStoreMockRecorder) ListCommentAttachments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentAttachments", reflect.TypeOf((*MockStore)(nil).ListCommentAttachments), arg0, arg1)
+}
+
+// ListComments mocks base method.
+func (m *MockStore) ListComments(arg0 context.Context, arg1 db.ListCommentsParams) ([]db.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComments", arg0, arg1)
+	ret0, _ := ret[0].([]db.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComments indicates an expected call of ListComments.
+func (mr *MockStoreMockRecorder) ListComments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockStore)(nil).ListComments), arg0, arg1)
+}
+
+// ListDueReminders mocks base method.
+func (m *MockStore) ListDueReminders(arg0 context.Context, arg1 db.ListDueRemindersParams) ([]db.ListDueRemindersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueReminders", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListDueRemindersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueReminders indicates an expected call of ListDueReminders.
+func (mr *MockStoreMockRecorder) ListDueReminders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueReminders", reflect.TypeOf((*MockStore)(nil).ListDueReminders), arg0, arg1)
+}
+
+// ListProjects mocks base method.
+func (m *MockStore) ListProjects(arg0 context.Context) ([]db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", arg0)
+	ret0, _ := ret[0].([]db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockStoreMockRecorder) ListProjects(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockStore)(nil).ListProjects), arg0)
+}
+
+// ListPurgeableTodos mocks base method.
+func (m *MockStore) ListPurgeableTodos(arg0 context.Context, arg1 db.ListPurgeableTodosParams) ([]db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPurgeableTodos", arg0, arg1)
+	ret0, _ := ret[0].([]db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPurgeableTodos indicates an expected call of ListPurgeableTodos.
+func (mr *MockStoreMockRecorder) ListPurgeableTodos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPurgeableTodos", reflect.TypeOf((*MockStore)(nil).ListPurgeableTodos), arg0, arg1)
+}
+
+// ListRemindersOfTodo mocks base method.
+func (m *MockStore) ListRemindersOfTodo(arg0 context.Context, arg1 int64) ([]db.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemindersOfTodo", arg0, arg1)
+	ret0, _ := ret[0].([]db.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemindersOfTodo indicates an expected call of ListRemindersOfTodo.
+func (mr *MockStoreMockRecorder) ListRemindersOfTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemindersOfTodo", reflect.TypeOf((*MockStore)(nil).ListRemindersOfTodo), arg0, arg1)
+}
+
+// ListSimilarTodos mocks base method.
+func (m *MockStore) ListSimilarTodos(arg0 context.Context, arg1 db.ListSimilarTodosParams) ([]db.ListSimilarTodosRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSimilarTodos", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListSimilarTodosRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSimilarTodos indicates an expected call of ListSimilarTodos.
+func (mr *MockStoreMockRecorder) ListSimilarTodos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSimilarTodos", reflect.TypeOf((*MockStore)(nil).ListSimilarTodos), arg0, arg1)
+}
+
+// ListSmartLists mocks base method.
+func (m *MockStore) ListSmartLists(arg0 context.Context, arg1 string) ([]db.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSmartLists", arg0, arg1)
+	ret0, _ := ret[0].([]db.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSmartLists indicates an expected call of ListSmartLists.
+func (mr *MockStoreMockRecorder) ListSmartLists(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSmartLists", reflect.TypeOf((*MockStore)(nil).ListSmartLists), arg0, arg1)
+}
+
+// ListTags mocks base method.
+func (m *MockStore) ListTags(arg0 context.Context) ([]db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", arg0)
+	ret0, _ := ret[0].([]db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockStoreMockRecorder) ListTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockStore)(nil).ListTags), arg0)
+}
+
+// ListTagsOfTemplate mocks base method.
+func (m *MockStore) ListTagsOfTemplate(arg0 context.Context, arg1 int64) ([]db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsOfTemplate", arg0, arg1)
+	ret0, _ := ret[0].([]db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsOfTemplate indicates an expected call of ListTagsOfTemplate.
+func (mr *MockStoreMockRecorder) ListTagsOfTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsOfTemplate", reflect.TypeOf((*MockStore)(nil).ListTagsOfTemplate), arg0, arg1)
+}
+
+// ListTagsOfTodo mocks base method.
+func (m *MockStore) ListTagsOfTodo(arg0 context.Context, arg1 int64) ([]db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsOfTodo", arg0, arg1)
+	ret0, _ := ret[0].([]db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsOfTodo indicates an expected call of ListTagsOfTodo.
+func (mr *MockStoreMockRecorder) ListTagsOfTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsOfTodo", reflect.TypeOf((*MockStore)(nil).ListTagsOfTodo), arg0, arg1)
+}
+
+// ListTemplateAttachments mocks base method.
+func (m *MockStore) ListTemplateAttachments(arg0 context.Context, arg1 int64) ([]db.TemplateAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplateAttachments", arg0, arg1)
+	ret0, _ := ret[0].([]db.TemplateAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplateAttachments indicates an expected call of ListTemplateAttachments.
+func (mr *MockStoreMockRecorder) ListTemplateAttachments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateAttachments", reflect.TypeOf((*MockStore)(nil).ListTemplateAttachments), arg0, arg1)
+}
+
+// ListTemplates mocks base method.
+func (m *MockStore) ListTemplates(arg0 context.Context) ([]db.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates", arg0)
+	ret0, _ := ret[0].([]db.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockStoreMockRecorder) ListTemplates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockStore)(nil).ListTemplates), arg0)
+}
+
+// ListTimeEntries mocks base method.
+func (m *MockStore) ListTimeEntries(arg0 context.Context, arg1 int64) ([]db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimeEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTimeEntries indicates an expected call of ListTimeEntries.
+func (mr *MockStoreMockRecorder) ListTimeEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimeEntries", reflect.TypeOf((*MockStore)(nil).ListTimeEntries), arg0, arg1)
+}
+
+// ListTodoAssignees mocks base method.
+func (m *MockStore) ListTodoAssignees(arg0 context.Context, arg1 int64) ([]db.TodoAssignee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodoAssignees", arg0, arg1)
+	ret0, _ := ret[0].([]db.TodoAssignee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodoAssignees indicates an expected call of ListTodoAssignees.
+func (mr *MockStoreMockRecorder) ListTodoAssignees(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodoAssignees", reflect.TypeOf((*MockStore)(nil).ListTodoAssignees), arg0, arg1)
+}
+
+// ListTodoBlockers mocks base method.
+func (m *MockStore) ListTodoBlockers(arg0 context.Context, arg1 int64) ([]db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodoBlockers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodoBlockers indicates an expected call of ListTodoBlockers.
+func (mr *MockStoreMockRecorder) ListTodoBlockers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodoBlockers", reflect.TypeOf((*MockStore)(nil).ListTodoBlockers), arg0, arg1)
+}
+
+// ListTodoDescendants mocks base method.
+func (m *MockStore) ListTodoDescendants(arg0 context.Context, arg1 int64) ([]db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodoDescendants", arg0, arg1)
+	ret0, _ := ret[0].([]db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodoDescendants indicates an expected call of ListTodoDescendants.
+func (mr *MockStoreMockRecorder) ListTodoDescendants(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodoDescendants", reflect.TypeOf((*MockStore)(nil).ListTodoDescendants), arg0, arg1)
+}
+
+// ListTodoRecipients mocks base method.
+func (m *MockStore) ListTodoRecipients(arg0 context.Context, arg1 int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodoRecipients", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodoRecipients indicates an expected call of ListTodoRecipients.
+func (mr *MockStoreMockRecorder) ListTodoRecipients(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodoRecipients", reflect.TypeOf((*MockStore)(nil).ListTodoRecipients), arg0, arg1)
+}
+
+// ListTodoRevisions mocks base method.
+func (m *MockStore) ListTodoRevisions(arg0 context.Context, arg1 int64) ([]db.TodoRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodoRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]db.TodoRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodoRevisions indicates an expected call of ListTodoRevisions.
+func (mr *MockStoreMockRecorder) ListTodoRevisions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodoRevisions", reflect.TypeOf((*MockStore)(nil).ListTodoRevisions), arg0, arg1)
+}
+
+// ListTodoRollups mocks base method.
+func (m *MockStore) ListTodoRollups(arg0 context.Context, arg1 []int64) ([]db.ListTodoRollupsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodoRollups", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTodoRollupsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodoRollups indicates an expected call of ListTodoRollups.
+func (mr *MockStoreMockRecorder) ListTodoRollups(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodoRollups", reflect.TypeOf((*MockStore)(nil).ListTodoRollups), arg0, arg1)
+}
+
+// ListTodoTransitions mocks base method.
+func (m *MockStore) ListTodoTransitions(arg0 context.Context, arg1 int64) ([]db.TodoTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodoTransitions", arg0, arg1)
+	ret0, _ := ret[0].([]db.TodoTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodoTransitions indicates an expected call of ListTodoTransitions.
+func (mr *MockStoreMockRecorder) ListTodoTransitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodoTransitions", reflect.TypeOf((*MockStore)(nil).ListTodoTransitions), arg0, arg1)
+}
+
+// ListTodoWatchers mocks base method.
+func (m *MockStore) ListTodoWatchers(arg0 context.Context, arg1 int64) ([]db.TodoWatcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodoWatchers", arg0, arg1)
+	ret0, _ := ret[0].([]db.TodoWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodoWatchers indicates an expected call of ListTodoWatchers.
+func (mr *MockStoreMockRecorder) ListTodoWatchers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodoWatchers", reflect.TypeOf((*MockStore)(nil).ListTodoWatchers), arg0, arg1)
+}
+
+// ListTodos mocks base method.
+func (m *MockStore) ListTodos(arg0 context.Context, arg1 db.ListTodosParams) ([]db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodos", arg0, arg1)
+	ret0, _ := ret[0].([]db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodos indicates an expected call of ListTodos.
+func (mr *MockStoreMockRecorder) ListTodos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodos", reflect.TypeOf((*MockStore)(nil).ListTodos), arg0, arg1)
+}
+
+// ListTodosBlockedBy mocks base method.
+func (m *MockStore) ListTodosBlockedBy(arg0 context.Context, arg1 int64) ([]db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTodosBlockedBy", arg0, arg1)
+	ret0, _ := ret[0].([]db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTodosBlockedBy indicates an expected call of ListTodosBlockedBy.
+func (mr *MockStoreMockRecorder) ListTodosBlockedBy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodosBlockedBy", reflect.TypeOf((*MockStore)(nil).ListTodosBlockedBy), arg0, arg1)
+}
+
+// ListTrashedTodos mocks base method.
+func (m *MockStore) ListTrashedTodos(arg0 context.Context, arg1 db.ListTrashedTodosParams) ([]db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrashedTodos", arg0, arg1)
+	ret0, _ := ret[0].([]db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrashedTodos indicates an expected call of ListTrashedTodos.
+func (mr *MockStoreMockRecorder) ListTrashedTodos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashedTodos", reflect.TypeOf((*MockStore)(nil).ListTrashedTodos), arg0, arg1)
+}
+
+// ListWorkflowStates mocks base method.
+func (m *MockStore) ListWorkflowStates(arg0 context.Context, arg1 int64) ([]db.WorkflowState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowStates", arg0, arg1)
+	ret0, _ := ret[0].([]db.WorkflowState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowStates indicates an expected call of ListWorkflowStates.
+func (mr *MockStoreMockRecorder) ListWorkflowStates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowStates", reflect.TypeOf((*MockStore)(nil).ListWorkflowStates), arg0, arg1)
+}
+
+// ListWorkflowTransitions mocks base method.
+func (m *MockStore) ListWorkflowTransitions(arg0 context.Context, arg1 int64) ([]db.WorkflowTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowTransitions", arg0, arg1)
+	ret0, _ := ret[0].([]db.WorkflowTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowTransitions indicates an expected call of ListWorkflowTransitions.
+func (mr *MockStoreMockRecorder) ListWorkflowTransitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowTransitions", reflect.TypeOf((*MockStore)(nil).ListWorkflowTransitions), arg0, arg1)
+}
+
+// ListWorkflows mocks base method.
+func (m *MockStore) ListWorkflows(arg0 context.Context) ([]db.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflows", arg0)
+	ret0, _ := ret[0].([]db.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflows indicates an expected call of ListWorkflows.
+func (mr *MockStoreMockRecorder) ListWorkflows(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflows", reflect.TypeOf((*MockStore)(nil).ListWorkflows), arg0)
+}
+
+// LockTodoDependencies mocks base method.
+func (m *MockStore) LockTodoDependencies(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTodoDependencies", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockTodoDependencies indicates an expected call of LockTodoDependencies.
+func (mr *MockStoreMockRecorder) LockTodoDependencies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTodoDependencies", reflect.TypeOf((*MockStore)(nil).LockTodoDependencies), arg0)
+}
+
+// LockTodoHierarchy mocks base method.
+func (m *MockStore) LockTodoHierarchy(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTodoHierarchy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockTodoHierarchy indicates an expected call of LockTodoHierarchy.
+func (mr *MockStoreMockRecorder) LockTodoHierarchy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTodoHierarchy", reflect.TypeOf((*MockStore)(nil).LockTodoHierarchy), arg0)
+}
+
+// MarkReminderFailed mocks base method.
+func (m *MockStore) MarkReminderFailed(arg0 context.Context, arg1 db.MarkReminderFailedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminderFailed", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReminderFailed indicates an expected call of MarkReminderFailed.
+func (mr *MockStoreMockRecorder) MarkReminderFailed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminderFailed", reflect.TypeOf((*MockStore)(nil).MarkReminderFailed), arg0, arg1)
+}
+
+// MarkReminderFired mocks base method.
+func (m *MockStore) MarkReminderFired(arg0 context.Context, arg1 db.MarkReminderFiredParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminderFired", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReminderFired indicates an expected call of MarkReminderFired.
+func (mr *MockStoreMockRecorder) MarkReminderFired(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminderFired", reflect.TypeOf((*MockStore)(nil).MarkReminderFired), arg0, arg1)
+}
+
+// MergeTagsTx mocks base method.
+func (m *MockStore) MergeTagsTx(arg0 context.Context, arg1 db.MergeTagsTxParams) (db.MergeTagsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTagsTx", arg0, arg1)
+	ret0, _ := ret[0].(db.MergeTagsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTagsTx indicates an expected call of MergeTagsTx.
+func (mr *MockStoreMockRecorder) MergeTagsTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTagsTx", reflect.TypeOf((*MockStore)(nil).MergeTagsTx), arg0, arg1)
+}
+
+// MergeTodoTx mocks base method.
+func (m *MockStore) MergeTodoTx(arg0 context.Context, arg1 db.MergeTodoTxParams) (db.MergeTodoTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTodoTx", arg0, arg1)
+	ret0, _ := ret[0].(db.MergeTodoTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTodoTx indicates an expected call of MergeTodoTx.
+func (mr *MockStoreMockRecorder) MergeTodoTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTodoTx", reflect.TypeOf((*MockStore)(nil).MergeTodoTx), arg0, arg1)
+}
+
+// MoveTodoAttachments mocks base method.
+func (m *MockStore) MoveTodoAttachments(arg0 context.Context, arg1 db.MoveTodoAttachmentsParams) ([]db.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTodoAttachments", arg0, arg1)
+	ret0, _ := ret[0].([]db.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTodoAttachments indicates an expected call of MoveTodoAttachments.
+func (mr *MockStoreMockRecorder) MoveTodoAttachments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTodoAttachments", reflect.TypeOf((*MockStore)(nil).MoveTodoAttachments), arg0, arg1)
+}
+
+// MoveTodoComments mocks base method.
+func (m *MockStore) MoveTodoComments(arg0 context.Context, arg1 db.MoveTodoCommentsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTodoComments", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTodoComments indicates an expected call of MoveTodoComments.
+func (mr *MockStoreMockRecorder) MoveTodoComments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTodoComments", reflect.TypeOf((*MockStore)(nil).MoveTodoComments), arg0, arg1)
+}
+
+// MoveTodoTags mocks base method.
+func (m *MockStore) MoveTodoTags(arg0 context.Context, arg1 db.MoveTodoTagsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTodoTags", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTodoTags indicates an expected call of MoveTodoTags.
+func (mr *MockStoreMockRecorder) MoveTodoTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTodoTags", reflect.TypeOf((*MockStore)(nil).MoveTodoTags), arg0, arg1)
+}
+
+// MoveTodoTx mocks base method.
+func (m *MockStore) MoveTodoTx(arg0 context.Context, arg1 db.MoveTodoTxParams) (db.MoveTodoTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTodoTx", arg0, arg1)
+	ret0, _ := ret[0].(db.MoveTodoTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTodoTx indicates an expected call of MoveTodoTx.
+func (mr *MockStoreMockRecorder) MoveTodoTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTodoTx", reflect.TypeOf((*MockStore)(nil).MoveTodoTx), arg0, arg1)
+}
+
+// PromoteChecklistItemTx mocks base method.
+func (m *MockStore) PromoteChecklistItemTx(arg0 context.Context, arg1 db.PromoteChecklistItemTxParams) (db.PromoteChecklistItemTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteChecklistItemTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PromoteChecklistItemTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteChecklistItemTx indicates an expected call of PromoteChecklistItemTx.
+func (mr *MockStoreMockRecorder) PromoteChecklistItemTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteChecklistItemTx", reflect.TypeOf((*MockStore)(nil).PromoteChecklistItemTx), arg0, arg1)
+}
+
+// RebalanceTodoPositions mocks base method.
+func (m *MockStore) RebalanceTodoPositions(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceTodoPositions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebalanceTodoPositions indicates an expected call of RebalanceTodoPositions.
+func (mr *MockStoreMockRecorder) RebalanceTodoPositions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceTodoPositions", reflect.TypeOf((*MockStore)(nil).RebalanceTodoPositions), arg0)
+}
+
+// RemoveTagFromTodo mocks base method.
+func (m *MockStore) RemoveTagFromTodo(arg0 context.Context, arg1 db.RemoveTagFromTodoParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTagFromTodo", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTagFromTodo indicates an expected call of RemoveTagFromTodo.
+func (mr *MockStoreMockRecorder) RemoveTagFromTodo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagFromTodo", reflect.TypeOf((*MockStore)(nil).RemoveTagFromTodo), arg0, arg1)
+}
+
+// RemoveTodoAssignee mocks base method.
+func (m *MockStore) RemoveTodoAssignee(arg0 context.Context, arg1 db.RemoveTodoAssigneeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTodoAssignee", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTodoAssignee indicates an expected call of RemoveTodoAssignee.
+func (mr *MockStoreMockRecorder) RemoveTodoAssignee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTodoAssignee", reflect.TypeOf((*MockStore)(nil).RemoveTodoAssignee), arg0, arg1)
+}
+
+// RemoveTodoDependency mocks base method.
+func (m *MockStore) RemoveTodoDependency(arg0 context.Context, arg1 db.RemoveTodoDependencyParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTodoDependency", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTodoDependency indicates an expected call of RemoveTodoDependency.
+func (mr *MockStoreMockRecorder) RemoveTodoDependency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTodoDependency", reflect.TypeOf((*MockStore)(nil).RemoveTodoDependency), arg0, arg1)
+}
+
+// RemoveTodoWatcher mocks base method.
+func (m *MockStore) RemoveTodoWatcher(arg0 context.Context, arg1 db.RemoveTodoWatcherParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTodoWatcher", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTodoWatcher indicates an expected call of RemoveTodoWatcher.
+func (mr *MockStoreMockRecorder) RemoveTodoWatcher(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTodoWatcher", reflect.TypeOf((*MockStore)(nil).RemoveTodoWatcher), arg0, arg1)
+}
+
+// ReorderChecklistItems mocks base method.
+func (m *MockStore) ReorderChecklistItems(arg0 context.Context, arg1 db.ReorderChecklistItemsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderChecklistItems", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderChecklistItems indicates an expected call of ReorderChecklistItems.
+func (mr *MockStoreMockRecorder) ReorderChecklistItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderChecklistItems", reflect.TypeOf((*MockStore)(nil).ReorderChecklistItems), arg0, arg1)
+}
+
+// ReorderChecklistItemsTx mocks base method.
+func (m *MockStore) ReorderChecklistItemsTx(arg0 context.Context, arg1 db.ReorderChecklistItemsTxParams) (db.ReorderChecklistItemsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderChecklistItemsTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReorderChecklistItemsTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderChecklistItemsTx indicates an expected call of ReorderChecklistItemsTx.
+func (mr *MockStoreMockRecorder) ReorderChecklistItemsTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderChecklistItemsTx", reflect.TypeOf((*MockStore)(nil).ReorderChecklistItemsTx), arg0, arg1)
+}
+
+// ReportTimeByDay mocks base method.
+func (m *MockStore) ReportTimeByDay(arg0 context.Context, arg1 db.ReportTimeByDayParams) ([]db.ReportTimeByDayRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportTimeByDay", arg0, arg1)
+	ret0, _ := ret[0].([]db.ReportTimeByDayRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAttachment indicates an expected call of CreateAttachment.
-func (mr *MockStoreMockRecorder) CreateAttachment(arg0, arg1 interface{}) *gomock.Call {
+// ReportTimeByDay indicates an expected call of ReportTimeByDay.
+func (mr *MockStoreMockRecorder) ReportTimeByDay(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockStore)(nil).CreateAttachment), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportTimeByDay", reflect.TypeOf((*MockStore)(nil).ReportTimeByDay), arg0, arg1)
 }
 
-// CreateTodo mocks base method.
-func (m *MockStore) CreateTodo(arg0 context.Context, arg1 string) (db.Todo, error) {
+// ReportTimeByProject mocks base method.
+func (m *MockStore) ReportTimeByProject(arg0 context.Context, arg1 db.ReportTimeByProjectParams) ([]db.ReportTimeByProjectRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTodo", arg0, arg1)
-	ret0, _ := ret[0].(db.Todo)
+	ret := m.ctrl.Call(m, "ReportTimeByProject", arg0, arg1)
+	ret0, _ := ret[0].([]db.ReportTimeByProjectRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTodo indicates an expected call of CreateTodo.
-func (mr *MockStoreMockRecorder) CreateTodo(arg0, arg1 interface{}) *gomock.Call {
+// ReportTimeByProject indicates an expected call of ReportTimeByProject.
+func (mr *MockStoreMockRecorder) ReportTimeByProject(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTodo", reflect.TypeOf((*MockStore)(nil).CreateTodo), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportTimeByProject", reflect.TypeOf((*MockStore)(nil).ReportTimeByProject), arg0, arg1)
 }
 
-// CreateTodoTx mocks base method.
-func (m *MockStore) CreateTodoTx(arg0 context.Context, arg1 db.CreateTodoTxParams) (db.CreateTodoTxResult, error) {
+// ReportTimeByTodo mocks base method.
+func (m *MockStore) ReportTimeByTodo(arg0 context.Context, arg1 db.ReportTimeByTodoParams) ([]db.ReportTimeByTodoRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTodoTx", arg0, arg1)
-	ret0, _ := ret[0].(db.CreateTodoTxResult)
+	ret := m.ctrl.Call(m, "ReportTimeByTodo", arg0, arg1)
+	ret0, _ := ret[0].([]db.ReportTimeByTodoRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTodoTx indicates an expected call of CreateTodoTx.
-func (mr *MockStoreMockRecorder) CreateTodoTx(arg0, arg1 interface{}) *gomock.Call {
+// ReportTimeByTodo indicates an expected call of ReportTimeByTodo.
+func (mr *MockStoreMockRecorder) ReportTimeByTodo(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTodoTx", reflect.TypeOf((*MockStore)(nil).CreateTodoTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportTimeByTodo", reflect.TypeOf((*MockStore)(nil).ReportTimeByTodo), arg0, arg1)
 }
 
-// DeleteAttachment mocks base method.
-func (m *MockStore) DeleteAttachment(arg0 context.Context, arg1 int64) error {
+// RestoreTodo mocks base method.
+func (m *MockStore) RestoreTodo(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAttachment", arg0, arg1)
+	ret := m.ctrl.Call(m, "RestoreTodo", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteAttachment indicates an expected call of DeleteAttachment.
-func (mr *MockStoreMockRecorder) DeleteAttachment(arg0, arg1 interface{}) *gomock.Call {
+// RestoreTodo indicates an expected call of RestoreTodo.
+func (mr *MockStoreMockRecorder) RestoreTodo(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockStore)(nil).DeleteAttachment), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTodo", reflect.TypeOf((*MockStore)(nil).RestoreTodo), arg0, arg1)
 }
 
-// DeleteAttachmentTx mocks base method.
-func (m *MockStore) DeleteAttachmentTx(arg0 context.Context, arg1 db.DeleteAttachmentTxParams) error {
+// RestoreTodoTx mocks base method.
+func (m *MockStore) RestoreTodoTx(arg0 context.Context, arg1 int64) (db.RestoreTodoTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAttachmentTx", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RestoreTodoTx", arg0, arg1)
+	ret0, _ := ret[0].(db.RestoreTodoTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAttachmentTx indicates an expected call of DeleteAttachmentTx.
-func (mr *MockStoreMockRecorder) DeleteAttachmentTx(arg0, arg1 interface{}) *gomock.Call {
+// RestoreTodoTx indicates an expected call of RestoreTodoTx.
+func (mr *MockStoreMockRecorder) RestoreTodoTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachmentTx", reflect.TypeOf((*MockStore)(nil).DeleteAttachmentTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTodoTx", reflect.TypeOf((*MockStore)(nil).RestoreTodoTx), arg0, arg1)
 }
 
-// DeleteAttachmentsOfTodo mocks base method.
-func (m *MockStore) DeleteAttachmentsOfTodo(arg0 context.Context, arg1 int64) error {
+// RevertTodoRevisionTx mocks base method.
+func (m *MockStore) RevertTodoRevisionTx(arg0 context.Context, arg1 db.RevertTodoRevisionTxParams) (db.UpdateTodoTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAttachmentsOfTodo", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RevertTodoRevisionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdateTodoTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAttachmentsOfTodo indicates an expected call of DeleteAttachmentsOfTodo.
-func (mr *MockStoreMockRecorder) DeleteAttachmentsOfTodo(arg0, arg1 interface{}) *gomock.Call {
+// RevertTodoRevisionTx indicates an expected call of RevertTodoRevisionTx.
+func (mr *MockStoreMockRecorder) RevertTodoRevisionTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachmentsOfTodo", reflect.TypeOf((*MockStore)(nil).DeleteAttachmentsOfTodo), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertTodoRevisionTx", reflect.TypeOf((*MockStore)(nil).RevertTodoRevisionTx), arg0, arg1)
 }
 
-// DeleteTodo mocks base method.
-func (m *MockStore) DeleteTodo(arg0 context.Context, arg1 int64) error {
+// SearchTodos mocks base method.
+func (m *MockStore) SearchTodos(arg0 context.Context, arg1 db.SearchTodosParams) ([]db.SearchTodosRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTodo", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SearchTodos", arg0, arg1)
+	ret0, _ := ret[0].([]db.SearchTodosRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTodo indicates an expected call of DeleteTodo.
-func (mr *MockStoreMockRecorder) DeleteTodo(arg0, arg1 interface{}) *gomock.Call {
+// SearchTodos indicates an expected call of SearchTodos.
+func (mr *MockStoreMockRecorder) SearchTodos(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTodo", reflect.TypeOf((*MockStore)(nil).DeleteTodo), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTodos", reflect.TypeOf((*MockStore)(nil).SearchTodos), arg0, arg1)
 }
 
-// DeleteTodoTx mocks base method.
-func (m *MockStore) DeleteTodoTx(arg0 context.Context, arg1 db.DeleteTodoTxParams) error {
+// SetTodoMergedInto mocks base method.
+func (m *MockStore) SetTodoMergedInto(arg0 context.Context, arg1 db.SetTodoMergedIntoParams) (db.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTodoTx", arg0, arg1)
+	ret := m.ctrl.Call(m, "SetTodoMergedInto", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTodoMergedInto indicates an expected call of SetTodoMergedInto.
+func (mr *MockStoreMockRecorder) SetTodoMergedInto(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTodoMergedInto", reflect.TypeOf((*MockStore)(nil).SetTodoMergedInto), arg0, arg1)
+}
+
+// SetTodoParentTx mocks base method.
+func (m *MockStore) SetTodoParentTx(arg0 context.Context, arg1 db.SetTodoParentTxParams) (db.SetTodoParentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTodoParentTx", arg0, arg1)
+	ret0, _ := ret[0].(db.SetTodoParentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTodoParentTx indicates an expected call of SetTodoParentTx.
+func (mr *MockStoreMockRecorder) SetTodoParentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTodoParentTx", reflect.TypeOf((*MockStore)(nil).SetTodoParentTx), arg0, arg1)
+}
+
+// SetTodoRecurrenceTx mocks base method.
+func (m *MockStore) SetTodoRecurrenceTx(arg0 context.Context, arg1 db.SetTodoRecurrenceTxParams) (db.SetTodoRecurrenceTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTodoRecurrenceTx", arg0, arg1)
+	ret0, _ := ret[0].(db.SetTodoRecurrenceTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTodoRecurrenceTx indicates an expected call of SetTodoRecurrenceTx.
+func (mr *MockStoreMockRecorder) SetTodoRecurrenceTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTodoRecurrenceTx", reflect.TypeOf((*MockStore)(nil).SetTodoRecurrenceTx), arg0, arg1)
+}
+
+// SkipTodoOccurrenceTx mocks base method.
+func (m *MockStore) SkipTodoOccurrenceTx(arg0 context.Context, arg1 int64) (db.SkipTodoOccurrenceTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkipTodoOccurrenceTx", arg0, arg1)
+	ret0, _ := ret[0].(db.SkipTodoOccurrenceTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SkipTodoOccurrenceTx indicates an expected call of SkipTodoOccurrenceTx.
+func (mr *MockStoreMockRecorder) SkipTodoOccurrenceTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipTodoOccurrenceTx", reflect.TypeOf((*MockStore)(nil).SkipTodoOccurrenceTx), arg0, arg1)
+}
+
+// StartTimer mocks base method.
+func (m *MockStore) StartTimer(arg0 context.Context, arg1 db.StartTimerParams) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimer", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTimer indicates an expected call of StartTimer.
+func (mr *MockStoreMockRecorder) StartTimer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimer", reflect.TypeOf((*MockStore)(nil).StartTimer), arg0, arg1)
+}
+
+// StopTimer mocks base method.
+func (m *MockStore) StopTimer(arg0 context.Context, arg1 db.StopTimerParams) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTimer", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopTimer indicates an expected call of StopTimer.
+func (mr *MockStoreMockRecorder) StopTimer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTimer", reflect.TypeOf((*MockStore)(nil).StopTimer), arg0, arg1)
+}
+
+// TrashTodo mocks base method.
+func (m *MockStore) TrashTodo(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrashTodo", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteTodoTx indicates an expected call of DeleteTodoTx.
-func (mr *MockStoreMockRecorder) DeleteTodoTx(arg0, arg1 interface{}) *gomock.Call {
+// TrashTodo indicates an expected call of TrashTodo.
+func (mr *MockStoreMockRecorder) TrashTodo(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTodoTx", reflect.TypeOf((*MockStore)(nil).DeleteTodoTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrashTodo", reflect.TypeOf((*MockStore)(nil).TrashTodo), arg0, arg1)
 }
 
-// GetAttachment mocks base method.
-func (m *MockStore) GetAttachment(arg0 context.Context, arg1 int64) (db.Attachment, error) {
+// UnarchiveTodo mocks base method.
+func (m *MockStore) UnarchiveTodo(arg0 context.Context, arg1 int64) (db.Todo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAttachment", arg0, arg1)
-	ret0, _ := ret[0].(db.Attachment)
+	ret := m.ctrl.Call(m, "UnarchiveTodo", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAttachment indicates an expected call of GetAttachment.
-func (mr *MockStoreMockRecorder) GetAttachment(arg0, arg1 interface{}) *gomock.Call {
+// UnarchiveTodo indicates an expected call of UnarchiveTodo.
+func (mr *MockStoreMockRecorder) UnarchiveTodo(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockStore)(nil).GetAttachment), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveTodo", reflect.TypeOf((*MockStore)(nil).UnarchiveTodo), arg0, arg1)
 }
 
-// GetTodo mocks base method.
-func (m *MockStore) GetTodo(arg0 context.Context, arg1 int64) (db.Todo, error) {
+// UpdateChecklistItem mocks base method.
+func (m *MockStore) UpdateChecklistItem(arg0 context.Context, arg1 db.UpdateChecklistItemParams) (db.ChecklistItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTodo", arg0, arg1)
-	ret0, _ := ret[0].(db.Todo)
+	ret := m.ctrl.Call(m, "UpdateChecklistItem", arg0, arg1)
+	ret0, _ := ret[0].(db.ChecklistItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTodo indicates an expected call of GetTodo.
-func (mr *MockStoreMockRecorder) GetTodo(arg0, arg1 interface{}) *gomock.Call {
+// UpdateChecklistItem indicates an expected call of UpdateChecklistItem.
+func (mr *MockStoreMockRecorder) UpdateChecklistItem(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodo", reflect.TypeOf((*MockStore)(nil).GetTodo), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChecklistItem", reflect.TypeOf((*MockStore)(nil).UpdateChecklistItem), arg0, arg1)
 }
 
-// ListAttachmentOfTodo mocks base method.
-func (m *MockStore) ListAttachmentOfTodo(arg0 context.Context, arg1 int64) ([]db.Attachment, error) {
+// UpdateCommentBody mocks base method.
+func (m *MockStore) UpdateCommentBody(arg0 context.Context, arg1 db.UpdateCommentBodyParams) (db.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAttachmentOfTodo", arg0, arg1)
-	ret0, _ := ret[0].([]db.Attachment)
+	ret := m.ctrl.Call(m, "UpdateCommentBody", arg0, arg1)
+	ret0, _ := ret[0].(db.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAttachmentOfTodo indicates an expected call of ListAttachmentOfTodo.
-func (mr *MockStoreMockRecorder) ListAttachmentOfTodo(arg0, arg1 interface{}) *gomock.Call {
+// UpdateCommentBody indicates an expected call of UpdateCommentBody.
+func (mr *MockStoreMockRecorder) UpdateCommentBody(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachmentOfTodo", reflect.TypeOf((*MockStore)(nil).ListAttachmentOfTodo), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommentBody", reflect.TypeOf((*MockStore)(nil).UpdateCommentBody), arg0, arg1)
 }
 
-// ListTodos mocks base method.
-func (m *MockStore) ListTodos(arg0 context.Context, arg1 db.ListTodosParams) ([]db.Todo, error) {
+// UpdateCommentTx mocks base method.
+func (m *MockStore) UpdateCommentTx(arg0 context.Context, arg1 db.UpdateCommentTxParams) (db.UpdateCommentTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTodos", arg0, arg1)
-	ret0, _ := ret[0].([]db.Todo)
+	ret := m.ctrl.Call(m, "UpdateCommentTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdateCommentTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTodos indicates an expected call of ListTodos.
-func (mr *MockStoreMockRecorder) ListTodos(arg0, arg1 interface{}) *gomock.Call {
+// UpdateCommentTx indicates an expected call of UpdateCommentTx.
+func (mr *MockStoreMockRecorder) UpdateCommentTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTodos", reflect.TypeOf((*MockStore)(nil).ListTodos), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommentTx", reflect.TypeOf((*MockStore)(nil).UpdateCommentTx), arg0, arg1)
+}
+
+// UpdateProject mocks base method.
+func (m *MockStore) UpdateProject(arg0 context.Context, arg1 db.UpdateProjectParams) (db.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", arg0, arg1)
+	ret0, _ := ret[0].(db.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockStoreMockRecorder) UpdateProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockStore)(nil).UpdateProject), arg0, arg1)
+}
+
+// UpdateRecurrence mocks base method.
+func (m *MockStore) UpdateRecurrence(arg0 context.Context, arg1 db.UpdateRecurrenceParams) (db.Recurrence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecurrence", arg0, arg1)
+	ret0, _ := ret[0].(db.Recurrence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecurrence indicates an expected call of UpdateRecurrence.
+func (mr *MockStoreMockRecorder) UpdateRecurrence(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecurrence", reflect.TypeOf((*MockStore)(nil).UpdateRecurrence), arg0, arg1)
+}
+
+// UpdateSmartList mocks base method.
+func (m *MockStore) UpdateSmartList(arg0 context.Context, arg1 db.UpdateSmartListParams) (db.SmartList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSmartList", arg0, arg1)
+	ret0, _ := ret[0].(db.SmartList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSmartList indicates an expected call of UpdateSmartList.
+func (mr *MockStoreMockRecorder) UpdateSmartList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSmartList", reflect.TypeOf((*MockStore)(nil).UpdateSmartList), arg0, arg1)
+}
+
+// UpdateTag mocks base method.
+func (m *MockStore) UpdateTag(arg0 context.Context, arg1 db.UpdateTagParams) (db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", arg0, arg1)
+	ret0, _ := ret[0].(db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockStoreMockRecorder) UpdateTag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockStore)(nil).UpdateTag), arg0, arg1)
+}
+
+// UpdateTemplate mocks base method.
+func (m *MockStore) UpdateTemplate(arg0 context.Context, arg1 db.UpdateTemplateParams) (db.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplate", arg0, arg1)
+	ret0, _ := ret[0].(db.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTemplate indicates an expected call of UpdateTemplate.
+func (mr *MockStoreMockRecorder) UpdateTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockStore)(nil).UpdateTemplate), arg0, arg1)
+}
+
+// UpdateTemplateTx mocks base method.
+func (m *MockStore) UpdateTemplateTx(arg0 context.Context, arg1 db.UpdateTemplateTxParams) (db.UpdateTemplateTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplateTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdateTemplateTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTemplateTx indicates an expected call of UpdateTemplateTx.
+func (mr *MockStoreMockRecorder) UpdateTemplateTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateTx", reflect.TypeOf((*MockStore)(nil).UpdateTemplateTx), arg0, arg1)
+}
+
+// UpdateTimeEntry mocks base method.
+func (m *MockStore) UpdateTimeEntry(arg0 context.Context, arg1 db.UpdateTimeEntryParams) (db.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTimeEntry indicates an expected call of UpdateTimeEntry.
+func (mr *MockStoreMockRecorder) UpdateTimeEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeEntry", reflect.TypeOf((*MockStore)(nil).UpdateTimeEntry), arg0, arg1)
 }
 
 // UpdateTodoFileCount mocks base method.
@@ -225,6 +2404,51 @@ func (mr *MockStoreMockRecorder) UpdateTodoFileCount(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTodoFileCount", reflect.TypeOf((*MockStore)(nil).UpdateTodoFileCount), arg0, arg1)
 }
 
+// UpdateTodoParent mocks base method.
+func (m *MockStore) UpdateTodoParent(arg0 context.Context, arg1 db.UpdateTodoParentParams) (db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTodoParent", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTodoParent indicates an expected call of UpdateTodoParent.
+func (mr *MockStoreMockRecorder) UpdateTodoParent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTodoParent", reflect.TypeOf((*MockStore)(nil).UpdateTodoParent), arg0, arg1)
+}
+
+// UpdateTodoPosition mocks base method.
+func (m *MockStore) UpdateTodoPosition(arg0 context.Context, arg1 db.UpdateTodoPositionParams) (db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTodoPosition", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTodoPosition indicates an expected call of UpdateTodoPosition.
+func (mr *MockStoreMockRecorder) UpdateTodoPosition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTodoPosition", reflect.TypeOf((*MockStore)(nil).UpdateTodoPosition), arg0, arg1)
+}
+
+// UpdateTodoRecurrence mocks base method.
+func (m *MockStore) UpdateTodoRecurrence(arg0 context.Context, arg1 db.UpdateTodoRecurrenceParams) (db.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTodoRecurrence", arg0, arg1)
+	ret0, _ := ret[0].(db.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTodoRecurrence indicates an expected call of UpdateTodoRecurrence.
+func (mr *MockStoreMockRecorder) UpdateTodoRecurrence(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTodoRecurrence", reflect.TypeOf((*MockStore)(nil).UpdateTodoRecurrence), arg0, arg1)
+}
+
 // UpdateTodoTitleStatus mocks base method.
 func (m *MockStore) UpdateTodoTitleStatus(arg0 context.Context, arg1 db.UpdateTodoTitleStatusParams) (db.Todo, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +2464,51 @@ func (mr *MockStoreMockRecorder) UpdateTodoTitleStatus(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTodoTitleStatus", reflect.TypeOf((*MockStore)(nil).UpdateTodoTitleStatus), arg0, arg1)
 }
 
+// UpdateTodoTx mocks base method.
+func (m *MockStore) UpdateTodoTx(arg0 context.Context, arg1 db.UpdateTodoTxParams) (db.UpdateTodoTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTodoTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdateTodoTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTodoTx indicates an expected call of UpdateTodoTx.
+func (mr *MockStoreMockRecorder) UpdateTodoTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTodoTx", reflect.TypeOf((*MockStore)(nil).UpdateTodoTx), arg0, arg1)
+}
+
+// UpdateWorkflowName mocks base method.
+func (m *MockStore) UpdateWorkflowName(arg0 context.Context, arg1 db.UpdateWorkflowNameParams) (db.Workflow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowName", arg0, arg1)
+	ret0, _ := ret[0].(db.Workflow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowName indicates an expected call of UpdateWorkflowName.
+func (mr *MockStoreMockRecorder) UpdateWorkflowName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowName", reflect.TypeOf((*MockStore)(nil).UpdateWorkflowName), arg0, arg1)
+}
+
+// UpdateWorkflowTx mocks base method.
+func (m *MockStore) UpdateWorkflowTx(arg0 context.Context, arg1 db.UpdateWorkflowTxParams) (db.UpdateWorkflowTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdateWorkflowTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowTx indicates an expected call of UpdateWorkflowTx.
+func (mr *MockStoreMockRecorder) UpdateWorkflowTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowTx", reflect.TypeOf((*MockStore)(nil).UpdateWorkflowTx), arg0, arg1)
+}
+
 // UploadAttachmentTx mocks base method.
 func (m *MockStore) UploadAttachmentTx(arg0 context.Context, arg1 db.UploadAttachmentTxParams) error {
 	m.ctrl.T.Helper()
@@ -253,3 +2522,17 @@ func (mr *MockStoreMockRecorder) UploadAttachmentTx(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachmentTx", reflect.TypeOf((*MockStore)(nil).UploadAttachmentTx), arg0, arg1)
 }
+
+// UploadTemplateAttachmentTx mocks base method.
+func (m *MockStore) UploadTemplateAttachmentTx(arg0 context.Context, arg1 db.UploadTemplateAttachmentTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadTemplateAttachmentTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadTemplateAttachmentTx indicates an expected call of UploadTemplateAttachmentTx.
+func (mr *MockStoreMockRecorder) UploadTemplateAttachmentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTemplateAttachmentTx", reflect.TypeOf((*MockStore)(nil).UploadTemplateAttachmentTx), arg0, arg1)
+}
@@ -15,6 +15,11 @@ WHERE id = $1 LIMIT 1;
 SELECT * FROM attachments
 WHERE todo_id = $1 LIMIT 5;
 
+-- name: ListAttachmentsOfTodos :many
+SELECT * FROM attachments
+WHERE todo_id = ANY(sqlc.arg(todo_ids)::bigint[])
+ORDER BY todo_id, id;
+
 -- name: DeleteAttachment :exec
 DELETE FROM attachments
 WHERE id = $1;
@@ -22,3 +27,9 @@ WHERE id = $1;
 -- name: DeleteAttachmentsOfTodo :exec
 DELETE FROM attachments
 WHERE todo_id = $1;
+
+-- name: MoveTodoAttachments :many
+UPDATE attachments
+SET todo_id = sqlc.arg(target_todo_id)::bigint
+WHERE todo_id = sqlc.arg(source_todo_id)::bigint
+RETURNING *;
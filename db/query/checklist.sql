@@ -0,0 +1,43 @@
+-- name: CreateChecklistItem :one
+INSERT INTO checklist_items (
+    todo_id,
+    text,
+    position
+) VALUES (
+    $1, $2,
+    COALESCE((SELECT MAX(position) FROM checklist_items WHERE todo_id = $1), 0) + 65536
+) RETURNING *;
+
+-- name: GetChecklistItem :one
+SELECT * FROM checklist_items
+WHERE id = $1 LIMIT 1;
+
+-- name: ListChecklistItems :many
+SELECT * FROM checklist_items
+WHERE todo_id = $1
+ORDER BY position, id;
+
+-- name: UpdateChecklistItem :one
+UPDATE checklist_items
+SET text = COALESCE(sqlc.narg(text), text),
+    checked = COALESCE(sqlc.narg(checked), checked)
+WHERE id = $1
+RETURNING *;
+
+-- name: ReorderChecklistItems :exec
+-- Positions the items of the todo in the order of the IDs
+UPDATE checklist_items
+SET position = ordered.rank * 65536
+FROM unnest(sqlc.arg(item_ids)::bigint[]) WITH ORDINALITY AS ordered(id, rank)
+WHERE checklist_items.id = ordered.id
+    AND checklist_items.todo_id = sqlc.arg(todo_id)::bigint;
+
+-- name: DeleteChecklistItem :exec
+DELETE FROM checklist_items
+WHERE id = $1;
+
+-- name: CopyChecklistItems :exec
+-- The copies start unchecked
+INSERT INTO checklist_items (todo_id, text, position)
+SELECT sqlc.arg(target_todo_id)::bigint, text, position FROM checklist_items
+WHERE todo_id = sqlc.arg(source_todo_id)::bigint;
@@ -0,0 +1,60 @@
+-- name: CreateComment :one
+INSERT INTO comments (
+    todo_id,
+    body
+) VALUES (
+    $1, $2
+) RETURNING *;
+
+-- name: GetComment :one
+SELECT * FROM comments
+WHERE id = $1 LIMIT 1;
+
+-- name: ListComments :many
+SELECT * FROM comments
+WHERE todo_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListAllComments :many
+SELECT * FROM comments
+WHERE todo_id = $1
+ORDER BY id;
+
+-- name: UpdateCommentBody :one
+UPDATE comments
+SET body = $2,
+    edited_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteComment :exec
+DELETE FROM comments
+WHERE id = $1;
+
+-- name: AddCommentAttachments :exec
+INSERT INTO comment_attachments (
+    comment_id,
+    attachment_id
+) SELECT sqlc.arg(comment_id)::bigint, unnest(sqlc.arg(attachment_ids)::bigint[])
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteCommentAttachments :exec
+DELETE FROM comment_attachments
+WHERE comment_id = $1;
+
+-- name: ListCommentAttachments :many
+SELECT * FROM comment_attachments
+WHERE comment_id = ANY(sqlc.arg(comment_ids)::bigint[])
+ORDER BY comment_id, attachment_id;
+
+-- name: CountAttachmentsOfTodo :one
+SELECT COUNT(*) FROM attachments
+WHERE todo_id = sqlc.arg(todo_id)::bigint
+    AND id = ANY(sqlc.arg(attachment_ids)::bigint[]);
+
+-- name: MoveTodoComments :exec
+UPDATE comments
+SET todo_id = sqlc.arg(target_todo_id)::bigint
+WHERE todo_id = sqlc.arg(source_todo_id)::bigint;
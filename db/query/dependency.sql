@@ -0,0 +1,37 @@
+-- name: AddTodoDependency :exec
+INSERT INTO todo_dependencies (
+    todo_id,
+    blocked_by_id
+) VALUES (
+    $1, $2
+) ON CONFLICT DO NOTHING;
+
+-- name: RemoveTodoDependency :exec
+DELETE FROM todo_dependencies
+WHERE todo_id = $1 AND blocked_by_id = $2;
+
+-- name: ListTodoBlockers :many
+SELECT todos.* FROM todos
+JOIN todo_dependencies ON todo_dependencies.blocked_by_id = todos.id
+WHERE todo_dependencies.todo_id = $1 AND todos.deleted_at IS NULL
+ORDER BY todos.position, todos.id;
+
+-- name: ListTodosBlockedBy :many
+SELECT todos.* FROM todos
+JOIN todo_dependencies ON todo_dependencies.todo_id = todos.id
+WHERE todo_dependencies.blocked_by_id = $1 AND todos.deleted_at IS NULL
+ORDER BY todos.position, todos.id;
+
+-- name: IsTodoBlockedBy :one
+WITH RECURSIVE blockers AS (
+    SELECT blocked_by_id FROM todo_dependencies WHERE todo_id = sqlc.arg(todo_id)::bigint
+    UNION
+    SELECT todo_dependencies.blocked_by_id FROM todo_dependencies
+    JOIN blockers ON todo_dependencies.todo_id = blockers.blocked_by_id
+)
+SELECT EXISTS (
+    SELECT 1 FROM blockers WHERE blocked_by_id = sqlc.arg(blocker_id)::bigint
+)::bool;
+
+-- name: LockTodoDependencies :exec
+SELECT pg_advisory_xact_lock(hashtext('todo_dependencies'));
@@ -0,0 +1,26 @@
+-- name: CreateProject :one
+INSERT INTO projects (
+    name,
+    workflow_id
+) VALUES (
+    $1, $2
+) RETURNING *;
+
+-- name: GetProject :one
+SELECT * FROM projects
+WHERE id = $1 LIMIT 1;
+
+-- name: ListProjects :many
+SELECT * FROM projects
+ORDER BY name, id;
+
+-- name: UpdateProject :one
+UPDATE projects
+SET name = COALESCE(sqlc.narg(name), name),
+    workflow_id = CASE WHEN sqlc.arg(update_workflow_id)::bool THEN sqlc.narg(workflow_id)::bigint ELSE workflow_id END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteProject :exec
+DELETE FROM projects
+WHERE id = $1;
@@ -0,0 +1,26 @@
+-- name: CreateRecurrence :one
+INSERT INTO recurrences (
+    rrule,
+    dtstart,
+    timezone,
+    copy_attachments
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetRecurrence :one
+SELECT * FROM recurrences
+WHERE id = $1 LIMIT 1;
+
+-- name: UpdateRecurrence :one
+UPDATE recurrences
+SET rrule = $2,
+    dtstart = $3,
+    timezone = $4,
+    copy_attachments = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteRecurrence :exec
+DELETE FROM recurrences
+WHERE id = $1;
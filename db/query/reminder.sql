@@ -0,0 +1,51 @@
+-- name: CreateReminder :one
+INSERT INTO reminders (
+    todo_id,
+    offset_minutes
+) VALUES (
+    $1, $2
+) RETURNING *;
+
+-- name: GetReminder :one
+SELECT * FROM reminders
+WHERE id = $1 LIMIT 1;
+
+-- name: ListRemindersOfTodo :many
+SELECT * FROM reminders
+WHERE todo_id = $1
+ORDER BY offset_minutes DESC;
+
+-- name: DeleteReminder :exec
+DELETE FROM reminders
+WHERE id = $1;
+
+-- name: ListDueReminders :many
+SELECT reminders.id, reminders.todo_id, reminders.offset_minutes, reminders.failed_attempts, todos.title, todos.due_at, todos.due_timezone FROM reminders
+JOIN todos ON todos.id = reminders.todo_id
+WHERE todos.due_at IS NOT NULL
+    AND todos.deleted_at IS NULL
+    AND todos.completed_at IS NULL
+    AND reminders.fired_for_due_at IS DISTINCT FROM todos.due_at
+    AND todos.due_at - make_interval(mins => reminders.offset_minutes) <= sqlc.arg(now)::timestamptz
+    AND (reminders.retry_at IS NULL OR reminders.retry_at <= sqlc.arg(now)::timestamptz)
+ORDER BY reminders.failed_attempts, todos.due_at, reminders.id
+LIMIT sqlc.arg('limit');
+
+-- name: MarkReminderFired :exec
+UPDATE reminders
+SET fired_at = sqlc.arg(fired_at)::timestamptz,
+    fired_for_due_at = sqlc.arg(fired_for_due_at)::timestamptz,
+    failed_attempts = 0,
+    retry_at = NULL
+WHERE id = sqlc.arg(id);
+
+-- name: MarkReminderFailed :exec
+UPDATE reminders
+SET failed_attempts = failed_attempts + 1,
+    retry_at = sqlc.arg(retry_at)::timestamptz
+WHERE id = sqlc.arg(id);
+
+-- name: CopyTodoReminders :exec
+INSERT INTO reminders (todo_id, offset_minutes)
+SELECT sqlc.arg(target_todo_id)::bigint, offset_minutes FROM reminders
+WHERE todo_id = sqlc.arg(source_todo_id)::bigint;
@@ -0,0 +1,16 @@
+-- name: CreateTodoRevision :one
+INSERT INTO todo_revisions (
+    todo_id,
+    changes
+) VALUES (
+    $1, $2
+) RETURNING *;
+
+-- name: GetTodoRevision :one
+SELECT * FROM todo_revisions
+WHERE id = $1 LIMIT 1;
+
+-- name: ListTodoRevisions :many
+SELECT * FROM todo_revisions
+WHERE todo_id = $1
+ORDER BY id DESC;
@@ -0,0 +1,31 @@
+-- name: CreateSmartList :one
+INSERT INTO smart_lists (
+    user_id,
+    name,
+    query,
+    pinned
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetSmartList :one
+SELECT * FROM smart_lists
+WHERE id = $1 LIMIT 1;
+
+-- name: ListSmartLists :many
+-- Pinned smart lists come first
+SELECT * FROM smart_lists
+WHERE user_id = $1
+ORDER BY pinned DESC, name;
+
+-- name: UpdateSmartList :one
+UPDATE smart_lists
+SET name = COALESCE(sqlc.narg(name), name),
+    query = COALESCE(sqlc.narg(query), query),
+    pinned = COALESCE(sqlc.narg(pinned), pinned)
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteSmartList :exec
+DELETE FROM smart_lists
+WHERE id = $1;
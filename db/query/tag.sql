@@ -0,0 +1,61 @@
+-- name: CreateTag :one
+INSERT INTO tags (
+    name,
+    color
+) VALUES (
+    $1, $2
+) RETURNING *;
+
+-- name: GetTag :one
+SELECT * FROM tags
+WHERE id = $1 LIMIT 1;
+
+-- name: ListTags :many
+SELECT * FROM tags
+ORDER BY name;
+
+-- name: UpdateTag :one
+UPDATE tags
+SET name = COALESCE(sqlc.narg(name), name),
+    color = COALESCE(sqlc.narg(color), color)
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteTag :exec
+DELETE FROM tags
+WHERE id = $1;
+
+-- name: AddTagsToTodo :exec
+INSERT INTO todo_tags (
+    todo_id,
+    tag_id
+) SELECT sqlc.arg(todo_id)::bigint, unnest(sqlc.arg(tag_ids)::bigint[])
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveTagFromTodo :exec
+DELETE FROM todo_tags
+WHERE todo_id = $1 AND tag_id = $2;
+
+-- name: ListTagsOfTodo :many
+SELECT tags.* FROM tags
+JOIN todo_tags ON todo_tags.tag_id = tags.id
+WHERE todo_tags.todo_id = $1
+ORDER BY tags.name;
+
+-- name: MoveTodoTags :exec
+INSERT INTO todo_tags (
+    todo_id,
+    tag_id
+) SELECT todo_id, sqlc.arg(target_tag_id)::bigint FROM todo_tags
+WHERE tag_id = sqlc.arg(source_tag_id)
+ON CONFLICT DO NOTHING;
+
+-- name: CopyTodoTags :exec
+INSERT INTO todo_tags (todo_id, tag_id)
+SELECT sqlc.arg(target_todo_id)::bigint, tag_id FROM todo_tags
+WHERE todo_id = sqlc.arg(source_todo_id)::bigint
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteTodoTags :exec
+DELETE FROM todo_tags
+WHERE todo_id = $1;
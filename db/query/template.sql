@@ -0,0 +1,78 @@
+-- name: CreateTemplate :one
+INSERT INTO templates (
+    name,
+    title,
+    description,
+    priority,
+    checklist,
+    subtasks
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetTemplate :one
+SELECT * FROM templates
+WHERE id = $1 LIMIT 1;
+
+-- name: ListTemplates :many
+SELECT * FROM templates
+ORDER BY name;
+
+-- name: UpdateTemplate :one
+UPDATE templates
+SET name = COALESCE(sqlc.narg(name), name),
+    title = COALESCE(sqlc.narg(title), title),
+    description = COALESCE(sqlc.narg(description), description),
+    priority = COALESCE(sqlc.narg(priority), priority),
+    checklist = COALESCE(sqlc.narg(checklist)::varchar[], checklist),
+    subtasks = COALESCE(sqlc.narg(subtasks)::varchar[], subtasks)
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteTemplate :exec
+DELETE FROM templates
+WHERE id = $1;
+
+-- name: AddTagsToTemplate :exec
+INSERT INTO template_tags (
+    template_id,
+    tag_id
+) SELECT sqlc.arg(template_id)::bigint, unnest(sqlc.arg(tag_ids)::bigint[])
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteTemplateTags :exec
+DELETE FROM template_tags
+WHERE template_id = $1;
+
+-- name: ListTagsOfTemplate :many
+SELECT tags.* FROM tags
+JOIN template_tags ON template_tags.tag_id = tags.id
+WHERE template_tags.template_id = $1
+ORDER BY tags.name;
+
+-- name: CopyTemplateTags :exec
+INSERT INTO todo_tags (todo_id, tag_id)
+SELECT sqlc.arg(todo_id)::bigint, tag_id FROM template_tags
+WHERE template_id = sqlc.arg(template_id)::bigint;
+
+-- name: CreateTemplateAttachment :one
+INSERT INTO template_attachments (
+    template_id,
+    original_filename,
+    storage_filename
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: GetTemplateAttachment :one
+SELECT * FROM template_attachments
+WHERE id = $1 LIMIT 1;
+
+-- name: ListTemplateAttachments :many
+SELECT * FROM template_attachments
+WHERE template_id = $1
+ORDER BY id;
+
+-- name: DeleteTemplateAttachment :exec
+DELETE FROM template_attachments
+WHERE id = $1;
@@ -0,0 +1,96 @@
+-- name: StartTimer :one
+-- Fails with a unique violation if the user already has a running timer
+INSERT INTO time_entries (
+    todo_id,
+    user_id,
+    started_at
+) VALUES (
+    $1, $2, now()
+) RETURNING *;
+
+-- name: StopTimer :one
+UPDATE time_entries
+SET stopped_at = now()
+WHERE todo_id = $1
+    AND user_id = $2
+    AND stopped_at IS NULL
+RETURNING *;
+
+-- name: CreateTimeEntry :one
+INSERT INTO time_entries (
+    todo_id,
+    user_id,
+    started_at,
+    stopped_at,
+    note
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetTimeEntry :one
+SELECT * FROM time_entries
+WHERE id = $1 LIMIT 1;
+
+-- name: ListTimeEntries :many
+SELECT * FROM time_entries
+WHERE todo_id = $1
+ORDER BY started_at, id;
+
+-- name: UpdateTimeEntry :one
+UPDATE time_entries
+SET started_at = COALESCE(sqlc.narg(started_at), started_at),
+    stopped_at = COALESCE(sqlc.narg(stopped_at), stopped_at),
+    note = COALESCE(sqlc.narg(note), note)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteTimeEntry :exec
+DELETE FROM time_entries
+WHERE id = $1;
+
+-- name: ReportTimeByTodo :many
+-- Sums the time tracked on each todo by the entries started within the range; running timers count until now
+SELECT
+    time_entries.todo_id,
+    todos.title,
+    SUM(EXTRACT(EPOCH FROM COALESCE(time_entries.stopped_at, now()) - time_entries.started_at))::bigint AS seconds
+FROM time_entries
+JOIN todos ON todos.id = time_entries.todo_id
+WHERE todos.deleted_at IS NULL
+    AND time_entries.started_at >= sqlc.arg(started_from)
+    AND time_entries.started_at < sqlc.arg(started_before)
+    AND (sqlc.narg(user_id)::varchar IS NULL OR time_entries.user_id = sqlc.narg(user_id))
+GROUP BY time_entries.todo_id, todos.title
+ORDER BY seconds DESC, time_entries.todo_id;
+
+-- name: ReportTimeByProject :many
+-- Sums the time tracked on the todos of each project by the entries started within the range;
+-- todos outside of any project are grouped under a NULL project
+SELECT
+    todos.project_id,
+    projects.name,
+    SUM(EXTRACT(EPOCH FROM COALESCE(time_entries.stopped_at, now()) - time_entries.started_at))::bigint AS seconds
+FROM time_entries
+JOIN todos ON todos.id = time_entries.todo_id
+LEFT JOIN projects ON projects.id = todos.project_id
+WHERE todos.deleted_at IS NULL
+    AND time_entries.started_at >= sqlc.arg(started_from)
+    AND time_entries.started_at < sqlc.arg(started_before)
+    AND (sqlc.narg(user_id)::varchar IS NULL OR time_entries.user_id = sqlc.narg(user_id))
+GROUP BY todos.project_id, projects.name
+ORDER BY seconds DESC, todos.project_id;
+
+-- name: ReportTimeByDay :many
+-- Sums the time tracked each day, in the timezone, by the entries started within the range;
+-- an entry counts toward the day it started
+SELECT
+    to_char(time_entries.started_at AT TIME ZONE sqlc.arg(timezone)::text, 'YYYY-MM-DD') AS day,
+    SUM(EXTRACT(EPOCH FROM COALESCE(time_entries.stopped_at, now()) - time_entries.started_at))::bigint AS seconds
+FROM time_entries
+JOIN todos ON todos.id = time_entries.todo_id
+WHERE todos.deleted_at IS NULL
+    AND time_entries.started_at >= sqlc.arg(started_from)
+    AND time_entries.started_at < sqlc.arg(started_before)
+    AND (sqlc.narg(user_id)::varchar IS NULL OR time_entries.user_id = sqlc.narg(user_id))
+GROUP BY day
+ORDER BY day;
@@ -1,33 +1,246 @@
 -- name: CreateTodo :one
+-- New todos start in the first state of their workflow
 INSERT INTO todos (
-    title
+    title,
+    due_at,
+    due_timezone,
+    priority,
+    parent_id,
+    project_id,
+    description,
+    status,
+    position
 ) VALUES (
-    $1
+    $1, $2, $3, $4, $5, $6, $7,
+    (
+        SELECT workflow_states.name FROM workflow_states
+        WHERE workflow_states.workflow_id = COALESCE(
+            (SELECT projects.workflow_id FROM projects WHERE projects.id = $6),
+            (SELECT workflows.id FROM workflows WHERE workflows.is_default)
+        )
+        ORDER BY workflow_states.position
+        LIMIT 1
+    ),
+    COALESCE((SELECT MAX(position) FROM todos), 0) + 65536
 ) RETURNING *;
 
 -- name: GetTodo :one
 SELECT * FROM todos
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
 
--- name: ListTodos :many
+-- name: GetTodoForUpdate :one
 SELECT * FROM todos
-ORDER BY id
-LIMIT $1
-OFFSET $2;
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetTrashedTodo :one
+SELECT * FROM todos
+WHERE id = $1 AND deleted_at IS NOT NULL LIMIT 1;
 
 -- name: UpdateTodoTitleStatus :one
 UPDATE todos
 SET title = COALESCE(sqlc.narg(title), title),
-    status = COALESCE(sqlc.narg(status), status)
+    status = COALESCE(sqlc.narg(status), status),
+    due_at = CASE WHEN sqlc.arg(update_due_at)::bool THEN sqlc.narg(due_at)::timestamptz ELSE due_at END,
+    due_timezone = COALESCE(sqlc.narg(due_timezone), due_timezone),
+    priority = COALESCE(sqlc.narg(priority), priority),
+    completed_at = CASE WHEN sqlc.arg(update_completed_at)::bool THEN sqlc.narg(completed_at)::timestamptz ELSE completed_at END,
+    archived_at = CASE WHEN sqlc.arg(update_completed_at)::bool AND sqlc.narg(completed_at)::timestamptz IS NULL THEN NULL ELSE archived_at END,
+    description = COALESCE(sqlc.narg(description), description),
+    updated_at = now()
 WHERE id = $1
 RETURNING *;
 
 -- name: UpdateTodoFileCount :one
 UPDATE todos
-SET file_count = file_count + $2
+SET file_count = file_count + $2,
+    updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateTodoPosition :one
+UPDATE todos
+SET position = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: GetTodoPositionBefore :one
+SELECT position FROM todos
+WHERE (position, id) < (sqlc.arg(position)::bigint, sqlc.arg(id)::bigint)
+    AND id <> sqlc.arg(exclude_id)::bigint
+ORDER BY position DESC, id DESC
+LIMIT 1;
+
+-- name: GetTodoPositionAfter :one
+SELECT position FROM todos
+WHERE (position, id) > (sqlc.arg(position)::bigint, sqlc.arg(id)::bigint)
+    AND id <> sqlc.arg(exclude_id)::bigint
+ORDER BY position, id
+LIMIT 1;
+
+-- name: RebalanceTodoPositions :exec
+UPDATE todos
+SET position = ranked.rank * 65536
+FROM (
+    SELECT id, row_number() OVER (ORDER BY position, id) AS rank FROM todos
+) AS ranked
+WHERE todos.id = ranked.id;
+
+-- name: UpdateTodoParent :one
+UPDATE todos
+SET parent_id = $2,
+    updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListTodoDescendants :many
+WITH RECURSIVE descendants AS (
+    SELECT id FROM todos WHERE parent_id = sqlc.arg(todo_id)::bigint AND deleted_at IS NULL
+    UNION ALL
+    SELECT todos.id FROM todos
+    JOIN descendants ON todos.parent_id = descendants.id
+    WHERE todos.deleted_at IS NULL
+)
+SELECT todos.* FROM todos
+JOIN descendants ON todos.id = descendants.id
+ORDER BY todos.position, todos.id;
+
+-- name: ListAllTodoDescendants :many
+-- Includes the trashed descendants
+WITH RECURSIVE descendants AS (
+    SELECT id FROM todos WHERE parent_id = sqlc.arg(todo_id)::bigint
+    UNION ALL
+    SELECT todos.id FROM todos
+    JOIN descendants ON todos.parent_id = descendants.id
+)
+SELECT todos.* FROM todos
+JOIN descendants ON todos.id = descendants.id
+ORDER BY todos.position, todos.id;
+
+-- name: IsTodoAncestor :one
+WITH RECURSIVE ancestors AS (
+    SELECT id, parent_id FROM todos WHERE id = sqlc.arg(todo_id)::bigint
+    UNION ALL
+    SELECT todos.id, todos.parent_id FROM todos
+    JOIN ancestors ON todos.id = ancestors.parent_id
+)
+SELECT EXISTS (
+    SELECT 1 FROM ancestors WHERE id = sqlc.arg(ancestor_id)::bigint
+)::bool;
+
+-- name: LockTodoHierarchy :exec
+SELECT pg_advisory_xact_lock(hashtext('todo_hierarchy'));
+
+-- name: ListTodoRollups :many
+SELECT
+    todos.id AS todo_id,
+    (
+        SELECT COUNT(*) FROM todos AS children
+        WHERE children.parent_id = todos.id AND children.deleted_at IS NULL
+    )::int AS children_total,
+    (
+        SELECT COUNT(*) FROM todos AS children
+        WHERE children.parent_id = todos.id AND children.deleted_at IS NULL AND children.completed_at IS NOT NULL
+    )::int AS children_completed,
+    (
+        SELECT COUNT(*) FROM todo_dependencies
+        JOIN todos AS blockers ON blockers.id = todo_dependencies.blocked_by_id
+        WHERE todo_dependencies.todo_id = todos.id AND blockers.deleted_at IS NULL AND blockers.completed_at IS NULL
+    )::int AS open_blockers,
+    (SELECT COUNT(*) FROM checklist_items WHERE checklist_items.todo_id = todos.id)::int AS checklist_total,
+    (SELECT COUNT(*) FROM checklist_items WHERE checklist_items.todo_id = todos.id AND checklist_items.checked)::int AS checklist_checked
+FROM todos
+WHERE todos.id = ANY(sqlc.arg(todo_ids)::bigint[]);
+
+-- name: UpdateTodoRecurrence :one
+UPDATE todos
+SET recurrence_id = $2,
+    updated_at = now()
 WHERE id = $1
 RETURNING *;
 
 -- name: DeleteTodo :exec
 DELETE FROM todos
 WHERE id = $1;
+
+-- name: TrashTodo :exec
+-- Trashes the todo along with its subtasks which aren't trashed yet
+WITH RECURSIVE subtree AS (
+    SELECT id FROM todos WHERE id = sqlc.arg(todo_id)::bigint
+    UNION ALL
+    SELECT todos.id FROM todos
+    JOIN subtree ON todos.parent_id = subtree.id
+    WHERE todos.deleted_at IS NULL
+)
+UPDATE todos
+SET deleted_at = now()
+FROM subtree
+WHERE todos.id = subtree.id;
+
+-- name: RestoreTodo :exec
+-- Restores the todo along with the subtasks trashed at the same time
+WITH RECURSIVE subtree AS (
+    SELECT id, deleted_at FROM todos WHERE id = sqlc.arg(todo_id)::bigint
+    UNION ALL
+    SELECT todos.id, todos.deleted_at FROM todos
+    JOIN subtree ON todos.parent_id = subtree.id
+    WHERE todos.deleted_at = subtree.deleted_at
+)
+UPDATE todos
+SET deleted_at = NULL
+FROM subtree
+WHERE todos.id = subtree.id;
+
+-- name: ListTrashedTodos :many
+SELECT * FROM todos
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id DESC
+LIMIT $1
+OFFSET $2;
+
+-- name: ListPurgeableTodos :many
+-- Todos trashed before the cutoff, except those purged along with a trashed parent
+SELECT todos.* FROM todos
+LEFT JOIN todos AS parents ON parents.id = todos.parent_id
+WHERE todos.deleted_at < sqlc.arg(deleted_before)::timestamptz
+    AND (parents.deleted_at IS NULL OR parents.deleted_at >= sqlc.arg(deleted_before)::timestamptz)
+ORDER BY todos.deleted_at, todos.id
+LIMIT sqlc.arg('limit');
+
+-- name: ArchiveTodo :one
+UPDATE todos
+SET archived_at = COALESCE(archived_at, now())
+WHERE id = $1
+RETURNING *;
+
+-- name: UnarchiveTodo :one
+UPDATE todos
+SET archived_at = NULL
+WHERE id = $1
+RETURNING *;
+
+-- name: ArchiveCompletedTodos :execrows
+UPDATE todos
+SET archived_at = now()
+WHERE completed_at < sqlc.arg(completed_before)::timestamptz
+    AND archived_at IS NULL
+    AND deleted_at IS NULL;
+
+-- name: SetTodoMergedInto :one
+UPDATE todos
+SET merged_into_id = $2,
+    updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListSimilarTodos :many
+-- Lists the open todos whose title is similar to the title by trigram similarity, most similar first; the '%'
+-- operator uses the trigram index of the titles, so thresholds below pg_trgm.similarity_threshold (0.3) act as it
+SELECT sqlc.embed(todos), similarity(title, sqlc.arg(title)::text)::real AS similarity
+FROM todos
+WHERE title % sqlc.arg(title)::text
+    AND similarity(title, sqlc.arg(title)::text) >= sqlc.arg(threshold)::real
+    AND completed_at IS NULL
+    AND deleted_at IS NULL
+ORDER BY similarity DESC, id
+LIMIT sqlc.arg(max_results);
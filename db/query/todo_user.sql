@@ -0,0 +1,41 @@
+-- name: AddTodoAssignees :exec
+INSERT INTO todo_assignees (
+    todo_id,
+    user_id
+) SELECT sqlc.arg(todo_id)::bigint, unnest(sqlc.arg(user_ids)::varchar[])
+ON CONFLICT DO NOTHING;
+
+-- name: ListTodoAssignees :many
+SELECT * FROM todo_assignees
+WHERE todo_id = $1
+ORDER BY created_at, user_id;
+
+-- name: RemoveTodoAssignee :execrows
+DELETE FROM todo_assignees
+WHERE todo_id = $1 AND user_id = $2;
+
+-- name: AddTodoWatcher :exec
+INSERT INTO todo_watchers (
+    todo_id,
+    user_id
+) VALUES (
+    $1, $2
+) ON CONFLICT DO NOTHING;
+
+-- name: ListTodoWatchers :many
+SELECT * FROM todo_watchers
+WHERE todo_id = $1
+ORDER BY created_at, user_id;
+
+-- name: RemoveTodoWatcher :exec
+DELETE FROM todo_watchers
+WHERE todo_id = $1 AND user_id = $2;
+
+-- name: ListTodoRecipients :many
+-- Lists the users assigned to or watching the todo, who get notified about its changes
+SELECT user_id FROM todo_assignees
+WHERE todo_assignees.todo_id = $1
+UNION
+SELECT user_id FROM todo_watchers
+WHERE todo_watchers.todo_id = $1
+ORDER BY user_id;
@@ -0,0 +1,87 @@
+-- name: CreateWorkflow :one
+INSERT INTO workflows (
+    name
+) VALUES (
+    $1
+) RETURNING *;
+
+-- name: GetWorkflow :one
+SELECT * FROM workflows
+WHERE id = $1 LIMIT 1;
+
+-- name: ListWorkflows :many
+SELECT * FROM workflows
+ORDER BY name;
+
+-- name: UpdateWorkflowName :one
+UPDATE workflows
+SET name = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWorkflow :exec
+DELETE FROM workflows
+WHERE id = $1;
+
+-- name: CreateWorkflowStates :exec
+INSERT INTO workflow_states (
+    workflow_id,
+    name,
+    terminal,
+    position
+) SELECT sqlc.arg(workflow_id)::bigint, states.name, states.terminal, states.position - 1
+FROM unnest(sqlc.arg(names)::varchar[], sqlc.arg(terminals)::bool[]) WITH ORDINALITY AS states(name, terminal, position);
+
+-- name: ListWorkflowStates :many
+SELECT * FROM workflow_states
+WHERE workflow_id = $1
+ORDER BY position;
+
+-- name: GetWorkflowState :one
+SELECT * FROM workflow_states
+WHERE workflow_id = $1 AND name = $2 LIMIT 1;
+
+-- name: DeleteWorkflowStates :exec
+DELETE FROM workflow_states
+WHERE workflow_id = $1;
+
+-- name: CreateWorkflowTransitions :exec
+INSERT INTO workflow_transitions (
+    workflow_id,
+    from_state,
+    to_state
+) SELECT sqlc.arg(workflow_id)::bigint, unnest(sqlc.arg(from_states)::varchar[]), unnest(sqlc.arg(to_states)::varchar[]);
+
+-- name: ListWorkflowTransitions :many
+SELECT * FROM workflow_transitions
+WHERE workflow_id = $1
+ORDER BY from_state, to_state;
+
+-- name: IsWorkflowTransitionAllowed :one
+SELECT EXISTS (
+    SELECT 1 FROM workflow_transitions
+    WHERE workflow_id = $1 AND from_state = $2 AND to_state = $3
+)::bool;
+
+-- name: GetTodoWorkflowID :one
+SELECT COALESCE(
+    projects.workflow_id,
+    (SELECT workflows.id FROM workflows WHERE workflows.is_default)
+)::bigint AS workflow_id
+FROM todos
+LEFT JOIN projects ON projects.id = todos.project_id
+WHERE todos.id = $1;
+
+-- name: CreateTodoTransition :one
+INSERT INTO todo_transitions (
+    todo_id,
+    from_state,
+    to_state
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: ListTodoTransitions :many
+SELECT * FROM todo_transitions
+WHERE todo_id = $1
+ORDER BY created_at, id;
@@ -106,3 +106,73 @@ func (q *Queries) ListAttachmentOfTodo(ctx context.Context, todoID int64) ([]Att
 	}
 	return items, nil
 }
+
+const listAttachmentsOfTodos = `-- name: ListAttachmentsOfTodos :many
+SELECT id, todo_id, original_filename, storage_filename, created_at FROM attachments
+WHERE todo_id = ANY($1::bigint[])
+ORDER BY todo_id, id
+`
+
+func (q *Queries) ListAttachmentsOfTodos(ctx context.Context, todoIds []int64) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, listAttachmentsOfTodos, todoIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Attachment{}
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TodoID,
+			&i.OriginalFilename,
+			&i.StorageFilename,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveTodoAttachments = `-- name: MoveTodoAttachments :many
+UPDATE attachments
+SET todo_id = $1::bigint
+WHERE todo_id = $2::bigint
+RETURNING id, todo_id, original_filename, storage_filename, created_at
+`
+
+type MoveTodoAttachmentsParams struct {
+	TargetTodoID int64 `json:"targetTodoId"`
+	SourceTodoID int64 `json:"sourceTodoId"`
+}
+
+func (q *Queries) MoveTodoAttachments(ctx context.Context, arg MoveTodoAttachmentsParams) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, moveTodoAttachments, arg.TargetTodoID, arg.SourceTodoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Attachment{}
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TodoID,
+			&i.OriginalFilename,
+			&i.StorageFilename,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
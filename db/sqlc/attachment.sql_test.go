@@ -69,6 +69,21 @@ func TestListAttachmentOfTodo(t *testing.T) {
 	compareAttachment(t, attachment3, attachments[2])
 }
 
+func TestListAttachmentsOfTodos(t *testing.T) {
+	todo1 := createRandomTodo(t)
+	todo2 := createRandomTodo(t)
+	attachment1 := createRandomAttachmentForTodo(t, todo1)
+	attachment2 := createRandomAttachmentForTodo(t, todo2)
+	createRandomAttachmentForTodo(t, createRandomTodo(t))
+
+	attachments, err := testStore.ListAttachmentsOfTodos(context.Background(), []int64{todo1.ID, todo2.ID})
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+
+	compareAttachment(t, attachment1, attachments[0])
+	compareAttachment(t, attachment2, attachments[1])
+}
+
 func TestDeleteAttachment(t *testing.T) {
 	todo := createRandomTodo(t)
 	attachment1 := createRandomAttachmentForTodo(t, todo)
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomChecklistItemForTodo(t *testing.T, todo Todo) ChecklistItem {
+	arg := CreateChecklistItemParams{
+		TodoID: todo.ID,
+		Text:   util.RandomString(10),
+	}
+
+	item, err := testStore.CreateChecklistItem(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.NotZero(t, item.ID)
+	require.Equal(t, arg.TodoID, item.TodoID)
+	require.Equal(t, arg.Text, item.Text)
+	require.False(t, item.Checked)
+	require.Positive(t, item.Position)
+	require.NotZero(t, item.CreatedAt)
+
+	return item
+}
+
+func TestCreateChecklistItemAppends(t *testing.T) {
+	todo := createRandomTodo(t)
+	first := createRandomChecklistItemForTodo(t, todo)
+	second := createRandomChecklistItemForTodo(t, todo)
+
+	require.Equal(t, first.Position+TodoPositionGap, second.Position)
+
+	items, err := testStore.ListChecklistItems(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, []ChecklistItem{first, second}, items)
+}
+
+func TestUpdateChecklistItem(t *testing.T) {
+	todo := createRandomTodo(t)
+	item := createRandomChecklistItemForTodo(t, todo)
+
+	checked := true
+	updatedItem, err := testStore.UpdateChecklistItem(context.Background(), UpdateChecklistItemParams{
+		ID:      item.ID,
+		Checked: &checked,
+	})
+	require.NoError(t, err)
+	require.True(t, updatedItem.Checked)
+	require.Equal(t, item.Text, updatedItem.Text)
+
+	text := util.RandomString(10)
+	updatedItem, err = testStore.UpdateChecklistItem(context.Background(), UpdateChecklistItemParams{
+		ID:   item.ID,
+		Text: &text,
+	})
+	require.NoError(t, err)
+	require.True(t, updatedItem.Checked)
+	require.Equal(t, text, updatedItem.Text)
+}
+
+func TestDeleteChecklistItem(t *testing.T) {
+	todo := createRandomTodo(t)
+	item := createRandomChecklistItemForTodo(t, todo)
+
+	err := testStore.DeleteChecklistItem(context.Background(), item.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetChecklistItem(context.Background(), item.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestListTodoRollupsChecklist(t *testing.T) {
+	todo := createRandomTodo(t)
+	item := createRandomChecklistItemForTodo(t, todo)
+	createRandomChecklistItemForTodo(t, todo)
+
+	checked := true
+	_, err := testStore.UpdateChecklistItem(context.Background(), UpdateChecklistItemParams{
+		ID:      item.ID,
+		Checked: &checked,
+	})
+	require.NoError(t, err)
+
+	rollups, err := testStore.ListTodoRollups(context.Background(), []int64{todo.ID})
+	require.NoError(t, err)
+	require.Len(t, rollups, 1)
+	require.Equal(t, int32(2), rollups[0].ChecklistTotal)
+	require.Equal(t, int32(1), rollups[0].ChecklistChecked)
+}
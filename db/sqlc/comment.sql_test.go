@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomCommentForTodo(t *testing.T, todo Todo, attachmentIDs ...int64) CreateCommentTxResult {
+	arg := CreateCommentTxParams{
+		TodoID:        todo.ID,
+		Body:          util.RandomString(20),
+		AttachmentIDs: attachmentIDs,
+	}
+
+	result, err := testStore.CreateCommentTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.NotZero(t, result.Comment.ID)
+	require.Equal(t, arg.TodoID, result.Comment.TodoID)
+	require.Equal(t, arg.Body, result.Comment.Body)
+	require.NotZero(t, result.Comment.CreatedAt)
+	require.Nil(t, result.Comment.EditedAt)
+
+	return result
+}
+
+func TestListComments(t *testing.T) {
+	todo := createRandomTodo(t)
+	for i := 0; i < 3; i++ {
+		createRandomCommentForTodo(t, todo)
+	}
+
+	comments, err := testStore.ListComments(context.Background(), ListCommentsParams{
+		TodoID: todo.ID,
+		Limit:  2,
+		Offset: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	require.Less(t, comments[0].ID, comments[1].ID)
+}
+
+func TestDeleteComment(t *testing.T) {
+	todo := createRandomTodo(t)
+	comment := createRandomCommentForTodo(t, todo).Comment
+
+	err := testStore.DeleteComment(context.Background(), comment.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetComment(context.Background(), comment.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestDeleteAttachmentUnlinksComment(t *testing.T) {
+	todo := createRandomTodo(t)
+	attachment := createRandomAttachmentForTodo(t, todo)
+	comment := createRandomCommentForTodo(t, todo, attachment.ID).Comment
+
+	err := testStore.DeleteAttachment(context.Background(), attachment.ID)
+	require.NoError(t, err)
+
+	attachments, err := testStore.ListCommentAttachments(context.Background(), []int64{comment.ID})
+	require.NoError(t, err)
+	require.Empty(t, attachments)
+}
@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveTodoDependency(t *testing.T) {
+	todo := createRandomTodo(t)
+	blocker := createRandomTodo(t)
+	addTodoDependencyTx(t, todo, blocker)
+
+	err := testStore.RemoveTodoDependency(context.Background(), RemoveTodoDependencyParams{
+		TodoID:      todo.ID,
+		BlockedByID: blocker.ID,
+	})
+	require.NoError(t, err)
+
+	blockers, err := testStore.ListTodoBlockers(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Empty(t, blockers)
+}
+
+func TestListTodoRollupsOpenBlockers(t *testing.T) {
+	todo := createRandomTodo(t)
+	blocker1 := createRandomTodo(t)
+	blocker2 := createRandomTodo(t)
+	addTodoDependencyTx(t, todo, blocker1)
+	addTodoDependencyTx(t, todo, blocker2)
+
+	setTodoStatusTx(t, blocker1, "complete")
+
+	rollups, err := testStore.ListTodoRollups(context.Background(), []int64{todo.ID})
+	require.NoError(t, err)
+	require.Len(t, rollups, 1)
+	require.Equal(t, int32(1), rollups[0].OpenBlockers)
+}
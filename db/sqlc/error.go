@@ -1,7 +1,95 @@
 package db
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	ForeignKeyViolation = "23503"
+	UniqueViolation     = "23505"
+	CheckViolation      = "23514"
 )
 
 var ErrRecordNotFound = pgx.ErrNoRows
+
+// ErrTodoParentCycle is returned when a todo is moved under itself or one of its descendants
+var ErrTodoParentCycle = errors.New("todo can't be moved under itself or one of its descendants")
+
+// ErrTodoNotRecurring is returned when a recurrence operation targets a todo without a recurrence
+var ErrTodoNotRecurring = errors.New("todo isn't recurring")
+
+// ErrTodoWithoutDueDate is returned when a recurrence is set on a todo without a due date
+var ErrTodoWithoutDueDate = errors.New("todo doesn't have a due date")
+
+// ErrRecurrenceEnded is returned when a recurrence has no occurrence left
+var ErrRecurrenceEnded = errors.New("recurrence has no further occurrences")
+
+// ErrUnknownTodoStatus is returned when a todo is moved to a status which isn't a state of its workflow
+var ErrUnknownTodoStatus = errors.New("status isn't a state of the todo's workflow")
+
+// ErrTodoTransitionNotAllowed is returned when the workflow of a todo doesn't allow moving from its current status to the new one
+var ErrTodoTransitionNotAllowed = errors.New("transition isn't allowed by the todo's workflow")
+
+// ErrTodoHasIncompleteSubtasks is returned when a todo with incomplete subtasks is moved to a terminal state
+var ErrTodoHasIncompleteSubtasks = errors.New("todo has incomplete subtasks")
+
+// ErrTodoDependencyCycle is returned when a todo is made to depend on itself or on one of the todos it blocks
+var ErrTodoDependencyCycle = errors.New("todo can't be blocked by itself or by a todo it blocks")
+
+// ErrTodoBlocked is returned when a todo with open blockers is moved to a terminal state without forcing it
+var ErrTodoBlocked = errors.New("todo has open blockers")
+
+// ErrUnknownCommentAttachment is returned when a comment references an attachment which doesn't belong to its todo
+var ErrUnknownCommentAttachment = errors.New("attachment doesn't belong to the todo of the comment")
+
+// ErrTodoRevisionNotRevertible is returned when reverting a revision of the attachments, whose files are gone
+var ErrTodoRevisionNotRevertible = errors.New("attachment revisions can't be reverted")
+
+// ErrTodoParentTrashed is returned when restoring a subtask whose parent is still in the trash
+var ErrTodoParentTrashed = errors.New("parent of the todo is in the trash")
+
+// ErrChecklistOrderMismatch is returned when a new checklist order doesn't list every item of the checklist exactly once
+var ErrChecklistOrderMismatch = errors.New("order must list every checklist item of the todo exactly once")
+
+// ErrWorkflowWithoutTerminalState is returned when a todo has to be closed but its workflow has no terminal state
+var ErrWorkflowWithoutTerminalState = errors.New("workflow of the todo has no terminal state")
+
+// TodoMergedError is returned when merging a todo which was already merged, or merging into one
+type TodoMergedError struct {
+	TodoID       int64
+	MergedIntoID int64
+}
+
+func (err *TodoMergedError) Error() string {
+	return fmt.Sprintf("todo %d was merged into the todo %d", err.TodoID, err.MergedIntoID)
+}
+
+// MergeAttachmentLimitError is returned when the attachments of both merged todos exceed the attachment limit
+type MergeAttachmentLimitError struct {
+	// Attachments the merged todo would have
+	Attachments int
+}
+
+func (err *MergeAttachmentLimitError) Error() string {
+	return fmt.Sprintf("merged todo would have %d attachments", err.Attachments)
+}
+
+// ErrUnknownTodoSortField is returned when todos are sorted by a field which isn't whitelisted
+var ErrUnknownTodoSortField = errors.New("todos can't be sorted by the field")
+
+// ErrUnknownSearchLanguage is returned when todos are searched in a language without search index
+var ErrUnknownSearchLanguage = errors.New("todos can't be searched in the language")
+
+// ErrorCode returns the postgres error code of err, or an empty string if err isn't a postgres error
+func ErrorCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+
+	return ""
+}
@@ -3,6 +3,8 @@ package db
 import (
 	"context"
 	"fmt"
+
+	storage "github.com/jaingounchained/todo/storage"
 )
 
 func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
@@ -22,3 +24,20 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 
 	return tx.Commit(ctx)
 }
+
+// execStorageTx runs the function in a transaction like execTx, replacing the storage with one that tracks the todo
+// directories and files the function creates, and deletes them when the transaction fails
+func (store *SQLStore) execStorageTx(ctx context.Context, s *storage.Storage, fn func(*Queries) error) error {
+	tracked := &trackedStorage{Storage: *s}
+	*s = tracked
+
+	err := store.execTx(ctx, fn)
+	if err != nil {
+		if undoErr := tracked.undo(ctx); undoErr != nil {
+			return fmt.Errorf("tx err: %w, storage undo err: %v", err, undoErr)
+		}
+		return err
+	}
+
+	return nil
+}
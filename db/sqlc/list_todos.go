@@ -0,0 +1,496 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Fields todos can be sorted by
+const (
+	TodoSortFieldCreatedAt   = "createdAt"
+	TodoSortFieldUpdatedAt   = "updatedAt"
+	TodoSortFieldTitle       = "title"
+	TodoSortFieldStatus      = "status"
+	TodoSortFieldDueAt       = "dueAt"
+	TodoSortFieldCompletedAt = "completedAt"
+	TodoSortFieldPriority    = "priority"
+	TodoSortFieldFileCount   = "fileCount"
+	TodoSortFieldPosition    = "position"
+)
+
+// TodoSortFields lists the fields todos can be sorted by
+var TodoSortFields = []string{
+	TodoSortFieldCreatedAt,
+	TodoSortFieldUpdatedAt,
+	TodoSortFieldTitle,
+	TodoSortFieldStatus,
+	TodoSortFieldDueAt,
+	TodoSortFieldCompletedAt,
+	TodoSortFieldPriority,
+	TodoSortFieldFileCount,
+	TodoSortFieldPosition,
+}
+
+// todoSortColumn is a column todos can be sorted by along with the type of its values
+type todoSortColumn struct {
+	name     string
+	cast     string
+	nullable bool
+}
+
+// todoSortColumns whitelists the columns todos can be sorted by; only these ever make it into the query
+var todoSortColumns = map[string]todoSortColumn{
+	TodoSortFieldCreatedAt:   {name: "created_at", cast: "timestamptz"},
+	TodoSortFieldUpdatedAt:   {name: "updated_at", cast: "timestamptz"},
+	TodoSortFieldTitle:       {name: "title", cast: "varchar"},
+	TodoSortFieldStatus:      {name: "status", cast: "varchar"},
+	TodoSortFieldDueAt:       {name: "due_at", cast: "timestamptz", nullable: true},
+	TodoSortFieldCompletedAt: {name: "completed_at", cast: "timestamptz", nullable: true},
+	TodoSortFieldPriority:    {name: "priority", cast: "smallint"},
+	TodoSortFieldFileCount:   {name: "file_count", cast: "int"},
+	TodoSortFieldPosition:    {name: "position", cast: "bigint"},
+}
+
+// todoIDSortColumn breaks the ties of every sort order
+var todoIDSortColumn = todoSortColumn{name: "id", cast: "bigint"}
+
+// TodoSort orders the todos by one of the sort fields
+type TodoSort struct {
+	Field      string `json:"field"`
+	Descending bool   `json:"descending"`
+}
+
+// todoDefaultSort is the manual order of the todos
+var todoDefaultSort = []TodoSort{{Field: TodoSortFieldPosition}}
+
+// TodoCursor marks the position of a todo within the sort order of the list, by the values of its sort fields; the
+// todos after it are listed, or the ones before it when paging backward
+type TodoCursor struct {
+	ID          int64      `json:"id"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	Title       *string    `json:"title,omitempty"`
+	Status      *string    `json:"status,omitempty"`
+	DueAt       *time.Time `json:"dueAt,omitempty"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	Priority    *int16     `json:"priority,omitempty"`
+	FileCount   *int32     `json:"fileCount,omitempty"`
+	Position    *int64     `json:"position,omitempty"`
+	Rank        *float32   `json:"rank,omitempty"`
+	Backward    bool       `json:"backward,omitempty"`
+}
+
+// NewTodoCursor returns the cursor at the todo for the sort order
+func NewTodoCursor(todo Todo, sort []TodoSort, backward bool) TodoCursor {
+	cursor := TodoCursor{
+		ID:       todo.ID,
+		Backward: backward,
+	}
+
+	if len(sort) == 0 {
+		sort = todoDefaultSort
+	}
+	for _, s := range sort {
+		switch s.Field {
+		case TodoSortFieldCreatedAt:
+			cursor.CreatedAt = &todo.CreatedAt
+		case TodoSortFieldUpdatedAt:
+			cursor.UpdatedAt = &todo.UpdatedAt
+		case TodoSortFieldTitle:
+			cursor.Title = &todo.Title
+		case TodoSortFieldStatus:
+			cursor.Status = &todo.Status
+		case TodoSortFieldDueAt:
+			cursor.DueAt = todo.DueAt
+		case TodoSortFieldCompletedAt:
+			cursor.CompletedAt = todo.CompletedAt
+		case TodoSortFieldPriority:
+			cursor.Priority = &todo.Priority
+		case TodoSortFieldFileCount:
+			cursor.FileCount = &todo.FileCount
+		case TodoSortFieldPosition:
+			cursor.Position = &todo.Position
+		}
+	}
+
+	return cursor
+}
+
+// value returns the value of the sort field at the cursor, or nil if it is NULL
+func (cursor TodoCursor) value(field string) any {
+	switch field {
+	case TodoSortFieldCreatedAt:
+		return nilIfNull(cursor.CreatedAt)
+	case TodoSortFieldUpdatedAt:
+		return nilIfNull(cursor.UpdatedAt)
+	case TodoSortFieldTitle:
+		return nilIfNull(cursor.Title)
+	case TodoSortFieldStatus:
+		return nilIfNull(cursor.Status)
+	case TodoSortFieldDueAt:
+		return nilIfNull(cursor.DueAt)
+	case TodoSortFieldCompletedAt:
+		return nilIfNull(cursor.CompletedAt)
+	case TodoSortFieldPriority:
+		return nilIfNull(cursor.Priority)
+	case TodoSortFieldFileCount:
+		return nilIfNull(cursor.FileCount)
+	case TodoSortFieldPosition:
+		return nilIfNull(cursor.Position)
+	case TodoSortFieldRank:
+		return nilIfNull(cursor.Rank)
+	}
+
+	return nil
+}
+
+// nilIfNull dereferences the value, turning a nil pointer into an untyped nil
+func nilIfNull[T any](value *T) any {
+	if value == nil {
+		return nil
+	}
+
+	return *value
+}
+
+const listTodos = `-- name: ListTodos :many
+SELECT id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at FROM todos
+`
+
+type ListTodosParams struct {
+	TagIds         []int64    `json:"tagIds"`
+	MatchAllTags   bool       `json:"matchAllTags"`
+	Overdue        *bool      `json:"overdue"`
+	Archived       bool       `json:"archived"`
+	Assignee       *string    `json:"assignee"`
+	Watcher        *string    `json:"watcher"`
+	Statuses       []string   `json:"statuses"`
+	CreatedFrom    *time.Time `json:"createdFrom"`
+	CreatedTo      *time.Time `json:"createdTo"`
+	UpdatedFrom    *time.Time `json:"updatedFrom"`
+	UpdatedTo      *time.Time `json:"updatedTo"`
+	TitleContains  *string    `json:"titleContains"`
+	HasAttachments *bool      `json:"hasAttachments"`
+	MinFileCount   *int32     `json:"minFileCount"`
+	MaxFileCount   *int32     `json:"maxFileCount"`
+	// Todos carrying every one of the tags, given by name
+	TagNames []string `json:"tagNames"`
+	// Todos carrying none of the tags, given by name
+	ExcludedTagNames []string `json:"excludedTagNames"`
+	// Todos in none of the states
+	ExcludedStatuses []string `json:"excludedStatuses"`
+	// Todos whose title or description contains each of the terms, ignoring case
+	TextTerms []string `json:"textTerms"`
+	// Todos whose title and description contain none of the terms, ignoring case
+	ExcludedTextTerms []string `json:"excludedTextTerms"`
+	// Todos are listed in their manual order unless sorted otherwise
+	Sort []TodoSort `json:"sort"`
+	// Todos are listed after the cursor, or before it when paging backward, instead of being skipped by the offset
+	Cursor *TodoCursor `json:"cursor"`
+	Limit  int32       `json:"limit"`
+	Offset int32       `json:"offset"`
+}
+
+// ListTodos lists the todos matching the filters; unlike the generated queries, the conditions of the filters which
+// aren't set are left out of the query altogether, so that the planner can pick the indexes of the ones which are.
+// Every value is passed as a query argument and the sort columns come from a whitelist. Paging backward from a cursor
+// still lists the todos in the sort order
+func (q *Queries) ListTodos(ctx context.Context, arg ListTodosParams) ([]Todo, error) {
+	query, args, err := buildListTodosQuery(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Todo{}
+	for rows.Next() {
+		var i Todo
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FileCount,
+			&i.DueAt,
+			&i.DueTimezone,
+			&i.Priority,
+			&i.Position,
+			&i.ParentID,
+			&i.RecurrenceID,
+			&i.ProjectID,
+			&i.CompletedAt,
+			&i.DeletedAt,
+			&i.ArchivedAt,
+			&i.Description,
+			&i.MergedIntoID,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if arg.Cursor != nil && arg.Cursor.Backward {
+		slices.Reverse(items)
+	}
+	return items, nil
+}
+
+const countTodos = `-- name: CountTodos :one
+SELECT COUNT(*) FROM todos
+`
+
+// CountTodos counts the todos matching the filters of the list, regardless of its sort order and page
+func (q *Queries) CountTodos(ctx context.Context, arg ListTodosParams) (int64, error) {
+	var b todoQueryBuilder
+	b.filter(arg)
+	query := countTodos + "WHERE " + strings.Join(b.conditions, "\n    AND ")
+
+	row := q.db.QueryRow(ctx, query, b.args...)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+// todoQueryBuilder collects the conditions of a todo query along with their arguments
+type todoQueryBuilder struct {
+	conditions []string
+	args       []any
+}
+
+// arg adds the value to the arguments of the query and returns its placeholder
+func (b *todoQueryBuilder) arg(value any) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// where adds the condition to the query
+func (b *todoQueryBuilder) where(condition string) {
+	b.conditions = append(b.conditions, condition)
+}
+
+// filter adds the conditions of the filters of the list
+func (b *todoQueryBuilder) filter(arg ListTodosParams) {
+	b.where("deleted_at IS NULL")
+	if arg.Archived {
+		b.where("archived_at IS NOT NULL")
+	} else {
+		b.where("archived_at IS NULL")
+	}
+
+	if len(arg.TagIds) > 0 {
+		tagIDs := b.arg(arg.TagIds)
+		if arg.MatchAllTags {
+			b.where(fmt.Sprintf("id IN (SELECT todo_id FROM todo_tags WHERE tag_id = ANY(%s::bigint[]) GROUP BY todo_id HAVING COUNT(*) = array_length(%s::bigint[], 1))", tagIDs, tagIDs))
+		} else {
+			b.where(fmt.Sprintf("id IN (SELECT todo_id FROM todo_tags WHERE tag_id = ANY(%s::bigint[]))", tagIDs))
+		}
+	}
+
+	if arg.Overdue != nil {
+		if *arg.Overdue {
+			b.where("due_at < now() AND completed_at IS NULL")
+		} else {
+			b.where("(due_at IS NULL OR due_at >= now() OR completed_at IS NOT NULL)")
+		}
+	}
+
+	if arg.Assignee != nil {
+		b.where(fmt.Sprintf("id IN (SELECT todo_id FROM todo_assignees WHERE user_id = %s::varchar)", b.arg(*arg.Assignee)))
+	}
+	if arg.Watcher != nil {
+		b.where(fmt.Sprintf("id IN (SELECT todo_id FROM todo_watchers WHERE user_id = %s::varchar)", b.arg(*arg.Watcher)))
+	}
+
+	if len(arg.TagNames) > 0 {
+		tagNames := b.arg(arg.TagNames)
+		b.where(fmt.Sprintf("id IN (SELECT todo_id FROM todo_tags JOIN tags ON tags.id = todo_tags.tag_id WHERE tags.name = ANY(%s::varchar[]) GROUP BY todo_id HAVING COUNT(*) = cardinality(%s::varchar[]))", tagNames, tagNames))
+	}
+	if len(arg.ExcludedTagNames) > 0 {
+		b.where(fmt.Sprintf("id NOT IN (SELECT todo_id FROM todo_tags JOIN tags ON tags.id = todo_tags.tag_id WHERE tags.name = ANY(%s::varchar[]))", b.arg(arg.ExcludedTagNames)))
+	}
+
+	if len(arg.Statuses) > 0 {
+		b.where(fmt.Sprintf("status = ANY(%s::varchar[])", b.arg(arg.Statuses)))
+	}
+	if len(arg.ExcludedStatuses) > 0 {
+		b.where(fmt.Sprintf("status <> ALL(%s::varchar[])", b.arg(arg.ExcludedStatuses)))
+	}
+
+	if arg.CreatedFrom != nil {
+		b.where(fmt.Sprintf("created_at >= %s::timestamptz", b.arg(*arg.CreatedFrom)))
+	}
+	if arg.CreatedTo != nil {
+		b.where(fmt.Sprintf("created_at < %s::timestamptz", b.arg(*arg.CreatedTo)))
+	}
+	if arg.UpdatedFrom != nil {
+		b.where(fmt.Sprintf("updated_at >= %s::timestamptz", b.arg(*arg.UpdatedFrom)))
+	}
+	if arg.UpdatedTo != nil {
+		b.where(fmt.Sprintf("updated_at < %s::timestamptz", b.arg(*arg.UpdatedTo)))
+	}
+
+	if arg.TitleContains != nil {
+		b.where(fmt.Sprintf("title ILIKE %s::varchar", b.arg("%"+escapeLikePattern(*arg.TitleContains)+"%")))
+	}
+	for _, term := range arg.TextTerms {
+		pattern := b.arg("%" + escapeLikePattern(term) + "%")
+		b.where(fmt.Sprintf("(title ILIKE %s::varchar OR description ILIKE %s::varchar)", pattern, pattern))
+	}
+	for _, term := range arg.ExcludedTextTerms {
+		pattern := b.arg("%" + escapeLikePattern(term) + "%")
+		b.where(fmt.Sprintf("NOT (title ILIKE %s::varchar OR description ILIKE %s::varchar)", pattern, pattern))
+	}
+
+	if arg.HasAttachments != nil {
+		if *arg.HasAttachments {
+			b.where("file_count > 0")
+		} else {
+			b.where("file_count = 0")
+		}
+	}
+	if arg.MinFileCount != nil {
+		b.where(fmt.Sprintf("file_count >= %s::int", b.arg(*arg.MinFileCount)))
+	}
+	if arg.MaxFileCount != nil {
+		b.where(fmt.Sprintf("file_count <= %s::int", b.arg(*arg.MaxFileCount)))
+	}
+}
+
+// buildListTodosQuery builds the query listing the todos as specified by the parameters, along with its arguments
+func buildListTodosQuery(arg ListTodosParams) (string, []any, error) {
+	var b todoQueryBuilder
+	return b.page(listTodos, arg, todoSortColumns, todoDefaultSort)
+}
+
+// page builds the query selecting the page of the todos matching the filters, in the sort order given by the sort
+// columns, along with its arguments
+func (b *todoQueryBuilder) page(selectFrom string, arg ListTodosParams, sortColumns map[string]todoSortColumn, defaultSort []TodoSort) (string, []any, error) {
+	b.filter(arg)
+
+	keys, err := todoSortKeys(arg.Sort, sortColumns, defaultSort)
+	if err != nil {
+		return "", nil, err
+	}
+
+	offset := arg.Offset
+	if arg.Cursor != nil {
+		// Paging backward walks the reversed sort order from the cursor, NULLs included
+		if arg.Cursor.Backward {
+			for i := range keys {
+				keys[i].descending = !keys[i].descending
+			}
+		}
+
+		if condition := b.keysetCondition(keys, *arg.Cursor); condition != "" {
+			b.where(condition)
+		}
+		offset = 0
+	}
+
+	orderBy := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key.descending {
+			orderBy = append(orderBy, key.column.name+" DESC")
+		} else {
+			orderBy = append(orderBy, key.column.name)
+		}
+	}
+
+	query := selectFrom +
+		"WHERE " + strings.Join(b.conditions, "\n    AND ") + "\n" +
+		"ORDER BY " + strings.Join(orderBy, ", ") + "\n" +
+		"LIMIT " + b.arg(arg.Limit) + "\n" +
+		"OFFSET " + b.arg(offset)
+
+	return query, b.args, nil
+}
+
+// todoSortKey is a column of the sort order along with its field and direction
+type todoSortKey struct {
+	field      string
+	column     todoSortColumn
+	descending bool
+}
+
+// todoSortKeys resolves the sort fields to their whitelisted columns, broken by ID
+func todoSortKeys(sort []TodoSort, sortColumns map[string]todoSortColumn, defaultSort []TodoSort) ([]todoSortKey, error) {
+	if len(sort) == 0 {
+		sort = defaultSort
+	}
+
+	keys := make([]todoSortKey, 0, len(sort)+1)
+	for _, s := range sort {
+		column, ok := sortColumns[s.Field]
+		if !ok {
+			return nil, fmt.Errorf("%w: '%s'", ErrUnknownTodoSortField, s.Field)
+		}
+
+		keys = append(keys, todoSortKey{
+			field:      s.Field,
+			column:     column,
+			descending: s.Descending,
+		})
+	}
+
+	return append(keys, todoSortKey{column: todoIDSortColumn}), nil
+}
+
+// keysetCondition returns the condition of the todos coming after the cursor in the order of the sort keys: the ones
+// past the cursor on the first key, or tied on it and past the cursor on the second one, and so on. NULLs come last in
+// ascending order and first in descending order, as in postgres
+func (b *todoQueryBuilder) keysetCondition(keys []todoSortKey, cursor TodoCursor) string {
+	var terms, ties []string
+	for _, key := range keys {
+		var value any
+		if key.field == "" {
+			value = cursor.ID
+		} else {
+			value = cursor.value(key.field)
+		}
+
+		column := key.column.name
+		if value == nil {
+			// Only the non NULL values come after a NULL, in descending order
+			if key.descending {
+				terms = append(terms, strings.Join(append(ties, column+" IS NOT NULL"), " AND "))
+			}
+			ties = append(ties, column+" IS NULL")
+			continue
+		}
+
+		placeholder := b.arg(value) + "::" + key.column.cast
+		var after string
+		switch {
+		case key.descending:
+			after = column + " < " + placeholder
+		case key.column.nullable:
+			after = "(" + column + " > " + placeholder + " OR " + column + " IS NULL)"
+		default:
+			after = column + " > " + placeholder
+		}
+
+		terms = append(terms, strings.Join(append(ties, after), " AND "))
+		ties = append(ties, column+" = "+placeholder)
+	}
+
+	if len(terms) == 0 {
+		return ""
+	}
+
+	return "((" + strings.Join(terms, ")\n        OR (") + "))"
+}
+
+// escapeLikePattern escapes the wildcards of a LIKE pattern so that the text is matched literally
+func escapeLikePattern(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
+}
@@ -0,0 +1,294 @@
+package db
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomTodoTitled(t *testing.T, title string) Todo {
+	todo, err := testStore.CreateTodo(context.Background(), CreateTodoParams{
+		Title: title,
+	})
+	require.NoError(t, err)
+
+	return todo
+}
+
+func TestListTodosFilteredByTitleAndAttachments(t *testing.T) {
+	prefix := util.RandomString(10)
+
+	// The wildcards of the title filter are matched literally
+	todo1 := createRandomTodoTitled(t, prefix+" 50%_off")
+	todo2 := createRandomTodoTitled(t, prefix+" 500 off")
+
+	todo1, err := testStore.UpdateTodoFileCount(context.Background(), UpdateTodoFileCountParams{
+		ID:        todo1.ID,
+		FileCount: 2,
+	})
+	require.NoError(t, err)
+	require.True(t, todo1.UpdatedAt.After(todo1.CreatedAt))
+
+	withAttachments := true
+	withoutAttachments := false
+	title := strings.ToUpper(prefix) + " 50%_"
+	minFileCount := int32(3)
+
+	tcs := []struct {
+		name          string
+		arg           ListTodosParams
+		expectedTodos []Todo
+	}{
+		{
+			name:          "TitleContains",
+			arg:           ListTodosParams{TitleContains: &prefix},
+			expectedTodos: []Todo{todo1, todo2},
+		},
+		{
+			name:          "TitleContainsWildcards",
+			arg:           ListTodosParams{TitleContains: &title},
+			expectedTodos: []Todo{todo1},
+		},
+		{
+			name:          "WithAttachments",
+			arg:           ListTodosParams{TitleContains: &prefix, HasAttachments: &withAttachments},
+			expectedTodos: []Todo{todo1},
+		},
+		{
+			name:          "WithoutAttachments",
+			arg:           ListTodosParams{TitleContains: &prefix, HasAttachments: &withoutAttachments},
+			expectedTodos: []Todo{todo2},
+		},
+		{
+			name:          "MinFileCount",
+			arg:           ListTodosParams{TitleContains: &prefix, MinFileCount: &minFileCount},
+			expectedTodos: []Todo{},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.arg.Limit = 10
+
+			todos, err := testStore.ListTodos(context.Background(), tc.arg)
+			require.NoError(t, err)
+			require.Len(t, todos, len(tc.expectedTodos))
+			for i := range todos {
+				compareTodos(t, tc.expectedTodos[i], todos[i])
+			}
+		})
+	}
+}
+
+func TestListTodosFilteredByStatusAndUpdateTime(t *testing.T) {
+	prefix := util.RandomString(10)
+
+	todo1 := createRandomTodoTitled(t, prefix)
+	todo2 := createRandomTodoTitled(t, prefix)
+
+	updatedFrom := time.Now()
+	status := "complete"
+	todo2, err := testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:     todo2.ID,
+		Status: &status,
+	})
+	require.NoError(t, err)
+
+	todos, err := testStore.ListTodos(context.Background(), ListTodosParams{
+		TitleContains: &prefix,
+		Statuses:      []string{"incomplete"},
+		Limit:         10,
+	})
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	compareTodos(t, todo1, todos[0])
+
+	todos, err = testStore.ListTodos(context.Background(), ListTodosParams{
+		TitleContains: &prefix,
+		UpdatedFrom:   &updatedFrom,
+		Limit:         10,
+	})
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	compareTodos(t, todo2, todos[0])
+}
+
+func TestListTodosFilteredByTagNamesAndTextTerms(t *testing.T) {
+	prefix := util.RandomString(10)
+
+	todo1 := createRandomTodoTitled(t, prefix+" Quarterly report")
+	todo2 := createRandomTodoTitled(t, prefix+" quarterly review")
+	todo3 := createRandomTodoTitled(t, prefix+" draft")
+
+	tag1 := createRandomTag(t)
+	tag2 := createRandomTag(t)
+	for _, arg := range []AddTagsToTodoParams{
+		{TodoID: todo1.ID, TagIds: []int64{tag1.ID, tag2.ID}},
+		{TodoID: todo2.ID, TagIds: []int64{tag1.ID}},
+		{TodoID: todo3.ID, TagIds: []int64{tag2.ID}},
+	} {
+		require.NoError(t, testStore.AddTagsToTodo(context.Background(), arg))
+	}
+
+	status := "complete"
+	todo3, err := testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:     todo3.ID,
+		Status: &status,
+	})
+	require.NoError(t, err)
+
+	tcs := []struct {
+		name          string
+		arg           ListTodosParams
+		expectedTodos []Todo
+	}{
+		{
+			name:          "TagNames",
+			arg:           ListTodosParams{TagNames: []string{tag1.Name, tag2.Name}},
+			expectedTodos: []Todo{todo1},
+		},
+		{
+			name:          "ExcludedTagNames",
+			arg:           ListTodosParams{TitleContains: &prefix, ExcludedTagNames: []string{tag2.Name}},
+			expectedTodos: []Todo{todo2},
+		},
+		{
+			name:          "ExcludedStatuses",
+			arg:           ListTodosParams{TitleContains: &prefix, ExcludedStatuses: []string{"complete"}},
+			expectedTodos: []Todo{todo1, todo2},
+		},
+		{
+			name:          "TextTerms",
+			arg:           ListTodosParams{TextTerms: []string{prefix, "QUARTERLY", "report"}},
+			expectedTodos: []Todo{todo1},
+		},
+		{
+			name:          "ExcludedTextTerms",
+			arg:           ListTodosParams{TextTerms: []string{prefix}, ExcludedTextTerms: []string{"report"}},
+			expectedTodos: []Todo{todo2, todo3},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.arg.Limit = 10
+
+			todos, err := testStore.ListTodos(context.Background(), tc.arg)
+			require.NoError(t, err)
+			require.Len(t, todos, len(tc.expectedTodos))
+			for i := range todos {
+				compareTodos(t, tc.expectedTodos[i], todos[i])
+			}
+		})
+	}
+}
+
+func TestListTodosSorted(t *testing.T) {
+	prefix := util.RandomString(10)
+
+	todo1 := createRandomTodoTitled(t, prefix+" b")
+	todo2 := createRandomTodoTitled(t, prefix+" a")
+	todo3 := createRandomTodoTitled(t, prefix+" c")
+
+	priority := int16(2)
+	todo3, err := testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:       todo3.ID,
+		Priority: &priority,
+	})
+	require.NoError(t, err)
+
+	todos, err := testStore.ListTodos(context.Background(), ListTodosParams{
+		TitleContains: &prefix,
+		Sort: []TodoSort{
+			{Field: TodoSortFieldPriority, Descending: true},
+			{Field: TodoSortFieldTitle},
+		},
+		Limit: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, todos, 3)
+	for i, todo := range []Todo{todo3, todo2, todo1} {
+		compareTodos(t, todo, todos[i])
+	}
+
+	_, err = testStore.ListTodos(context.Background(), ListTodosParams{
+		Sort:  []TodoSort{{Field: "id; DROP TABLE todos"}},
+		Limit: 10,
+	})
+	require.ErrorIs(t, err, ErrUnknownTodoSortField)
+}
+
+func TestListTodosKeysetPaging(t *testing.T) {
+	prefix := util.RandomString(10)
+
+	dueAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	var ids []int64
+	for _, due := range []*time.Time{&dueAt, &dueAt, nil, nil, nil} {
+		todo, err := testStore.CreateTodo(context.Background(), CreateTodoParams{
+			Title: prefix,
+			DueAt: due,
+		})
+		require.NoError(t, err)
+		ids = append(ids, todo.ID)
+	}
+
+	// Todos without due date come last in ascending order and first in descending order, ties are broken by ID
+	for _, descending := range []bool{false, true} {
+		sort := []TodoSort{{Field: TodoSortFieldDueAt, Descending: descending}}
+		expectedIDs := ids
+		if descending {
+			expectedIDs = append(slices.Clone(ids[2:]), ids[:2]...)
+		}
+
+		// Forward page by page, then backward from the last page
+		var listedIDs []int64
+		var cursor *TodoCursor
+		var last Todo
+		for {
+			todos, err := testStore.ListTodos(context.Background(), ListTodosParams{
+				TitleContains: &prefix,
+				Sort:          sort,
+				Cursor:        cursor,
+				Limit:         2,
+			})
+			require.NoError(t, err)
+			if len(todos) == 0 {
+				break
+			}
+
+			for _, todo := range todos {
+				listedIDs = append(listedIDs, todo.ID)
+			}
+			last = todos[0]
+			next := NewTodoCursor(todos[len(todos)-1], sort, false)
+			cursor = &next
+		}
+		require.Equal(t, expectedIDs, listedIDs)
+
+		prev := NewTodoCursor(last, sort, true)
+		todos, err := testStore.ListTodos(context.Background(), ListTodosParams{
+			TitleContains: &prefix,
+			Sort:          sort,
+			Cursor:        &prev,
+			Limit:         10,
+		})
+		require.NoError(t, err)
+		require.Len(t, todos, 4)
+		for i, todo := range todos {
+			require.Equal(t, expectedIDs[i], todo.ID)
+		}
+
+		count, err := testStore.CountTodos(context.Background(), ListTodosParams{
+			TitleContains: &prefix,
+			Sort:          sort,
+			Cursor:        &prev,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(5), count)
+	}
+}
@@ -16,10 +16,181 @@ type Attachment struct {
 	CreatedAt        time.Time `json:"createdAt"`
 }
 
+type ChecklistItem struct {
+	ID        int64     `json:"checklistItemId"`
+	TodoID    int64     `json:"todoId"`
+	Text      string    `json:"text"`
+	Checked   bool      `json:"checked"`
+	Position  int64     `json:"position"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Comment struct {
+	ID        int64      `json:"commentId"`
+	TodoID    int64      `json:"todoId"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"createdAt"`
+	EditedAt  *time.Time `json:"editedAt"`
+}
+
+type CommentAttachment struct {
+	CommentID    int64 `json:"commentId"`
+	AttachmentID int64 `json:"attachmentId"`
+}
+
+type Project struct {
+	ID         int64     `json:"projectId"`
+	Name       string    `json:"name"`
+	WorkflowID *int64    `json:"workflowId"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type Recurrence struct {
+	ID              int64     `json:"recurrenceId"`
+	Rrule           string    `json:"rrule"`
+	Dtstart         time.Time `json:"dtstart"`
+	Timezone        string    `json:"timezone"`
+	CopyAttachments bool      `json:"copyAttachments"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+type Reminder struct {
+	ID             int64      `json:"reminderId"`
+	TodoID         int64      `json:"todoId"`
+	OffsetMinutes  int32      `json:"offsetMinutes"`
+	FiredAt        *time.Time `json:"firedAt"`
+	FiredForDueAt  *time.Time `json:"firedForDueAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	FailedAttempts int32      `json:"failedAttempts"`
+	RetryAt        *time.Time `json:"retryAt"`
+}
+
+type SmartList struct {
+	ID        int64     `json:"smartListId"`
+	UserID    string    `json:"userId"`
+	Name      string    `json:"name"`
+	Query     string    `json:"query"`
+	Pinned    bool      `json:"pinned"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Tag struct {
+	ID        int64     `json:"tagId"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Template struct {
+	ID          int64     `json:"templateId"`
+	Name        string    `json:"name"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Priority    int16     `json:"priority"`
+	Checklist   []string  `json:"checklist"`
+	Subtasks    []string  `json:"subtasks"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type TemplateAttachment struct {
+	ID               int64     `json:"templateAttachmentId"`
+	TemplateID       int64     `json:"templateId"`
+	OriginalFilename string    `json:"originalFilename"`
+	StorageFilename  string    `json:"storageFilename"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+type TemplateTag struct {
+	TemplateID int64 `json:"templateId"`
+	TagID      int64 `json:"tagId"`
+}
+
+type TimeEntry struct {
+	ID        int64      `json:"timeEntryId"`
+	TodoID    int64      `json:"todoId"`
+	UserID    string     `json:"userId"`
+	StartedAt time.Time  `json:"startedAt"`
+	StoppedAt *time.Time `json:"stoppedAt"`
+	Note      string     `json:"note"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
 type Todo struct {
-	ID        int64     `json:"todoId"`
-	Title     string    `json:"title"`
-	Status    string    `json:"status"`
+	ID           int64      `json:"todoId"`
+	Title        string     `json:"title"`
+	Status       string     `json:"status"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	FileCount    int32      `json:"fileCount"`
+	DueAt        *time.Time `json:"dueAt"`
+	DueTimezone  *string    `json:"dueTimezone"`
+	Priority     int16      `json:"priority"`
+	Position     int64      `json:"position"`
+	ParentID     *int64     `json:"parentId"`
+	RecurrenceID *int64     `json:"recurrenceId"`
+	ProjectID    *int64     `json:"projectId"`
+	CompletedAt  *time.Time `json:"completedAt"`
+	DeletedAt    *time.Time `json:"deletedAt"`
+	ArchivedAt   *time.Time `json:"archivedAt"`
+	Description  string     `json:"description"`
+	MergedIntoID *int64     `json:"mergedIntoId"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+type TodoAssignee struct {
+	TodoID    int64     `json:"todoId"`
+	UserID    string    `json:"userId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type TodoDependency struct {
+	TodoID      int64     `json:"todoId"`
+	BlockedByID int64     `json:"blockedById"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type TodoRevision struct {
+	ID        int64               `json:"revisionId"`
+	TodoID    int64               `json:"todoId"`
+	Changes   TodoRevisionChanges `json:"changes"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+type TodoTag struct {
+	TodoID    int64     `json:"todoId"`
+	TagID     int64     `json:"tagId"`
 	CreatedAt time.Time `json:"createdAt"`
-	FileCount int32     `json:"fileCount"`
+}
+
+type TodoTransition struct {
+	ID        int64     `json:"transitionId"`
+	TodoID    int64     `json:"todoId"`
+	FromState string    `json:"fromState"`
+	ToState   string    `json:"toState"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type TodoWatcher struct {
+	TodoID    int64     `json:"todoId"`
+	UserID    string    `json:"userId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Workflow struct {
+	ID        int64     `json:"workflowId"`
+	Name      string    `json:"name"`
+	IsDefault bool      `json:"isDefault"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type WorkflowState struct {
+	WorkflowID int64  `json:"workflowId"`
+	Name       string `json:"name"`
+	Terminal   bool   `json:"terminal"`
+	Position   int32  `json:"position"`
+}
+
+type WorkflowTransition struct {
+	WorkflowID int64  `json:"workflowId"`
+	FromState  string `json:"fromState"`
+	ToState    string `json:"toState"`
 }
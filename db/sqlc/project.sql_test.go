@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomProject(t *testing.T, workflowID *int64) Project {
+	arg := CreateProjectParams{
+		Name:       util.RandomString(10),
+		WorkflowID: workflowID,
+	}
+
+	project, err := testStore.CreateProject(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, project.ID)
+	require.Equal(t, arg.Name, project.Name)
+	require.Equal(t, arg.WorkflowID, project.WorkflowID)
+	require.NotZero(t, project.CreatedAt)
+
+	return project
+}
+
+func TestCreateProject(t *testing.T) {
+	createRandomProject(t, nil)
+}
+
+func TestUpdateProject(t *testing.T) {
+	workflow := createRandomWorkflow(t)
+	project := createRandomProject(t, &workflow.ID)
+
+	// Renaming keeps the workflow
+	name := util.RandomString(10)
+	updated, err := testStore.UpdateProject(context.Background(), UpdateProjectParams{
+		ID:   project.ID,
+		Name: &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, name, updated.Name)
+	require.Equal(t, &workflow.ID, updated.WorkflowID)
+
+	// Clearing the workflow
+	updated, err = testStore.UpdateProject(context.Background(), UpdateProjectParams{
+		ID:               project.ID,
+		UpdateWorkflowID: true,
+	})
+	require.NoError(t, err)
+	require.Nil(t, updated.WorkflowID)
+}
+
+func TestDeleteWorkflowOfProject(t *testing.T) {
+	workflow := createRandomWorkflow(t)
+	project := createRandomProject(t, &workflow.ID)
+
+	err := testStore.DeleteWorkflow(context.Background(), workflow.ID)
+	require.NoError(t, err)
+
+	project, err = testStore.GetProject(context.Background(), project.ID)
+	require.NoError(t, err)
+	require.Nil(t, project.WorkflowID)
+}
@@ -6,20 +6,166 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
+	AddCommentAttachments(ctx context.Context, arg AddCommentAttachmentsParams) error
+	AddTagsToTemplate(ctx context.Context, arg AddTagsToTemplateParams) error
+	AddTagsToTodo(ctx context.Context, arg AddTagsToTodoParams) error
+	AddTodoAssignees(ctx context.Context, arg AddTodoAssigneesParams) error
+	AddTodoDependency(ctx context.Context, arg AddTodoDependencyParams) error
+	AddTodoWatcher(ctx context.Context, arg AddTodoWatcherParams) error
+	ArchiveCompletedTodos(ctx context.Context, completedBefore time.Time) (int64, error)
+	ArchiveTodo(ctx context.Context, id int64) (Todo, error)
+	// The copies start unchecked
+	CopyChecklistItems(ctx context.Context, arg CopyChecklistItemsParams) error
+	CopyTemplateTags(ctx context.Context, arg CopyTemplateTagsParams) error
+	CopyTodoReminders(ctx context.Context, arg CopyTodoRemindersParams) error
+	CopyTodoTags(ctx context.Context, arg CopyTodoTagsParams) error
+	CountAttachmentsOfTodo(ctx context.Context, arg CountAttachmentsOfTodoParams) (int64, error)
 	CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (Attachment, error)
-	CreateTodo(ctx context.Context, title string) (Todo, error)
+	CreateChecklistItem(ctx context.Context, arg CreateChecklistItemParams) (ChecklistItem, error)
+	CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error)
+	CreateProject(ctx context.Context, arg CreateProjectParams) (Project, error)
+	CreateRecurrence(ctx context.Context, arg CreateRecurrenceParams) (Recurrence, error)
+	CreateReminder(ctx context.Context, arg CreateReminderParams) (Reminder, error)
+	CreateSmartList(ctx context.Context, arg CreateSmartListParams) (SmartList, error)
+	CreateTag(ctx context.Context, arg CreateTagParams) (Tag, error)
+	CreateTemplate(ctx context.Context, arg CreateTemplateParams) (Template, error)
+	CreateTemplateAttachment(ctx context.Context, arg CreateTemplateAttachmentParams) (TemplateAttachment, error)
+	CreateTimeEntry(ctx context.Context, arg CreateTimeEntryParams) (TimeEntry, error)
+	// New todos start in the first state of their workflow
+	CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, error)
+	CreateTodoRevision(ctx context.Context, arg CreateTodoRevisionParams) (TodoRevision, error)
+	CreateTodoTransition(ctx context.Context, arg CreateTodoTransitionParams) (TodoTransition, error)
+	CreateWorkflow(ctx context.Context, name string) (Workflow, error)
+	CreateWorkflowStates(ctx context.Context, arg CreateWorkflowStatesParams) error
+	CreateWorkflowTransitions(ctx context.Context, arg CreateWorkflowTransitionsParams) error
 	DeleteAttachment(ctx context.Context, id int64) error
 	DeleteAttachmentsOfTodo(ctx context.Context, todoID int64) error
+	DeleteChecklistItem(ctx context.Context, id int64) error
+	DeleteComment(ctx context.Context, id int64) error
+	DeleteCommentAttachments(ctx context.Context, commentID int64) error
+	DeleteProject(ctx context.Context, id int64) error
+	DeleteRecurrence(ctx context.Context, id int64) error
+	DeleteReminder(ctx context.Context, id int64) error
+	DeleteSmartList(ctx context.Context, id int64) error
+	DeleteTag(ctx context.Context, id int64) error
+	DeleteTemplate(ctx context.Context, id int64) error
+	DeleteTemplateAttachment(ctx context.Context, id int64) error
+	DeleteTemplateTags(ctx context.Context, templateID int64) error
+	DeleteTimeEntry(ctx context.Context, id int64) error
 	DeleteTodo(ctx context.Context, id int64) error
+	DeleteTodoTags(ctx context.Context, todoID int64) error
+	DeleteWorkflow(ctx context.Context, id int64) error
+	DeleteWorkflowStates(ctx context.Context, workflowID int64) error
 	GetAttachment(ctx context.Context, id int64) (Attachment, error)
+	GetChecklistItem(ctx context.Context, id int64) (ChecklistItem, error)
+	GetComment(ctx context.Context, id int64) (Comment, error)
+	GetProject(ctx context.Context, id int64) (Project, error)
+	GetRecurrence(ctx context.Context, id int64) (Recurrence, error)
+	GetReminder(ctx context.Context, id int64) (Reminder, error)
+	GetSmartList(ctx context.Context, id int64) (SmartList, error)
+	GetTag(ctx context.Context, id int64) (Tag, error)
+	GetTemplate(ctx context.Context, id int64) (Template, error)
+	GetTemplateAttachment(ctx context.Context, id int64) (TemplateAttachment, error)
+	GetTimeEntry(ctx context.Context, id int64) (TimeEntry, error)
 	GetTodo(ctx context.Context, id int64) (Todo, error)
+	GetTodoForUpdate(ctx context.Context, id int64) (Todo, error)
+	GetTodoPositionAfter(ctx context.Context, arg GetTodoPositionAfterParams) (int64, error)
+	GetTodoPositionBefore(ctx context.Context, arg GetTodoPositionBeforeParams) (int64, error)
+	GetTodoRevision(ctx context.Context, id int64) (TodoRevision, error)
+	GetTodoWorkflowID(ctx context.Context, id int64) (int64, error)
+	GetTrashedTodo(ctx context.Context, id int64) (Todo, error)
+	GetWorkflow(ctx context.Context, id int64) (Workflow, error)
+	GetWorkflowState(ctx context.Context, arg GetWorkflowStateParams) (WorkflowState, error)
+	IsTodoAncestor(ctx context.Context, arg IsTodoAncestorParams) (bool, error)
+	IsTodoBlockedBy(ctx context.Context, arg IsTodoBlockedByParams) (bool, error)
+	IsWorkflowTransitionAllowed(ctx context.Context, arg IsWorkflowTransitionAllowedParams) (bool, error)
+	ListAllComments(ctx context.Context, todoID int64) ([]Comment, error)
+	// Includes the trashed descendants
+	ListAllTodoDescendants(ctx context.Context, todoID int64) ([]Todo, error)
 	ListAttachmentOfTodo(ctx context.Context, todoID int64) ([]Attachment, error)
-	ListTodos(ctx context.Context, arg ListTodosParams) ([]Todo, error)
+	ListAttachmentsOfTodos(ctx context.Context, todoIds []int64) ([]Attachment, error)
+	ListChecklistItems(ctx context.Context, todoID int64) ([]ChecklistItem, error)
+	ListCommentAttachments(ctx context.Context, commentIds []int64) ([]CommentAttachment, error)
+	ListComments(ctx context.Context, arg ListCommentsParams) ([]Comment, error)
+	ListDueReminders(ctx context.Context, arg ListDueRemindersParams) ([]ListDueRemindersRow, error)
+	ListProjects(ctx context.Context) ([]Project, error)
+	// Todos trashed before the cutoff, except those purged along with a trashed parent
+	ListPurgeableTodos(ctx context.Context, arg ListPurgeableTodosParams) ([]Todo, error)
+	ListRemindersOfTodo(ctx context.Context, todoID int64) ([]Reminder, error)
+	// Lists the open todos whose title is similar to the title by trigram similarity, most similar first; the '%'
+	// operator uses the trigram index of the titles, so thresholds below pg_trgm.similarity_threshold (0.3) act as it
+	ListSimilarTodos(ctx context.Context, arg ListSimilarTodosParams) ([]ListSimilarTodosRow, error)
+	// Pinned smart lists come first
+	ListSmartLists(ctx context.Context, userID string) ([]SmartList, error)
+	ListTags(ctx context.Context) ([]Tag, error)
+	ListTagsOfTemplate(ctx context.Context, templateID int64) ([]Tag, error)
+	ListTagsOfTodo(ctx context.Context, todoID int64) ([]Tag, error)
+	ListTemplateAttachments(ctx context.Context, templateID int64) ([]TemplateAttachment, error)
+	ListTemplates(ctx context.Context) ([]Template, error)
+	ListTimeEntries(ctx context.Context, todoID int64) ([]TimeEntry, error)
+	ListTodoAssignees(ctx context.Context, todoID int64) ([]TodoAssignee, error)
+	ListTodoBlockers(ctx context.Context, todoID int64) ([]Todo, error)
+	ListTodoDescendants(ctx context.Context, todoID int64) ([]Todo, error)
+	// Lists the users assigned to or watching the todo, who get notified about its changes
+	ListTodoRecipients(ctx context.Context, todoID int64) ([]string, error)
+	ListTodoRevisions(ctx context.Context, todoID int64) ([]TodoRevision, error)
+	ListTodoRollups(ctx context.Context, todoIds []int64) ([]ListTodoRollupsRow, error)
+	ListTodoTransitions(ctx context.Context, todoID int64) ([]TodoTransition, error)
+	ListTodoWatchers(ctx context.Context, todoID int64) ([]TodoWatcher, error)
+	ListTodosBlockedBy(ctx context.Context, blockedByID int64) ([]Todo, error)
+	ListTrashedTodos(ctx context.Context, arg ListTrashedTodosParams) ([]Todo, error)
+	ListWorkflowStates(ctx context.Context, workflowID int64) ([]WorkflowState, error)
+	ListWorkflowTransitions(ctx context.Context, workflowID int64) ([]WorkflowTransition, error)
+	ListWorkflows(ctx context.Context) ([]Workflow, error)
+	LockTodoDependencies(ctx context.Context) error
+	LockTodoHierarchy(ctx context.Context) error
+	MarkReminderFailed(ctx context.Context, arg MarkReminderFailedParams) error
+	MarkReminderFired(ctx context.Context, arg MarkReminderFiredParams) error
+	MoveTodoAttachments(ctx context.Context, arg MoveTodoAttachmentsParams) ([]Attachment, error)
+	MoveTodoComments(ctx context.Context, arg MoveTodoCommentsParams) error
+	MoveTodoTags(ctx context.Context, arg MoveTodoTagsParams) error
+	RebalanceTodoPositions(ctx context.Context) error
+	RemoveTagFromTodo(ctx context.Context, arg RemoveTagFromTodoParams) error
+	RemoveTodoAssignee(ctx context.Context, arg RemoveTodoAssigneeParams) (int64, error)
+	RemoveTodoDependency(ctx context.Context, arg RemoveTodoDependencyParams) error
+	RemoveTodoWatcher(ctx context.Context, arg RemoveTodoWatcherParams) error
+	// Positions the items of the todo in the order of the IDs
+	ReorderChecklistItems(ctx context.Context, arg ReorderChecklistItemsParams) error
+	// Sums the time tracked each day, in the timezone, by the entries started within the range;
+	// an entry counts toward the day it started
+	ReportTimeByDay(ctx context.Context, arg ReportTimeByDayParams) ([]ReportTimeByDayRow, error)
+	// Sums the time tracked on the todos of each project by the entries started within the range;
+	// todos outside of any project are grouped under a NULL project
+	ReportTimeByProject(ctx context.Context, arg ReportTimeByProjectParams) ([]ReportTimeByProjectRow, error)
+	// Sums the time tracked on each todo by the entries started within the range; running timers count until now
+	ReportTimeByTodo(ctx context.Context, arg ReportTimeByTodoParams) ([]ReportTimeByTodoRow, error)
+	// Restores the todo along with the subtasks trashed at the same time
+	RestoreTodo(ctx context.Context, todoID int64) error
+	SetTodoMergedInto(ctx context.Context, arg SetTodoMergedIntoParams) (Todo, error)
+	// Fails with a unique violation if the user already has a running timer
+	StartTimer(ctx context.Context, arg StartTimerParams) (TimeEntry, error)
+	StopTimer(ctx context.Context, arg StopTimerParams) (TimeEntry, error)
+	// Trashes the todo along with its subtasks which aren't trashed yet
+	TrashTodo(ctx context.Context, todoID int64) error
+	UnarchiveTodo(ctx context.Context, id int64) (Todo, error)
+	UpdateChecklistItem(ctx context.Context, arg UpdateChecklistItemParams) (ChecklistItem, error)
+	UpdateCommentBody(ctx context.Context, arg UpdateCommentBodyParams) (Comment, error)
+	UpdateProject(ctx context.Context, arg UpdateProjectParams) (Project, error)
+	UpdateRecurrence(ctx context.Context, arg UpdateRecurrenceParams) (Recurrence, error)
+	UpdateSmartList(ctx context.Context, arg UpdateSmartListParams) (SmartList, error)
+	UpdateTag(ctx context.Context, arg UpdateTagParams) (Tag, error)
+	UpdateTemplate(ctx context.Context, arg UpdateTemplateParams) (Template, error)
+	UpdateTimeEntry(ctx context.Context, arg UpdateTimeEntryParams) (TimeEntry, error)
 	UpdateTodoFileCount(ctx context.Context, arg UpdateTodoFileCountParams) (Todo, error)
+	UpdateTodoParent(ctx context.Context, arg UpdateTodoParentParams) (Todo, error)
+	UpdateTodoPosition(ctx context.Context, arg UpdateTodoPositionParams) (Todo, error)
+	UpdateTodoRecurrence(ctx context.Context, arg UpdateTodoRecurrenceParams) (Todo, error)
 	UpdateTodoTitleStatus(ctx context.Context, arg UpdateTodoTitleStatusParams) (Todo, error)
+	UpdateWorkflowName(ctx context.Context, arg UpdateWorkflowNameParams) (Workflow, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jaingounchained/todo/rrule"
+	storage "github.com/jaingounchained/todo/storage"
+	"github.com/jaingounchained/todo/util"
+)
+
+// NextOccurrence returns the first occurrence of the recurrence strictly after the given time
+func (recurrence Recurrence) NextOccurrence(after time.Time) (time.Time, error) {
+	rule, err := rrule.Parse(recurrence.Rrule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc, err := time.LoadLocation(recurrence.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next, ok := rule.Next(recurrence.Dtstart.In(loc), after)
+	if !ok {
+		return time.Time{}, ErrRecurrenceEnded
+	}
+
+	return next, nil
+}
+
+// currentOccurrence is the due date of the current instance, or now when the due date was cleared
+func currentOccurrence(todo Todo) time.Time {
+	if todo.DueAt != nil {
+		return *todo.DueAt
+	}
+
+	return time.Now()
+}
+
+// createNextRecurringTodo hands the recurrence of the completed todo over to a new instance due at the
+// next occurrence, carrying over tags, reminders and optionally the attachments; the recurrence is
+// deleted instead once it has no occurrence left
+func createNextRecurringTodo(ctx context.Context, q *Queries, todo Todo, storage storage.Storage) (completed Todo, next *Todo, err error) {
+	recurrence, err := q.GetRecurrence(ctx, *todo.RecurrenceID)
+	if err != nil {
+		return todo, nil, err
+	}
+
+	// Only the current instance points to the recurrence
+	completed, err = q.UpdateTodoRecurrence(ctx, UpdateTodoRecurrenceParams{
+		ID: todo.ID,
+	})
+	if err != nil {
+		return todo, nil, err
+	}
+
+	dueAt, err := recurrence.NextOccurrence(currentOccurrence(todo))
+	if errors.Is(err, ErrRecurrenceEnded) {
+		return completed, nil, q.DeleteRecurrence(ctx, recurrence.ID)
+	}
+	if err != nil {
+		return completed, nil, err
+	}
+
+	nextTodo, err := q.CreateTodo(ctx, CreateTodoParams{
+		Title:       todo.Title,
+		DueAt:       &dueAt,
+		DueTimezone: todo.DueTimezone,
+		Priority:    todo.Priority,
+		ParentID:    todo.ParentID,
+		ProjectID:   todo.ProjectID,
+		Description: todo.Description,
+	})
+	if err != nil {
+		return completed, nil, err
+	}
+
+	nextTodo, err = q.UpdateTodoRecurrence(ctx, UpdateTodoRecurrenceParams{
+		ID:           nextTodo.ID,
+		RecurrenceID: &recurrence.ID,
+	})
+	if err != nil {
+		return completed, nil, err
+	}
+
+	err = q.CopyTodoTags(ctx, CopyTodoTagsParams{
+		TargetTodoID: nextTodo.ID,
+		SourceTodoID: todo.ID,
+	})
+	if err != nil {
+		return completed, nil, err
+	}
+
+	err = q.CopyTodoReminders(ctx, CopyTodoRemindersParams{
+		TargetTodoID: nextTodo.ID,
+		SourceTodoID: todo.ID,
+	})
+	if err != nil {
+		return completed, nil, err
+	}
+
+	err = storage.CreateTodoDirectory(ctx, nextTodo.ID)
+	if err != nil {
+		return completed, nil, err
+	}
+
+	if recurrence.CopyAttachments {
+		nextTodo, _, err = copyAttachments(ctx, q, todo.ID, nextTodo.ID, storage)
+		if err != nil {
+			return completed, nil, err
+		}
+	}
+
+	return completed, &nextTodo, nil
+}
+
+// copyAttachments copies the attachment files and metadata of a todo to another one; it also returns the
+// IDs of the copies by the IDs of the copied attachments
+func copyAttachments(ctx context.Context, q *Queries, srcTodoID, dstTodoID int64, storage storage.Storage) (Todo, map[int64]int64, error) {
+	attachments, err := q.ListAttachmentOfTodo(ctx, srcTodoID)
+	if err != nil {
+		return Todo{}, nil, err
+	}
+
+	attachmentIDs := make(map[int64]int64, len(attachments))
+	for _, attachment := range attachments {
+		uuid, err := util.GenerateUUID()
+		if err != nil {
+			return Todo{}, nil, err
+		}
+
+		copied, err := q.CreateAttachment(ctx, CreateAttachmentParams{
+			TodoID:           dstTodoID,
+			OriginalFilename: attachment.OriginalFilename,
+			StorageFilename:  uuid,
+		})
+		if err != nil {
+			return Todo{}, nil, err
+		}
+		attachmentIDs[attachment.ID] = copied.ID
+
+		err = storage.CopyFile(ctx, srcTodoID, attachment.StorageFilename, dstTodoID, uuid)
+		if err != nil {
+			return Todo{}, nil, err
+		}
+	}
+
+	todo, err := q.UpdateTodoFileCount(ctx, UpdateTodoFileCountParams{
+		ID:        dstTodoID,
+		FileCount: int32(len(attachments)),
+	})
+	return todo, attachmentIDs, err
+}
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomTodoDueAt(t *testing.T, dueAt time.Time) Todo {
+	todo, err := testStore.CreateTodo(context.Background(), CreateTodoParams{
+		Title: util.RandomString(10),
+		DueAt: &dueAt,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, todo.DueAt)
+	require.WithinDuration(t, dueAt, *todo.DueAt, time.Second)
+
+	return todo
+}
+
+func createReminderForTodo(t *testing.T, todo Todo, offsetMinutes int32) Reminder {
+	reminder, err := testStore.CreateReminder(context.Background(), CreateReminderParams{
+		TodoID:        todo.ID,
+		OffsetMinutes: offsetMinutes,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, reminder.ID)
+	require.Equal(t, todo.ID, reminder.TodoID)
+	require.Equal(t, offsetMinutes, reminder.OffsetMinutes)
+	require.Nil(t, reminder.FiredAt)
+	require.Nil(t, reminder.FiredForDueAt)
+
+	return reminder
+}
+
+func TestCreateReminderDuplicateOffset(t *testing.T) {
+	todo := createRandomTodo(t)
+	createReminderForTodo(t, todo, 30)
+
+	_, err := testStore.CreateReminder(context.Background(), CreateReminderParams{
+		TodoID:        todo.ID,
+		OffsetMinutes: 30,
+	})
+	require.Error(t, err)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+}
+
+func TestListRemindersOfTodo(t *testing.T) {
+	todo := createRandomTodo(t)
+	createReminderForTodo(t, todo, 10)
+	createReminderForTodo(t, todo, 60)
+
+	reminders, err := testStore.ListRemindersOfTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, reminders, 2)
+	require.Equal(t, int32(60), reminders[0].OffsetMinutes)
+	require.Equal(t, int32(10), reminders[1].OffsetMinutes)
+}
+
+func TestDeleteReminder(t *testing.T) {
+	todo := createRandomTodo(t)
+	reminder := createReminderForTodo(t, todo, 10)
+
+	err := testStore.DeleteReminder(context.Background(), reminder.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetReminder(context.Background(), reminder.ID)
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+}
+
+func TestListDueRemindersAndMarkFired(t *testing.T) {
+	now := time.Now()
+	todo := createRandomTodoDueAt(t, now.Add(30*time.Minute))
+	due := createReminderForTodo(t, todo, 60)
+	notDue := createReminderForTodo(t, todo, 10)
+
+	isListed := func(reminderID int64) bool {
+		reminders, err := testStore.ListDueReminders(context.Background(), ListDueRemindersParams{
+			Now:   now,
+			Limit: 1000,
+		})
+		require.NoError(t, err)
+
+		for _, reminder := range reminders {
+			if reminder.ID == reminderID {
+				return true
+			}
+		}
+		return false
+	}
+
+	require.True(t, isListed(due.ID))
+	require.False(t, isListed(notDue.ID))
+
+	// Fired reminders aren't listed again for the same due date
+	err := testStore.MarkReminderFired(context.Background(), MarkReminderFiredParams{
+		FiredAt:       now,
+		FiredForDueAt: *todo.DueAt,
+		ID:            due.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, isListed(due.ID))
+
+	// Moving the due date re-arms the reminder
+	newDueAt := now.Add(45 * time.Minute)
+	_, err = testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:          todo.ID,
+		UpdateDueAt: true,
+		DueAt:       &newDueAt,
+	})
+	require.NoError(t, err)
+	require.True(t, isListed(due.ID))
+
+	// Completed todos aren't reminded about
+	setTodoStatusTx(t, todo, "complete")
+	require.False(t, isListed(due.ID))
+}
+
+func TestListDueRemindersRetriesFailedLast(t *testing.T) {
+	now := time.Now()
+	failed := createReminderForTodo(t, createRandomTodoDueAt(t, now.Add(-time.Hour)), 0)
+	fresh := createReminderForTodo(t, createRandomTodoDueAt(t, now), 0)
+
+	listedAt := func(reminderID int64, at time.Time) int {
+		reminders, err := testStore.ListDueReminders(context.Background(), ListDueRemindersParams{
+			Now:   at,
+			Limit: 1000,
+		})
+		require.NoError(t, err)
+
+		for i, reminder := range reminders {
+			if reminder.ID == reminderID {
+				return i
+			}
+		}
+		return -1
+	}
+
+	// Failed reminders wait for their retry
+	retryAt := now.Add(time.Minute)
+	err := testStore.MarkReminderFailed(context.Background(), MarkReminderFailedParams{
+		RetryAt: retryAt,
+		ID:      failed.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, -1, listedAt(failed.ID, now))
+
+	reminder, err := testStore.GetReminder(context.Background(), failed.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), reminder.FailedAttempts)
+	require.WithinDuration(t, retryAt, *reminder.RetryAt, time.Second)
+
+	// and are then listed after the ones that never failed, even though due earlier
+	require.Greater(t, listedAt(failed.ID, retryAt), listedAt(fresh.ID, retryAt))
+
+	// Firing the reminder clears its failures
+	err = testStore.MarkReminderFired(context.Background(), MarkReminderFiredParams{
+		FiredAt:       retryAt,
+		FiredForDueAt: now.Add(-time.Hour),
+		ID:            failed.ID,
+	})
+	require.NoError(t, err)
+
+	reminder, err = testStore.GetReminder(context.Background(), failed.ID)
+	require.NoError(t, err)
+	require.Zero(t, reminder.FailedAttempts)
+	require.Nil(t, reminder.RetryAt)
+}
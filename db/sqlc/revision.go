@@ -0,0 +1,144 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// Fields recorded in todo revisions
+const (
+	RevisionFieldTitle       = "title"
+	RevisionFieldStatus      = "status"
+	RevisionFieldDueAt       = "dueAt"
+	RevisionFieldDueTimezone = "dueTimezone"
+	RevisionFieldPriority    = "priority"
+	RevisionFieldCompletedAt = "completedAt"
+	RevisionFieldDescription = "description"
+	RevisionFieldAttachments = "attachments"
+)
+
+// TodoRevisionChange holds the JSON encoded value of a field before and after a change
+type TodoRevisionChange struct {
+	Before json.RawMessage `json:"before" swaggertype:"object"`
+	After  json.RawMessage `json:"after" swaggertype:"object"`
+}
+
+// TodoRevisionChanges maps each field changed by a revision to its values before and after the change
+type TodoRevisionChanges map[string]TodoRevisionChange
+
+// add records the change of the field, if its value did change
+func (changes TodoRevisionChanges) add(field string, before, after any) error {
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		return err
+	}
+
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(beforeJSON, afterJSON) {
+		changes[field] = TodoRevisionChange{
+			Before: beforeJSON,
+			After:  afterJSON,
+		}
+	}
+
+	return nil
+}
+
+// todoChanges returns the changes between two versions of a todo made by UpdateTodoTitleStatus
+func todoChanges(before, after Todo) (TodoRevisionChanges, error) {
+	changes := TodoRevisionChanges{}
+
+	fields := []struct {
+		name          string
+		before, after any
+	}{
+		{RevisionFieldTitle, before.Title, after.Title},
+		{RevisionFieldStatus, before.Status, after.Status},
+		{RevisionFieldDueAt, before.DueAt, after.DueAt},
+		{RevisionFieldDueTimezone, before.DueTimezone, after.DueTimezone},
+		{RevisionFieldPriority, before.Priority, after.Priority},
+		{RevisionFieldCompletedAt, before.CompletedAt, after.CompletedAt},
+		{RevisionFieldDescription, before.Description, after.Description},
+	}
+	for _, field := range fields {
+		if err := changes.add(field.name, field.before, field.after); err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+// recordTodoRevision stores the changes as a new revision of the todo; nothing is stored without changes
+func recordTodoRevision(ctx context.Context, q *Queries, todoID int64, changes TodoRevisionChanges) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err := q.CreateTodoRevision(ctx, CreateTodoRevisionParams{
+		TodoID:  todoID,
+		Changes: changes,
+	})
+	return err
+}
+
+// listAllAttachmentsOfTodo lists every attachment of the todo ordered by ID, unlike ListAttachmentOfTodo which
+// returns a limited page; revisions need the full snapshot
+func listAllAttachmentsOfTodo(ctx context.Context, q *Queries, todoID int64) ([]Attachment, error) {
+	return q.ListAttachmentsOfTodos(ctx, []int64{todoID})
+}
+
+// recordAttachmentsRevision stores the change of the attachments of the todo as a new revision; the snapshot taken
+// before the change has to come from listAllAttachmentsOfTodo
+func recordAttachmentsRevision(ctx context.Context, q *Queries, todoID int64, before []Attachment) error {
+	after, err := listAllAttachmentsOfTodo(ctx, q, todoID)
+	if err != nil {
+		return err
+	}
+
+	changes := TodoRevisionChanges{}
+	if err := changes.add(RevisionFieldAttachments, before, after); err != nil {
+		return err
+	}
+
+	return recordTodoRevision(ctx, q, todoID, changes)
+}
+
+// revertParams builds the update restoring the todo fields changed by the revision to their previous values;
+// completedAt follows the restored status and a due timezone which didn't exist before is kept
+func (changes TodoRevisionChanges) revertParams(todoID int64) (UpdateTodoTitleStatusParams, error) {
+	if _, ok := changes[RevisionFieldAttachments]; ok {
+		return UpdateTodoTitleStatusParams{}, ErrTodoRevisionNotRevertible
+	}
+
+	params := UpdateTodoTitleStatusParams{
+		ID: todoID,
+	}
+
+	fields := map[string]any{
+		RevisionFieldTitle:       &params.Title,
+		RevisionFieldStatus:      &params.Status,
+		RevisionFieldDueAt:       &params.DueAt,
+		RevisionFieldDueTimezone: &params.DueTimezone,
+		RevisionFieldPriority:    &params.Priority,
+		RevisionFieldDescription: &params.Description,
+	}
+	for name, value := range fields {
+		change, ok := changes[name]
+		if !ok {
+			continue
+		}
+
+		if err := json.Unmarshal(change.Before, value); err != nil {
+			return UpdateTodoTitleStatusParams{}, err
+		}
+	}
+	_, params.UpdateDueAt = changes[RevisionFieldDueAt]
+
+	return params, nil
+}
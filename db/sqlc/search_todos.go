@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// TodoSortFieldRank sorts the search results by their relevance
+const TodoSortFieldRank = "rank"
+
+// Text search configurations todos can be searched in, each backed by an index of the search vector of the todos
+var SearchLanguages = []string{"simple", "english", "french", "german", "spanish"}
+
+// Markers around the matches in the highlights; control characters can't clash with the text of the todos
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// todoSearchSortColumns are the columns search results can be sorted by, their rank included
+var todoSearchSortColumns = func() map[string]todoSortColumn {
+	columns := maps.Clone(todoSortColumns)
+	columns[TodoSortFieldRank] = todoSortColumn{name: "rank", cast: "real"}
+	return columns
+}()
+
+// todoSearchDefaultSort lists the most relevant search results first
+var todoSearchDefaultSort = []TodoSort{{Field: TodoSortFieldRank, Descending: true}}
+
+// IsSearchLanguage reports whether todos can be searched in the text search configuration
+func IsSearchLanguage(language string) bool {
+	return slices.Contains(SearchLanguages, language)
+}
+
+// TodoSearchSortFields lists the fields search results can be sorted by
+var TodoSearchSortFields = append(slices.Clone(TodoSortFields), TodoSortFieldRank)
+
+// todoSearchVector is the weighted search vector of the todos in the language, titles ranking above descriptions; it
+// must match the expression of the search indexes for them to be used
+func todoSearchVector(language string) string {
+	return fmt.Sprintf("setweight(to_tsvector('%[1]s'::regconfig, title), 'A') || setweight(to_tsvector('%[1]s'::regconfig, description), 'B')", language)
+}
+
+// searchTermPattern matches the words of a search query
+var searchTermPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// prefixSearchQuery turns the words of the search query into a tsquery matching the lexemes starting with each of them
+func prefixSearchQuery(query string) string {
+	terms := searchTermPattern.FindAllString(query, -1)
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+const searchTodos = `-- name: SearchTodos :many
+SELECT id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at,
+    rank,
+    ts_headline(%[1]s, title, query, %[2]s),
+    ts_headline(%[1]s, description, query, %[3]s)
+FROM (
+    SELECT todos.*, ts_rank_cd(%[4]s, query) AS rank, query
+    FROM todos, %[5]s(%[1]s, %[6]s) query
+    WHERE %[4]s @@ query
+) todos
+`
+
+const countSearchTodos = `-- name: CountSearchTodos :one
+SELECT COUNT(*)
+FROM todos, %[2]s(%[1]s, %[3]s) query
+WHERE %[4]s @@ query
+    AND `
+
+type SearchTodosParams struct {
+	ListTodosParams
+	Query string `json:"query"`
+	// Language is one of the search languages
+	Language string `json:"language"`
+	// Prefix matches the lexemes starting with the words of the query instead of the web search syntax
+	Prefix bool `json:"prefix"`
+}
+
+type SearchTodosRow struct {
+	Todo                 Todo    `json:"todo"`
+	Rank                 float32 `json:"rank"`
+	TitleHighlight       string  `json:"titleHighlight"`
+	DescriptionHighlight string  `json:"descriptionHighlight"`
+}
+
+// NewTodoSearchCursor returns the cursor at the search result for the sort order
+func NewTodoSearchCursor(row SearchTodosRow, sort []TodoSort, backward bool) TodoCursor {
+	if len(sort) == 0 {
+		sort = todoSearchDefaultSort
+	}
+
+	cursor := NewTodoCursor(row.Todo, sort, backward)
+	if slices.ContainsFunc(sort, func(s TodoSort) bool { return s.Field == TodoSortFieldRank }) {
+		cursor.Rank = &row.Rank
+	}
+
+	return cursor
+}
+
+// searchQuery returns the tsquery function and the tsquery text of the search
+func (arg SearchTodosParams) searchQuery() (function, text string) {
+	if arg.Prefix {
+		return "to_tsquery", prefixSearchQuery(arg.Query)
+	}
+
+	return "websearch_to_tsquery", arg.Query
+}
+
+// SearchTodos lists the todos matching the full-text search and the filters, most relevant first unless sorted
+// otherwise, along with their highlighted title and description snippets. Like ListTodos, the query is built for the
+// given filters and the language comes from a whitelist, so that the search index of the language gets used
+func (q *Queries) SearchTodos(ctx context.Context, arg SearchTodosParams) ([]SearchTodosRow, error) {
+	if !IsSearchLanguage(arg.Language) {
+		return nil, fmt.Errorf("%w: '%s'", ErrUnknownSearchLanguage, arg.Language)
+	}
+
+	var b todoQueryBuilder
+	function, text := arg.searchQuery()
+	selectFrom := fmt.Sprintf(searchTodos,
+		"'"+arg.Language+"'::regconfig",
+		b.arg(fmt.Sprintf("HighlightAll=true, StartSel=%s, StopSel=%s", HighlightStart, HighlightStop)),
+		b.arg(fmt.Sprintf("MaxFragments=2, MaxWords=20, MinWords=5, StartSel=%s, StopSel=%s", HighlightStart, HighlightStop)),
+		todoSearchVector(arg.Language),
+		function,
+		b.arg(text),
+	)
+
+	query, args, err := b.page(selectFrom, arg.ListTodosParams, todoSearchSortColumns, todoSearchDefaultSort)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchTodosRow{}
+	for rows.Next() {
+		var i SearchTodosRow
+		if err := rows.Scan(
+			&i.Todo.ID,
+			&i.Todo.Title,
+			&i.Todo.Status,
+			&i.Todo.CreatedAt,
+			&i.Todo.FileCount,
+			&i.Todo.DueAt,
+			&i.Todo.DueTimezone,
+			&i.Todo.Priority,
+			&i.Todo.Position,
+			&i.Todo.ParentID,
+			&i.Todo.RecurrenceID,
+			&i.Todo.ProjectID,
+			&i.Todo.CompletedAt,
+			&i.Todo.DeletedAt,
+			&i.Todo.ArchivedAt,
+			&i.Todo.Description,
+			&i.Todo.MergedIntoID,
+			&i.Todo.UpdatedAt,
+			&i.Rank,
+			&i.TitleHighlight,
+			&i.DescriptionHighlight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if arg.Cursor != nil && arg.Cursor.Backward {
+		slices.Reverse(items)
+	}
+	return items, nil
+}
+
+// CountSearchTodos counts the todos matching the full-text search and the filters, regardless of the page
+func (q *Queries) CountSearchTodos(ctx context.Context, arg SearchTodosParams) (int64, error) {
+	if !IsSearchLanguage(arg.Language) {
+		return 0, fmt.Errorf("%w: '%s'", ErrUnknownSearchLanguage, arg.Language)
+	}
+
+	var b todoQueryBuilder
+	function, text := arg.searchQuery()
+	query := fmt.Sprintf(countSearchTodos,
+		"'"+arg.Language+"'::regconfig",
+		function,
+		b.arg(text),
+		todoSearchVector(arg.Language),
+	)
+
+	b.filter(arg.ListTodosParams)
+	query += strings.Join(b.conditions, "\n    AND ")
+
+	row := q.db.QueryRow(ctx, query, b.args...)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchTodos(t *testing.T) {
+	word := util.RandomString(12)
+
+	// Matches in the title rank above the ones in the description
+	inTitle := createRandomTodoTitled(t, "Quarterly "+word+" reports")
+	inDescription := createRandomTodoTitled(t, util.RandomString(10))
+	description := "The " + word + " reporting <b>pipeline</b>"
+	inDescription, err := testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:          inDescription.ID,
+		Description: &description,
+	})
+	require.NoError(t, err)
+
+	rows, err := testStore.SearchTodos(context.Background(), SearchTodosParams{
+		ListTodosParams: ListTodosParams{Limit: 10},
+		Query:           word + " report",
+		Language:        "english",
+		Prefix:          true,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	compareTodos(t, inTitle, rows[0].Todo)
+	compareTodos(t, inDescription, rows[1].Todo)
+	require.Greater(t, rows[0].Rank, rows[1].Rank)
+	require.Contains(t, rows[0].TitleHighlight, HighlightStart+"reports"+HighlightStop)
+	require.Contains(t, rows[1].DescriptionHighlight, HighlightStart+"reporting"+HighlightStop)
+
+	count, err := testStore.CountSearchTodos(context.Background(), SearchTodosParams{
+		Query:    word + " report",
+		Language: "english",
+		Prefix:   true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	// The web search syntax without prefix matching
+	rows, err = testStore.SearchTodos(context.Background(), SearchTodosParams{
+		ListTodosParams: ListTodosParams{Limit: 10},
+		Query:           word + " -pipeline",
+		Language:        "english",
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	compareTodos(t, inTitle, rows[0].Todo)
+
+	// Paging by rank
+	next := NewTodoSearchCursor(rows[0], nil, false)
+	rows, err = testStore.SearchTodos(context.Background(), SearchTodosParams{
+		ListTodosParams: ListTodosParams{Cursor: &next, Limit: 10},
+		Query:           word,
+		Language:        "english",
+		Prefix:          true,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	compareTodos(t, inDescription, rows[0].Todo)
+
+	_, err = testStore.SearchTodos(context.Background(), SearchTodosParams{
+		ListTodosParams: ListTodosParams{Limit: 10},
+		Query:           word,
+		Language:        "english'::regconfig; DROP TABLE todos; --",
+	})
+	require.ErrorIs(t, err, ErrUnknownSearchLanguage)
+}
@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSmartList(t *testing.T, userID string, pinned bool) SmartList {
+	arg := CreateSmartListParams{
+		UserID: userID,
+		Name:   util.RandomString(20),
+		Query:  "overdue=true&sort=-createdAt",
+		Pinned: pinned,
+	}
+
+	smartList, err := testStore.CreateSmartList(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, smartList)
+
+	require.Equal(t, arg.UserID, smartList.UserID)
+	require.Equal(t, arg.Name, smartList.Name)
+	require.Equal(t, arg.Query, smartList.Query)
+	require.Equal(t, arg.Pinned, smartList.Pinned)
+
+	require.NotZero(t, smartList.ID)
+	require.NotZero(t, smartList.CreatedAt)
+
+	return smartList
+}
+
+func compareSmartLists(t *testing.T, smartList1, smartList2 SmartList) {
+	require.Equal(t, smartList1.ID, smartList2.ID)
+	require.Equal(t, smartList1.UserID, smartList2.UserID)
+	require.Equal(t, smartList1.Name, smartList2.Name)
+	require.Equal(t, smartList1.Query, smartList2.Query)
+	require.Equal(t, smartList1.Pinned, smartList2.Pinned)
+	require.WithinDuration(t, smartList1.CreatedAt, smartList2.CreatedAt, time.Second)
+}
+
+func TestCreateSmartListDuplicateName(t *testing.T) {
+	smartList := createRandomSmartList(t, util.RandomString(10), false)
+
+	_, err := testStore.CreateSmartList(context.Background(), CreateSmartListParams{
+		UserID: smartList.UserID,
+		Name:   smartList.Name,
+		Query:  smartList.Query,
+	})
+	require.Error(t, err)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	// Names are unique per user
+	_, err = testStore.CreateSmartList(context.Background(), CreateSmartListParams{
+		UserID: util.RandomString(10),
+		Name:   smartList.Name,
+		Query:  smartList.Query,
+	})
+	require.NoError(t, err)
+}
+
+func TestGetSmartList(t *testing.T) {
+	smartList1 := createRandomSmartList(t, util.RandomString(10), false)
+
+	smartList2, err := testStore.GetSmartList(context.Background(), smartList1.ID)
+	require.NoError(t, err)
+	compareSmartLists(t, smartList1, smartList2)
+}
+
+func TestListSmartLists(t *testing.T) {
+	userID := util.RandomString(10)
+	unpinned := createRandomSmartList(t, userID, false)
+	pinned := createRandomSmartList(t, userID, true)
+	createRandomSmartList(t, util.RandomString(10), true)
+
+	smartLists, err := testStore.ListSmartLists(context.Background(), userID)
+	require.NoError(t, err)
+	require.Len(t, smartLists, 2)
+	compareSmartLists(t, pinned, smartLists[0])
+	compareSmartLists(t, unpinned, smartLists[1])
+}
+
+func TestUpdateSmartList(t *testing.T) {
+	smartList := createRandomSmartList(t, util.RandomString(10), false)
+
+	pinned := true
+	query := "status=open"
+	updatedSmartList, err := testStore.UpdateSmartList(context.Background(), UpdateSmartListParams{
+		ID:     smartList.ID,
+		Query:  &query,
+		Pinned: &pinned,
+	})
+	require.NoError(t, err)
+	require.Equal(t, smartList.Name, updatedSmartList.Name)
+	require.Equal(t, query, updatedSmartList.Query)
+	require.True(t, updatedSmartList.Pinned)
+}
+
+func TestDeleteSmartList(t *testing.T) {
+	smartList := createRandomSmartList(t, util.RandomString(10), false)
+
+	err := testStore.DeleteSmartList(context.Background(), smartList.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetSmartList(context.Background(), smartList.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
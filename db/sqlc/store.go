@@ -9,10 +9,37 @@ import (
 // Store provides all functions to execute db queries and transaction
 type Store interface {
 	Querier
+	ListTodos(ctx context.Context, arg ListTodosParams) ([]Todo, error)
+	CountTodos(ctx context.Context, arg ListTodosParams) (int64, error)
+	SearchTodos(ctx context.Context, arg SearchTodosParams) ([]SearchTodosRow, error)
+	CountSearchTodos(ctx context.Context, arg SearchTodosParams) (int64, error)
 	CreateTodoTx(ctx context.Context, arg CreateTodoTxParams) (CreateTodoTxResult, error)
 	DeleteTodoTx(ctx context.Context, arg DeleteTodoTxParams) error
 	UploadAttachmentTx(ctx context.Context, arg UploadAttachmentTxParams) error
 	DeleteAttachmentTx(ctx context.Context, arg DeleteAttachmentTxParams) error
+	MergeTagsTx(ctx context.Context, arg MergeTagsTxParams) (MergeTagsTxResult, error)
+	MoveTodoTx(ctx context.Context, arg MoveTodoTxParams) (MoveTodoTxResult, error)
+	SetTodoParentTx(ctx context.Context, arg SetTodoParentTxParams) (SetTodoParentTxResult, error)
+	UpdateTodoTx(ctx context.Context, arg UpdateTodoTxParams) (UpdateTodoTxResult, error)
+	SetTodoRecurrenceTx(ctx context.Context, arg SetTodoRecurrenceTxParams) (SetTodoRecurrenceTxResult, error)
+	SkipTodoOccurrenceTx(ctx context.Context, todoID int64) (SkipTodoOccurrenceTxResult, error)
+	CreateWorkflowTx(ctx context.Context, arg CreateWorkflowTxParams) (CreateWorkflowTxResult, error)
+	UpdateWorkflowTx(ctx context.Context, arg UpdateWorkflowTxParams) (UpdateWorkflowTxResult, error)
+	AddTodoDependencyTx(ctx context.Context, arg AddTodoDependencyTxParams) (AddTodoDependencyTxResult, error)
+	CreateCommentTx(ctx context.Context, arg CreateCommentTxParams) (CreateCommentTxResult, error)
+	UpdateCommentTx(ctx context.Context, arg UpdateCommentTxParams) (UpdateCommentTxResult, error)
+	RevertTodoRevisionTx(ctx context.Context, arg RevertTodoRevisionTxParams) (UpdateTodoTxResult, error)
+	RestoreTodoTx(ctx context.Context, todoID int64) (RestoreTodoTxResult, error)
+	ReorderChecklistItemsTx(ctx context.Context, arg ReorderChecklistItemsTxParams) (ReorderChecklistItemsTxResult, error)
+	PromoteChecklistItemTx(ctx context.Context, arg PromoteChecklistItemTxParams) (PromoteChecklistItemTxResult, error)
+	CreateTemplateTx(ctx context.Context, arg CreateTemplateTxParams) (CreateTemplateTxResult, error)
+	UpdateTemplateTx(ctx context.Context, arg UpdateTemplateTxParams) (UpdateTemplateTxResult, error)
+	DeleteTemplateTx(ctx context.Context, arg DeleteTemplateTxParams) error
+	UploadTemplateAttachmentTx(ctx context.Context, arg UploadTemplateAttachmentTxParams) error
+	DeleteTemplateAttachmentTx(ctx context.Context, arg DeleteTemplateAttachmentTxParams) error
+	InstantiateTemplateTx(ctx context.Context, arg InstantiateTemplateTxParams) (InstantiateTemplateTxResult, error)
+	CloneTodoTx(ctx context.Context, arg CloneTodoTxParams) (CloneTodoTxResult, error)
+	MergeTodoTx(ctx context.Context, arg MergeTodoTxParams) (MergeTodoTxResult, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transaction
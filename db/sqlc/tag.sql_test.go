@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomTag(t *testing.T) Tag {
+	arg := CreateTagParams{
+		Name:  util.RandomString(20),
+		Color: "#00ff00",
+	}
+
+	tag, err := testStore.CreateTag(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, tag)
+
+	require.Equal(t, arg.Name, tag.Name)
+	require.Equal(t, arg.Color, tag.Color)
+
+	require.NotZero(t, tag.ID)
+	require.NotZero(t, tag.CreatedAt)
+
+	return tag
+}
+
+func compareTags(t *testing.T, tag1, tag2 Tag) {
+	require.Equal(t, tag1.ID, tag2.ID)
+	require.Equal(t, tag1.Name, tag2.Name)
+	require.Equal(t, tag1.Color, tag2.Color)
+	require.WithinDuration(t, tag1.CreatedAt, tag2.CreatedAt, time.Second)
+}
+
+func TestCreateTag(t *testing.T) {
+	createRandomTag(t)
+}
+
+func TestCreateTagDuplicateName(t *testing.T) {
+	tag := createRandomTag(t)
+
+	_, err := testStore.CreateTag(context.Background(), CreateTagParams{
+		Name:  tag.Name,
+		Color: tag.Color,
+	})
+	require.Error(t, err)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+}
+
+func TestGetTag(t *testing.T) {
+	tag1 := createRandomTag(t)
+
+	tag2, err := testStore.GetTag(context.Background(), tag1.ID)
+	require.NoError(t, err)
+	compareTags(t, tag1, tag2)
+}
+
+func TestUpdateTag(t *testing.T) {
+	tag1 := createRandomTag(t)
+	updatedName := util.RandomString(20)
+
+	tag2, err := testStore.UpdateTag(context.Background(), UpdateTagParams{
+		ID:   tag1.ID,
+		Name: &updatedName,
+	})
+	require.NoError(t, err)
+
+	tag1.Name = updatedName
+	compareTags(t, tag1, tag2)
+}
+
+func TestDeleteTag(t *testing.T) {
+	tag1 := createRandomTag(t)
+
+	err := testStore.DeleteTag(context.Background(), tag1.ID)
+	require.NoError(t, err)
+
+	tag2, err := testStore.GetTag(context.Background(), tag1.ID)
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+	require.Empty(t, tag2)
+}
+
+func TestAddAndRemoveTagsOfTodo(t *testing.T) {
+	todo := createRandomTodo(t)
+	tag1 := createRandomTag(t)
+	tag2 := createRandomTag(t)
+
+	// Adding the same tag twice is a no-op
+	for i := 0; i < 2; i++ {
+		err := testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+			TodoID: todo.ID,
+			TagIds: []int64{tag1.ID, tag2.ID},
+		})
+		require.NoError(t, err)
+	}
+
+	tags, err := testStore.ListTagsOfTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+
+	err = testStore.RemoveTagFromTodo(context.Background(), RemoveTagFromTodoParams{
+		TodoID: todo.ID,
+		TagID:  tag1.ID,
+	})
+	require.NoError(t, err)
+
+	tags, err = testStore.ListTagsOfTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	compareTags(t, tag2, tags[0])
+}
+
+func TestAddUnknownTagToTodo(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	err := testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: todo.ID,
+		TagIds: []int64{-1},
+	})
+	require.Error(t, err)
+	require.Equal(t, ForeignKeyViolation, ErrorCode(err))
+}
@@ -0,0 +1,154 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomTemplate(t *testing.T) Template {
+	arg := CreateTemplateParams{
+		Name:        util.RandomString(20),
+		Title:       util.RandomString(10),
+		Description: util.RandomString(30),
+		Priority:    int16(util.RandomInt(0, 3)),
+		Checklist:   []string{util.RandomString(10), util.RandomString(10)},
+		Subtasks:    []string{util.RandomString(10)},
+	}
+
+	template, err := testStore.CreateTemplate(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, template)
+
+	require.Equal(t, arg.Name, template.Name)
+	require.Equal(t, arg.Title, template.Title)
+	require.Equal(t, arg.Description, template.Description)
+	require.Equal(t, arg.Priority, template.Priority)
+	require.Equal(t, arg.Checklist, template.Checklist)
+	require.Equal(t, arg.Subtasks, template.Subtasks)
+
+	require.NotZero(t, template.ID)
+	require.NotZero(t, template.CreatedAt)
+
+	return template
+}
+
+func compareTemplates(t *testing.T, template1, template2 Template) {
+	require.Equal(t, template1.ID, template2.ID)
+	require.Equal(t, template1.Name, template2.Name)
+	require.Equal(t, template1.Title, template2.Title)
+	require.Equal(t, template1.Description, template2.Description)
+	require.Equal(t, template1.Priority, template2.Priority)
+	require.Equal(t, template1.Checklist, template2.Checklist)
+	require.Equal(t, template1.Subtasks, template2.Subtasks)
+	require.WithinDuration(t, template1.CreatedAt, template2.CreatedAt, time.Second)
+}
+
+func TestCreateTemplate(t *testing.T) {
+	createRandomTemplate(t)
+}
+
+func TestGetTemplate(t *testing.T) {
+	template1 := createRandomTemplate(t)
+
+	template2, err := testStore.GetTemplate(context.Background(), template1.ID)
+	require.NoError(t, err)
+	compareTemplates(t, template1, template2)
+}
+
+func TestUpdateTemplate(t *testing.T) {
+	template1 := createRandomTemplate(t)
+
+	newTitle := util.RandomString(10)
+	template2, err := testStore.UpdateTemplate(context.Background(), UpdateTemplateParams{
+		ID:        template1.ID,
+		Title:     &newTitle,
+		Checklist: []string{},
+	})
+	require.NoError(t, err)
+
+	// Only the provided fields are updated
+	template1.Title = newTitle
+	template1.Checklist = []string{}
+	compareTemplates(t, template1, template2)
+}
+
+func TestDeleteTemplate(t *testing.T) {
+	template1 := createRandomTemplate(t)
+
+	err := testStore.DeleteTemplate(context.Background(), template1.ID)
+	require.NoError(t, err)
+
+	template2, err := testStore.GetTemplate(context.Background(), template1.ID)
+	require.Error(t, err)
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+	require.Empty(t, template2)
+}
+
+func TestTemplateTags(t *testing.T) {
+	template := createRandomTemplate(t)
+	tag1 := createRandomTag(t)
+	tag2 := createRandomTag(t)
+
+	// Adding a tag twice keeps a single association
+	err := testStore.AddTagsToTemplate(context.Background(), AddTagsToTemplateParams{
+		TemplateID: template.ID,
+		TagIds:     []int64{tag1.ID, tag2.ID, tag1.ID},
+	})
+	require.NoError(t, err)
+
+	tags, err := testStore.ListTagsOfTemplate(context.Background(), template.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+
+	todo := createRandomTodo(t)
+	err = testStore.CopyTemplateTags(context.Background(), CopyTemplateTagsParams{
+		TodoID:     todo.ID,
+		TemplateID: template.ID,
+	})
+	require.NoError(t, err)
+
+	todoTags, err := testStore.ListTagsOfTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.ElementsMatch(t, tags, todoTags)
+
+	err = testStore.DeleteTemplateTags(context.Background(), template.ID)
+	require.NoError(t, err)
+
+	tags, err = testStore.ListTagsOfTemplate(context.Background(), template.ID)
+	require.NoError(t, err)
+	require.Empty(t, tags)
+}
+
+func TestTemplateAttachments(t *testing.T) {
+	template := createRandomTemplate(t)
+
+	arg := CreateTemplateAttachmentParams{
+		TemplateID:       template.ID,
+		OriginalFilename: util.RandomString(10),
+		StorageFilename:  util.RandomString(10),
+	}
+	attachment1, err := testStore.CreateTemplateAttachment(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.TemplateID, attachment1.TemplateID)
+	require.Equal(t, arg.OriginalFilename, attachment1.OriginalFilename)
+	require.Equal(t, arg.StorageFilename, attachment1.StorageFilename)
+
+	attachment2, err := testStore.GetTemplateAttachment(context.Background(), attachment1.ID)
+	require.NoError(t, err)
+	require.Equal(t, attachment1, attachment2)
+
+	attachments, err := testStore.ListTemplateAttachments(context.Background(), template.ID)
+	require.NoError(t, err)
+	require.Equal(t, []TemplateAttachment{attachment1}, attachments)
+
+	// Deleting the template deletes its attachments
+	err = testStore.DeleteTemplate(context.Background(), template.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetTemplateAttachment(context.Background(), attachment1.ID)
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+}
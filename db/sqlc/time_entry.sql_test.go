@@ -0,0 +1,127 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createTimeEntryForTodo(t *testing.T, todo Todo, userID string, startedAt time.Time, duration time.Duration) TimeEntry {
+	stoppedAt := startedAt.Add(duration)
+	arg := CreateTimeEntryParams{
+		TodoID:    todo.ID,
+		UserID:    userID,
+		StartedAt: startedAt,
+		StoppedAt: &stoppedAt,
+		Note:      util.RandomString(10),
+	}
+
+	entry, err := testStore.CreateTimeEntry(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.NotZero(t, entry.ID)
+	require.Equal(t, arg.TodoID, entry.TodoID)
+	require.Equal(t, arg.UserID, entry.UserID)
+	require.WithinDuration(t, startedAt, entry.StartedAt, time.Second)
+	require.WithinDuration(t, stoppedAt, *entry.StoppedAt, time.Second)
+	require.Equal(t, arg.Note, entry.Note)
+
+	return entry
+}
+
+func TestStartStopTimer(t *testing.T) {
+	todo := createRandomTodo(t)
+	userID := util.RandomString(8)
+
+	entry, err := testStore.StartTimer(context.Background(), StartTimerParams{
+		TodoID: todo.ID,
+		UserID: userID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, entry.StoppedAt)
+
+	// A user runs at most one timer
+	_, err = testStore.StartTimer(context.Background(), StartTimerParams{
+		TodoID: createRandomTodo(t).ID,
+		UserID: userID,
+	})
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	stoppedEntry, err := testStore.StopTimer(context.Background(), StopTimerParams{
+		TodoID: todo.ID,
+		UserID: userID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, entry.ID, stoppedEntry.ID)
+	require.NotNil(t, stoppedEntry.StoppedAt)
+
+	_, err = testStore.StopTimer(context.Background(), StopTimerParams{
+		TodoID: todo.ID,
+		UserID: userID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestUpdateTimeEntryInvalidRange(t *testing.T) {
+	todo := createRandomTodo(t)
+	entry := createTimeEntryForTodo(t, todo, util.RandomString(8), time.Now().Add(-time.Hour), 30*time.Minute)
+
+	stoppedAt := entry.StartedAt.Add(-time.Minute)
+	_, err := testStore.UpdateTimeEntry(context.Background(), UpdateTimeEntryParams{
+		StoppedAt: &stoppedAt,
+		ID:        entry.ID,
+	})
+	require.Equal(t, CheckViolation, ErrorCode(err))
+}
+
+func TestReportTime(t *testing.T) {
+	project := createRandomProject(t, nil)
+	todo, err := testStore.CreateTodo(context.Background(), CreateTodoParams{
+		Title:     util.RandomString(10),
+		ProjectID: &project.ID,
+	})
+	require.NoError(t, err)
+
+	userID := util.RandomString(8)
+	day := time.Date(2024, time.June, 3, 9, 0, 0, 0, time.UTC)
+	createTimeEntryForTodo(t, todo, userID, day, time.Hour)
+	createTimeEntryForTodo(t, todo, userID, day.AddDate(0, 0, 1), 30*time.Minute)
+	// Out of the range
+	createTimeEntryForTodo(t, todo, userID, day.AddDate(0, 0, 7), time.Hour)
+	// Tracked by another user
+	createTimeEntryForTodo(t, todo, util.RandomString(8), day, time.Hour)
+
+	startedFrom := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	startedBefore := startedFrom.AddDate(0, 0, 7)
+
+	byTodo, err := testStore.ReportTimeByTodo(context.Background(), ReportTimeByTodoParams{
+		StartedFrom:   startedFrom,
+		StartedBefore: startedBefore,
+		UserID:        &userID,
+	})
+	require.NoError(t, err)
+	require.Contains(t, byTodo, ReportTimeByTodoRow{TodoID: todo.ID, Title: todo.Title, Seconds: 5400})
+
+	byProject, err := testStore.ReportTimeByProject(context.Background(), ReportTimeByProjectParams{
+		StartedFrom:   startedFrom,
+		StartedBefore: startedBefore,
+		UserID:        &userID,
+	})
+	require.NoError(t, err)
+	require.Contains(t, byProject, ReportTimeByProjectRow{ProjectID: &project.ID, Name: &project.Name, Seconds: 5400})
+
+	byDay, err := testStore.ReportTimeByDay(context.Background(), ReportTimeByDayParams{
+		Timezone:      "UTC",
+		StartedFrom:   startedFrom,
+		StartedBefore: startedBefore,
+		UserID:        &userID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []ReportTimeByDayRow{
+		{Day: "2024-06-03", Seconds: 3600},
+		{Day: "2024-06-04", Seconds: 1800},
+	}, byDay)
+}
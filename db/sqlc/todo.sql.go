@@ -7,18 +7,105 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
+const archiveCompletedTodos = `-- name: ArchiveCompletedTodos :execrows
+UPDATE todos
+SET archived_at = now()
+WHERE completed_at < $1::timestamptz
+    AND archived_at IS NULL
+    AND deleted_at IS NULL
+`
+
+func (q *Queries) ArchiveCompletedTodos(ctx context.Context, completedBefore time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, archiveCompletedTodos, completedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const archiveTodo = `-- name: ArchiveTodo :one
+UPDATE todos
+SET archived_at = COALESCE(archived_at, now())
+WHERE id = $1
+RETURNING id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at
+`
+
+func (q *Queries) ArchiveTodo(ctx context.Context, id int64) (Todo, error) {
+	row := q.db.QueryRow(ctx, archiveTodo, id)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const createTodo = `-- name: CreateTodo :one
 INSERT INTO todos (
-    title
+    title,
+    due_at,
+    due_timezone,
+    priority,
+    parent_id,
+    project_id,
+    description,
+    status,
+    position
 ) VALUES (
-    $1
-) RETURNING id, title, status, created_at, file_count
+    $1, $2, $3, $4, $5, $6, $7,
+    (
+        SELECT workflow_states.name FROM workflow_states
+        WHERE workflow_states.workflow_id = COALESCE(
+            (SELECT projects.workflow_id FROM projects WHERE projects.id = $6),
+            (SELECT workflows.id FROM workflows WHERE workflows.is_default)
+        )
+        ORDER BY workflow_states.position
+        LIMIT 1
+    ),
+    COALESCE((SELECT MAX(position) FROM todos), 0) + 65536
+) RETURNING id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at
 `
 
-func (q *Queries) CreateTodo(ctx context.Context, title string) (Todo, error) {
-	row := q.db.QueryRow(ctx, createTodo, title)
+type CreateTodoParams struct {
+	Title       string     `json:"title"`
+	DueAt       *time.Time `json:"dueAt"`
+	DueTimezone *string    `json:"dueTimezone"`
+	Priority    int16      `json:"priority"`
+	ParentID    *int64     `json:"parentId"`
+	ProjectID   *int64     `json:"projectId"`
+	Description string     `json:"description"`
+}
+
+// New todos start in the first state of their workflow
+func (q *Queries) CreateTodo(ctx context.Context, arg CreateTodoParams) (Todo, error) {
+	row := q.db.QueryRow(ctx, createTodo,
+		arg.Title,
+		arg.DueAt,
+		arg.DueTimezone,
+		arg.Priority,
+		arg.ParentID,
+		arg.ProjectID,
+		arg.Description,
+	)
 	var i Todo
 	err := row.Scan(
 		&i.ID,
@@ -26,6 +113,19 @@ func (q *Queries) CreateTodo(ctx context.Context, title string) (Todo, error) {
 		&i.Status,
 		&i.CreatedAt,
 		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -41,8 +141,8 @@ func (q *Queries) DeleteTodo(ctx context.Context, id int64) error {
 }
 
 const getTodo = `-- name: GetTodo :one
-SELECT id, title, status, created_at, file_count FROM todos
-WHERE id = $1 LIMIT 1
+SELECT id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at FROM todos
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetTodo(ctx context.Context, id int64) (Todo, error) {
@@ -54,24 +154,447 @@ func (q *Queries) GetTodo(ctx context.Context, id int64) (Todo, error) {
 		&i.Status,
 		&i.CreatedAt,
 		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const listTodos = `-- name: ListTodos :many
-SELECT id, title, status, created_at, file_count FROM todos
-ORDER BY id
+const getTodoForUpdate = `-- name: GetTodoForUpdate :one
+SELECT id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at FROM todos
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTodoForUpdate(ctx context.Context, id int64) (Todo, error) {
+	row := q.db.QueryRow(ctx, getTodoForUpdate, id)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTodoPositionAfter = `-- name: GetTodoPositionAfter :one
+SELECT position FROM todos
+WHERE (position, id) > ($1::bigint, $2::bigint)
+    AND id <> $3::bigint
+ORDER BY position, id
+LIMIT 1
+`
+
+type GetTodoPositionAfterParams struct {
+	Position  int64 `json:"position"`
+	ID        int64 `json:"id"`
+	ExcludeID int64 `json:"excludeId"`
+}
+
+func (q *Queries) GetTodoPositionAfter(ctx context.Context, arg GetTodoPositionAfterParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getTodoPositionAfter, arg.Position, arg.ID, arg.ExcludeID)
+	var position int64
+	err := row.Scan(&position)
+	return position, err
+}
+
+const getTodoPositionBefore = `-- name: GetTodoPositionBefore :one
+SELECT position FROM todos
+WHERE (position, id) < ($1::bigint, $2::bigint)
+    AND id <> $3::bigint
+ORDER BY position DESC, id DESC
+LIMIT 1
+`
+
+type GetTodoPositionBeforeParams struct {
+	Position  int64 `json:"position"`
+	ID        int64 `json:"id"`
+	ExcludeID int64 `json:"excludeId"`
+}
+
+func (q *Queries) GetTodoPositionBefore(ctx context.Context, arg GetTodoPositionBeforeParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getTodoPositionBefore, arg.Position, arg.ID, arg.ExcludeID)
+	var position int64
+	err := row.Scan(&position)
+	return position, err
+}
+
+const getTrashedTodo = `-- name: GetTrashedTodo :one
+SELECT id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at FROM todos
+WHERE id = $1 AND deleted_at IS NOT NULL LIMIT 1
+`
+
+func (q *Queries) GetTrashedTodo(ctx context.Context, id int64) (Todo, error) {
+	row := q.db.QueryRow(ctx, getTrashedTodo, id)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const isTodoAncestor = `-- name: IsTodoAncestor :one
+WITH RECURSIVE ancestors AS (
+    SELECT id, parent_id FROM todos WHERE id = $1::bigint
+    UNION ALL
+    SELECT todos.id, todos.parent_id FROM todos
+    JOIN ancestors ON todos.id = ancestors.parent_id
+)
+SELECT EXISTS (
+    SELECT 1 FROM ancestors WHERE id = $2::bigint
+)::bool
+`
+
+type IsTodoAncestorParams struct {
+	TodoID     int64 `json:"todoId"`
+	AncestorID int64 `json:"ancestorId"`
+}
+
+func (q *Queries) IsTodoAncestor(ctx context.Context, arg IsTodoAncestorParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isTodoAncestor, arg.TodoID, arg.AncestorID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const listAllTodoDescendants = `-- name: ListAllTodoDescendants :many
+WITH RECURSIVE descendants AS (
+    SELECT id FROM todos WHERE parent_id = $1::bigint
+    UNION ALL
+    SELECT todos.id FROM todos
+    JOIN descendants ON todos.parent_id = descendants.id
+)
+SELECT todos.id, todos.title, todos.status, todos.created_at, todos.file_count, todos.due_at, todos.due_timezone, todos.priority, todos.position, todos.parent_id, todos.recurrence_id, todos.project_id, todos.completed_at, todos.deleted_at, todos.archived_at, todos.description, todos.merged_into_id, todos.updated_at FROM todos
+JOIN descendants ON todos.id = descendants.id
+ORDER BY todos.position, todos.id
+`
+
+// Includes the trashed descendants
+func (q *Queries) ListAllTodoDescendants(ctx context.Context, todoID int64) ([]Todo, error) {
+	rows, err := q.db.Query(ctx, listAllTodoDescendants, todoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Todo{}
+	for rows.Next() {
+		var i Todo
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FileCount,
+			&i.DueAt,
+			&i.DueTimezone,
+			&i.Priority,
+			&i.Position,
+			&i.ParentID,
+			&i.RecurrenceID,
+			&i.ProjectID,
+			&i.CompletedAt,
+			&i.DeletedAt,
+			&i.ArchivedAt,
+			&i.Description,
+			&i.MergedIntoID,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPurgeableTodos = `-- name: ListPurgeableTodos :many
+SELECT todos.id, todos.title, todos.status, todos.created_at, todos.file_count, todos.due_at, todos.due_timezone, todos.priority, todos.position, todos.parent_id, todos.recurrence_id, todos.project_id, todos.completed_at, todos.deleted_at, todos.archived_at, todos.description, todos.merged_into_id, todos.updated_at FROM todos
+LEFT JOIN todos AS parents ON parents.id = todos.parent_id
+WHERE todos.deleted_at < $1::timestamptz
+    AND (parents.deleted_at IS NULL OR parents.deleted_at >= $1::timestamptz)
+ORDER BY todos.deleted_at, todos.id
+LIMIT $2
+`
+
+type ListPurgeableTodosParams struct {
+	DeletedBefore time.Time `json:"deletedBefore"`
+	Limit         int32     `json:"limit"`
+}
+
+// Todos trashed before the cutoff, except those purged along with a trashed parent
+func (q *Queries) ListPurgeableTodos(ctx context.Context, arg ListPurgeableTodosParams) ([]Todo, error) {
+	rows, err := q.db.Query(ctx, listPurgeableTodos, arg.DeletedBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Todo{}
+	for rows.Next() {
+		var i Todo
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FileCount,
+			&i.DueAt,
+			&i.DueTimezone,
+			&i.Priority,
+			&i.Position,
+			&i.ParentID,
+			&i.RecurrenceID,
+			&i.ProjectID,
+			&i.CompletedAt,
+			&i.DeletedAt,
+			&i.ArchivedAt,
+			&i.Description,
+			&i.MergedIntoID,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSimilarTodos = `-- name: ListSimilarTodos :many
+SELECT todos.id, todos.title, todos.status, todos.created_at, todos.file_count, todos.due_at, todos.due_timezone, todos.priority, todos.position, todos.parent_id, todos.recurrence_id, todos.project_id, todos.completed_at, todos.deleted_at, todos.archived_at, todos.description, todos.merged_into_id, todos.updated_at, similarity(title, $1::text)::real AS similarity
+FROM todos
+WHERE title % $1::text
+    AND similarity(title, $1::text) >= $2::real
+    AND completed_at IS NULL
+    AND deleted_at IS NULL
+ORDER BY similarity DESC, id
+LIMIT $3
+`
+
+type ListSimilarTodosParams struct {
+	Title      string  `json:"title"`
+	Threshold  float32 `json:"threshold"`
+	MaxResults int32   `json:"maxResults"`
+}
+
+type ListSimilarTodosRow struct {
+	Todo       Todo    `json:"todo"`
+	Similarity float32 `json:"similarity"`
+}
+
+// Lists the open todos whose title is similar to the title by trigram similarity, most similar first; the '%'
+// operator uses the trigram index of the titles, so thresholds below pg_trgm.similarity_threshold (0.3) act as it
+func (q *Queries) ListSimilarTodos(ctx context.Context, arg ListSimilarTodosParams) ([]ListSimilarTodosRow, error) {
+	rows, err := q.db.Query(ctx, listSimilarTodos, arg.Title, arg.Threshold, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSimilarTodosRow{}
+	for rows.Next() {
+		var i ListSimilarTodosRow
+		if err := rows.Scan(
+			&i.Todo.ID,
+			&i.Todo.Title,
+			&i.Todo.Status,
+			&i.Todo.CreatedAt,
+			&i.Todo.FileCount,
+			&i.Todo.DueAt,
+			&i.Todo.DueTimezone,
+			&i.Todo.Priority,
+			&i.Todo.Position,
+			&i.Todo.ParentID,
+			&i.Todo.RecurrenceID,
+			&i.Todo.ProjectID,
+			&i.Todo.CompletedAt,
+			&i.Todo.DeletedAt,
+			&i.Todo.ArchivedAt,
+			&i.Todo.Description,
+			&i.Todo.MergedIntoID,
+			&i.Todo.UpdatedAt,
+			&i.Similarity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodoDescendants = `-- name: ListTodoDescendants :many
+WITH RECURSIVE descendants AS (
+    SELECT id FROM todos WHERE parent_id = $1::bigint AND deleted_at IS NULL
+    UNION ALL
+    SELECT todos.id FROM todos
+    JOIN descendants ON todos.parent_id = descendants.id
+    WHERE todos.deleted_at IS NULL
+)
+SELECT todos.id, todos.title, todos.status, todos.created_at, todos.file_count, todos.due_at, todos.due_timezone, todos.priority, todos.position, todos.parent_id, todos.recurrence_id, todos.project_id, todos.completed_at, todos.deleted_at, todos.archived_at, todos.description, todos.merged_into_id, todos.updated_at FROM todos
+JOIN descendants ON todos.id = descendants.id
+ORDER BY todos.position, todos.id
+`
+
+func (q *Queries) ListTodoDescendants(ctx context.Context, todoID int64) ([]Todo, error) {
+	rows, err := q.db.Query(ctx, listTodoDescendants, todoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Todo{}
+	for rows.Next() {
+		var i Todo
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Status,
+			&i.CreatedAt,
+			&i.FileCount,
+			&i.DueAt,
+			&i.DueTimezone,
+			&i.Priority,
+			&i.Position,
+			&i.ParentID,
+			&i.RecurrenceID,
+			&i.ProjectID,
+			&i.CompletedAt,
+			&i.DeletedAt,
+			&i.ArchivedAt,
+			&i.Description,
+			&i.MergedIntoID,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTodoRollups = `-- name: ListTodoRollups :many
+SELECT
+    todos.id AS todo_id,
+    (
+        SELECT COUNT(*) FROM todos AS children
+        WHERE children.parent_id = todos.id AND children.deleted_at IS NULL
+    )::int AS children_total,
+    (
+        SELECT COUNT(*) FROM todos AS children
+        WHERE children.parent_id = todos.id AND children.deleted_at IS NULL AND children.completed_at IS NOT NULL
+    )::int AS children_completed,
+    (
+        SELECT COUNT(*) FROM todo_dependencies
+        JOIN todos AS blockers ON blockers.id = todo_dependencies.blocked_by_id
+        WHERE todo_dependencies.todo_id = todos.id AND blockers.deleted_at IS NULL AND blockers.completed_at IS NULL
+    )::int AS open_blockers,
+    (SELECT COUNT(*) FROM checklist_items WHERE checklist_items.todo_id = todos.id)::int AS checklist_total,
+    (SELECT COUNT(*) FROM checklist_items WHERE checklist_items.todo_id = todos.id AND checklist_items.checked)::int AS checklist_checked
+FROM todos
+WHERE todos.id = ANY($1::bigint[])
+`
+
+type ListTodoRollupsRow struct {
+	TodoID            int64 `json:"todoId"`
+	ChildrenTotal     int32 `json:"childrenTotal"`
+	ChildrenCompleted int32 `json:"childrenCompleted"`
+	OpenBlockers      int32 `json:"openBlockers"`
+	ChecklistTotal    int32 `json:"checklistTotal"`
+	ChecklistChecked  int32 `json:"checklistChecked"`
+}
+
+func (q *Queries) ListTodoRollups(ctx context.Context, todoIds []int64) ([]ListTodoRollupsRow, error) {
+	rows, err := q.db.Query(ctx, listTodoRollups, todoIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTodoRollupsRow{}
+	for rows.Next() {
+		var i ListTodoRollupsRow
+		if err := rows.Scan(
+			&i.TodoID,
+			&i.ChildrenTotal,
+			&i.ChildrenCompleted,
+			&i.OpenBlockers,
+			&i.ChecklistTotal,
+			&i.ChecklistChecked,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrashedTodos = `-- name: ListTrashedTodos :many
+SELECT id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at FROM todos
+WHERE deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id DESC
 LIMIT $1
 OFFSET $2
 `
 
-type ListTodosParams struct {
+type ListTrashedTodosParams struct {
 	Limit  int32 `json:"limit"`
 	Offset int32 `json:"offset"`
 }
 
-func (q *Queries) ListTodos(ctx context.Context, arg ListTodosParams) ([]Todo, error) {
-	rows, err := q.db.Query(ctx, listTodos, arg.Limit, arg.Offset)
+func (q *Queries) ListTrashedTodos(ctx context.Context, arg ListTrashedTodosParams) ([]Todo, error) {
+	rows, err := q.db.Query(ctx, listTrashedTodos, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +608,19 @@ func (q *Queries) ListTodos(ctx context.Context, arg ListTodosParams) ([]Todo, e
 			&i.Status,
 			&i.CreatedAt,
 			&i.FileCount,
+			&i.DueAt,
+			&i.DueTimezone,
+			&i.Priority,
+			&i.Position,
+			&i.ParentID,
+			&i.RecurrenceID,
+			&i.ProjectID,
+			&i.CompletedAt,
+			&i.DeletedAt,
+			&i.ArchivedAt,
+			&i.Description,
+			&i.MergedIntoID,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -96,11 +632,147 @@ func (q *Queries) ListTodos(ctx context.Context, arg ListTodosParams) ([]Todo, e
 	return items, nil
 }
 
+const lockTodoHierarchy = `-- name: LockTodoHierarchy :exec
+SELECT pg_advisory_xact_lock(hashtext('todo_hierarchy'))
+`
+
+func (q *Queries) LockTodoHierarchy(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockTodoHierarchy)
+	return err
+}
+
+const rebalanceTodoPositions = `-- name: RebalanceTodoPositions :exec
+UPDATE todos
+SET position = ranked.rank * 65536
+FROM (
+    SELECT id, row_number() OVER (ORDER BY position, id) AS rank FROM todos
+) AS ranked
+WHERE todos.id = ranked.id
+`
+
+func (q *Queries) RebalanceTodoPositions(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, rebalanceTodoPositions)
+	return err
+}
+
+const restoreTodo = `-- name: RestoreTodo :exec
+WITH RECURSIVE subtree AS (
+    SELECT id, deleted_at FROM todos WHERE id = $1::bigint
+    UNION ALL
+    SELECT todos.id, todos.deleted_at FROM todos
+    JOIN subtree ON todos.parent_id = subtree.id
+    WHERE todos.deleted_at = subtree.deleted_at
+)
+UPDATE todos
+SET deleted_at = NULL
+FROM subtree
+WHERE todos.id = subtree.id
+`
+
+// Restores the todo along with the subtasks trashed at the same time
+func (q *Queries) RestoreTodo(ctx context.Context, todoID int64) error {
+	_, err := q.db.Exec(ctx, restoreTodo, todoID)
+	return err
+}
+
+const setTodoMergedInto = `-- name: SetTodoMergedInto :one
+UPDATE todos
+SET merged_into_id = $2,
+    updated_at = now()
+WHERE id = $1
+RETURNING id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at
+`
+
+type SetTodoMergedIntoParams struct {
+	ID           int64  `json:"todoId"`
+	MergedIntoID *int64 `json:"mergedIntoId"`
+}
+
+func (q *Queries) SetTodoMergedInto(ctx context.Context, arg SetTodoMergedIntoParams) (Todo, error) {
+	row := q.db.QueryRow(ctx, setTodoMergedInto, arg.ID, arg.MergedIntoID)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const trashTodo = `-- name: TrashTodo :exec
+WITH RECURSIVE subtree AS (
+    SELECT id FROM todos WHERE id = $1::bigint
+    UNION ALL
+    SELECT todos.id FROM todos
+    JOIN subtree ON todos.parent_id = subtree.id
+    WHERE todos.deleted_at IS NULL
+)
+UPDATE todos
+SET deleted_at = now()
+FROM subtree
+WHERE todos.id = subtree.id
+`
+
+// Trashes the todo along with its subtasks which aren't trashed yet
+func (q *Queries) TrashTodo(ctx context.Context, todoID int64) error {
+	_, err := q.db.Exec(ctx, trashTodo, todoID)
+	return err
+}
+
+const unarchiveTodo = `-- name: UnarchiveTodo :one
+UPDATE todos
+SET archived_at = NULL
+WHERE id = $1
+RETURNING id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at
+`
+
+func (q *Queries) UnarchiveTodo(ctx context.Context, id int64) (Todo, error) {
+	row := q.db.QueryRow(ctx, unarchiveTodo, id)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const updateTodoFileCount = `-- name: UpdateTodoFileCount :one
 UPDATE todos
-SET file_count = file_count + $2
+SET file_count = file_count + $2,
+    updated_at = now()
 WHERE id = $1
-RETURNING id, title, status, created_at, file_count
+RETURNING id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at
 `
 
 type UpdateTodoFileCountParams struct {
@@ -117,6 +789,135 @@ func (q *Queries) UpdateTodoFileCount(ctx context.Context, arg UpdateTodoFileCou
 		&i.Status,
 		&i.CreatedAt,
 		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateTodoParent = `-- name: UpdateTodoParent :one
+UPDATE todos
+SET parent_id = $2,
+    updated_at = now()
+WHERE id = $1
+RETURNING id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at
+`
+
+type UpdateTodoParentParams struct {
+	ID       int64  `json:"todoId"`
+	ParentID *int64 `json:"parentId"`
+}
+
+func (q *Queries) UpdateTodoParent(ctx context.Context, arg UpdateTodoParentParams) (Todo, error) {
+	row := q.db.QueryRow(ctx, updateTodoParent, arg.ID, arg.ParentID)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateTodoPosition = `-- name: UpdateTodoPosition :one
+UPDATE todos
+SET position = $2
+WHERE id = $1
+RETURNING id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at
+`
+
+type UpdateTodoPositionParams struct {
+	ID       int64 `json:"todoId"`
+	Position int64 `json:"position"`
+}
+
+func (q *Queries) UpdateTodoPosition(ctx context.Context, arg UpdateTodoPositionParams) (Todo, error) {
+	row := q.db.QueryRow(ctx, updateTodoPosition, arg.ID, arg.Position)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateTodoRecurrence = `-- name: UpdateTodoRecurrence :one
+UPDATE todos
+SET recurrence_id = $2,
+    updated_at = now()
+WHERE id = $1
+RETURNING id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at
+`
+
+type UpdateTodoRecurrenceParams struct {
+	ID           int64  `json:"todoId"`
+	RecurrenceID *int64 `json:"recurrenceId"`
+}
+
+func (q *Queries) UpdateTodoRecurrence(ctx context.Context, arg UpdateTodoRecurrenceParams) (Todo, error) {
+	row := q.db.QueryRow(ctx, updateTodoRecurrence, arg.ID, arg.RecurrenceID)
+	var i Todo
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Status,
+		&i.CreatedAt,
+		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -124,19 +925,44 @@ func (q *Queries) UpdateTodoFileCount(ctx context.Context, arg UpdateTodoFileCou
 const updateTodoTitleStatus = `-- name: UpdateTodoTitleStatus :one
 UPDATE todos
 SET title = COALESCE($2, title),
-    status = COALESCE($3, status)
+    status = COALESCE($3, status),
+    due_at = CASE WHEN $4::bool THEN $5::timestamptz ELSE due_at END,
+    due_timezone = COALESCE($6, due_timezone),
+    priority = COALESCE($7, priority),
+    completed_at = CASE WHEN $8::bool THEN $9::timestamptz ELSE completed_at END,
+    archived_at = CASE WHEN $8::bool AND $9::timestamptz IS NULL THEN NULL ELSE archived_at END,
+    description = COALESCE($10, description),
+    updated_at = now()
 WHERE id = $1
-RETURNING id, title, status, created_at, file_count
+RETURNING id, title, status, created_at, file_count, due_at, due_timezone, priority, position, parent_id, recurrence_id, project_id, completed_at, deleted_at, archived_at, description, merged_into_id, updated_at
 `
 
 type UpdateTodoTitleStatusParams struct {
-	ID     int64   `json:"todoId"`
-	Title  *string `json:"title"`
-	Status *string `json:"status"`
+	ID                int64      `json:"todoId"`
+	Title             *string    `json:"title"`
+	Status            *string    `json:"status"`
+	UpdateDueAt       bool       `json:"updateDueAt"`
+	DueAt             *time.Time `json:"dueAt"`
+	DueTimezone       *string    `json:"dueTimezone"`
+	Priority          *int16     `json:"priority"`
+	UpdateCompletedAt bool       `json:"updateCompletedAt"`
+	CompletedAt       *time.Time `json:"completedAt"`
+	Description       *string    `json:"description"`
 }
 
 func (q *Queries) UpdateTodoTitleStatus(ctx context.Context, arg UpdateTodoTitleStatusParams) (Todo, error) {
-	row := q.db.QueryRow(ctx, updateTodoTitleStatus, arg.ID, arg.Title, arg.Status)
+	row := q.db.QueryRow(ctx, updateTodoTitleStatus,
+		arg.ID,
+		arg.Title,
+		arg.Status,
+		arg.UpdateDueAt,
+		arg.DueAt,
+		arg.DueTimezone,
+		arg.Priority,
+		arg.UpdateCompletedAt,
+		arg.CompletedAt,
+		arg.Description,
+	)
 	var i Todo
 	err := row.Scan(
 		&i.ID,
@@ -144,6 +970,19 @@ func (q *Queries) UpdateTodoTitleStatus(ctx context.Context, arg UpdateTodoTitle
 		&i.Status,
 		&i.CreatedAt,
 		&i.FileCount,
+		&i.DueAt,
+		&i.DueTimezone,
+		&i.Priority,
+		&i.Position,
+		&i.ParentID,
+		&i.RecurrenceID,
+		&i.ProjectID,
+		&i.CompletedAt,
+		&i.DeletedAt,
+		&i.ArchivedAt,
+		&i.Description,
+		&i.MergedIntoID,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,7 +13,9 @@ import (
 func createRandomTodo(t *testing.T) Todo {
 	title := util.RandomString(10)
 
-	todo, err := testStore.CreateTodo(context.Background(), title)
+	todo, err := testStore.CreateTodo(context.Background(), CreateTodoParams{
+		Title: title,
+	})
 	require.NoError(t, err)
 	require.NotEmpty(t, todo)
 
@@ -22,6 +25,20 @@ func createRandomTodo(t *testing.T) Todo {
 	require.Equal(t, todo.FileCount, int32(0))
 	require.Equal(t, todo.Status, "incomplete")
 	require.NotZero(t, todo.CreatedAt)
+	require.Equal(t, todo.Priority, int16(0))
+	require.Positive(t, todo.Position)
+
+	return todo
+}
+
+func createRandomSubtask(t *testing.T, parent Todo) Todo {
+	todo, err := testStore.CreateTodo(context.Background(), CreateTodoParams{
+		Title:    util.RandomString(10),
+		ParentID: &parent.ID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, todo.ParentID)
+	require.Equal(t, parent.ID, *todo.ParentID)
 
 	return todo
 }
@@ -31,6 +48,7 @@ func compareTodos(t *testing.T, todo1, todo2 Todo) {
 	require.Equal(t, todo1.Title, todo2.Title)
 	require.Equal(t, todo1.Status, todo2.Status)
 	require.Equal(t, todo1.FileCount, todo2.FileCount)
+	require.Equal(t, todo1.Priority, todo2.Priority)
 	require.WithinDuration(t, todo1.CreatedAt, todo2.CreatedAt, time.Second)
 }
 
@@ -152,3 +170,356 @@ func TestListTodos(t *testing.T) {
 		require.NotEmpty(t, todo)
 	}
 }
+
+func TestListTodosFilteredByTags(t *testing.T) {
+	tag1 := createRandomTag(t)
+	tag2 := createRandomTag(t)
+
+	// todo1 carries tag1, todo2 carries both tags, todo3 carries none
+	todo1 := createRandomTodo(t)
+	todo2 := createRandomTodo(t)
+	createRandomTodo(t)
+
+	err := testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: todo1.ID,
+		TagIds: []int64{tag1.ID},
+	})
+	require.NoError(t, err)
+	err = testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: todo2.ID,
+		TagIds: []int64{tag1.ID, tag2.ID},
+	})
+	require.NoError(t, err)
+
+	tcs := []struct {
+		name          string
+		matchAllTags  bool
+		expectedTodos []Todo
+	}{
+		{
+			name:          "MatchAny",
+			matchAllTags:  false,
+			expectedTodos: []Todo{todo1, todo2},
+		},
+		{
+			name:          "MatchAll",
+			matchAllTags:  true,
+			expectedTodos: []Todo{todo2},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			todos, err := testStore.ListTodos(context.Background(), ListTodosParams{
+				TagIds:       []int64{tag1.ID, tag2.ID},
+				MatchAllTags: tc.matchAllTags,
+				Limit:        10,
+				Offset:       0,
+			})
+			require.NoError(t, err)
+			require.Len(t, todos, len(tc.expectedTodos))
+			for i := range todos {
+				compareTodos(t, tc.expectedTodos[i], todos[i])
+			}
+		})
+	}
+}
+
+func TestUpdateTodoDueDate(t *testing.T) {
+	todo := createRandomTodo(t)
+	require.Nil(t, todo.DueAt)
+
+	dueAt := time.Now().Add(time.Hour)
+	timezone := "Europe/Berlin"
+	updated, err := testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:          todo.ID,
+		UpdateDueAt: true,
+		DueAt:       &dueAt,
+		DueTimezone: &timezone,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, updated.DueAt)
+	require.WithinDuration(t, dueAt, *updated.DueAt, time.Second)
+	require.Equal(t, timezone, *updated.DueTimezone)
+
+	// Leaving UpdateDueAt unset keeps the due date
+	title := util.RandomString(10)
+	updated, err = testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:    todo.ID,
+		Title: &title,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, updated.DueAt)
+
+	// Clearing the due date
+	updated, err = testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:          todo.ID,
+		UpdateDueAt: true,
+	})
+	require.NoError(t, err)
+	require.Nil(t, updated.DueAt)
+}
+
+func TestListTodosFilteredByOverdue(t *testing.T) {
+	overdueTodo := createRandomTodoDueAt(t, time.Now().Add(-time.Hour))
+	notOverdueTodo := createRandomTodoDueAt(t, time.Now().Add(time.Hour))
+
+	overdue := true
+	todos, err := testStore.ListTodos(context.Background(), ListTodosParams{
+		Overdue: &overdue,
+		Limit:   1000,
+	})
+	require.NoError(t, err)
+
+	ids := make([]int64, 0, len(todos))
+	for _, todo := range todos {
+		ids = append(ids, todo.ID)
+	}
+	require.Contains(t, ids, overdueTodo.ID)
+	require.NotContains(t, ids, notOverdueTodo.ID)
+}
+
+func TestListTodoDescendants(t *testing.T) {
+	root := createRandomTodo(t)
+	child1 := createRandomSubtask(t, root)
+	child2 := createRandomSubtask(t, root)
+	grandchild := createRandomSubtask(t, child1)
+
+	descendants, err := testStore.ListTodoDescendants(context.Background(), root.ID)
+	require.NoError(t, err)
+	require.Len(t, descendants, 3)
+	for i, todo := range []Todo{child1, child2, grandchild} {
+		compareTodos(t, todo, descendants[i])
+	}
+
+	descendants, err = testStore.ListTodoDescendants(context.Background(), grandchild.ID)
+	require.NoError(t, err)
+	require.Empty(t, descendants)
+}
+
+func TestListTodoRollups(t *testing.T) {
+	root := createRandomTodo(t)
+	child1 := createRandomSubtask(t, root)
+	createRandomSubtask(t, root)
+	createRandomSubtask(t, child1)
+
+	setTodoStatusTx(t, child1, "complete")
+
+	rollups, err := testStore.ListTodoRollups(context.Background(), []int64{root.ID, child1.ID})
+	require.NoError(t, err)
+	require.Len(t, rollups, 2)
+
+	for _, rollup := range rollups {
+		switch rollup.TodoID {
+		case root.ID:
+			require.Equal(t, int32(2), rollup.ChildrenTotal)
+			require.Equal(t, int32(1), rollup.ChildrenCompleted)
+		case child1.ID:
+			require.Equal(t, int32(1), rollup.ChildrenTotal)
+			require.Equal(t, int32(0), rollup.ChildrenCompleted)
+		default:
+			t.Fatalf("unexpected rollup for todo %d", rollup.TodoID)
+		}
+	}
+}
+
+func TestTrashTodo(t *testing.T) {
+	root := createRandomTodo(t)
+	child := createRandomSubtask(t, root)
+	grandchild := createRandomSubtask(t, child)
+
+	err := testStore.TrashTodo(context.Background(), root.ID)
+	require.NoError(t, err)
+
+	// Trashed todos are hidden from the normal queries
+	for _, todoID := range []int64{root.ID, child.ID, grandchild.ID} {
+		_, err := testStore.GetTodo(context.Background(), todoID)
+		require.EqualError(t, err, ErrRecordNotFound.Error())
+
+		trashedTodo, err := testStore.GetTrashedTodo(context.Background(), todoID)
+		require.NoError(t, err)
+		require.NotNil(t, trashedTodo.DeletedAt)
+	}
+
+	// The whole subtree is still reachable for the purge
+	descendants, err := testStore.ListAllTodoDescendants(context.Background(), root.ID)
+	require.NoError(t, err)
+	require.Len(t, descendants, 2)
+}
+
+func TestRestoreTodoKeepsSubtasksTrashedEarlier(t *testing.T) {
+	root := createRandomTodo(t)
+	child1 := createRandomSubtask(t, root)
+	child2 := createRandomSubtask(t, root)
+
+	require.NoError(t, testStore.TrashTodo(context.Background(), child1.ID))
+	require.NoError(t, testStore.TrashTodo(context.Background(), root.ID))
+	require.NoError(t, testStore.RestoreTodo(context.Background(), root.ID))
+
+	_, err := testStore.GetTodo(context.Background(), root.ID)
+	require.NoError(t, err)
+	_, err = testStore.GetTodo(context.Background(), child2.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetTrashedTodo(context.Background(), child1.ID)
+	require.NoError(t, err)
+}
+
+func TestListPurgeableTodos(t *testing.T) {
+	root := createRandomTodo(t)
+	child := createRandomSubtask(t, root)
+	require.NoError(t, testStore.TrashTodo(context.Background(), root.ID))
+
+	cutoff := time.Now().Add(time.Minute)
+	todos, err := testStore.ListPurgeableTodos(context.Background(), ListPurgeableTodosParams{
+		DeletedBefore: cutoff,
+		Limit:         1000,
+	})
+	require.NoError(t, err)
+
+	// The subtask is purged along with its parent
+	todoIDs := make([]int64, 0, len(todos))
+	for _, todo := range todos {
+		todoIDs = append(todoIDs, todo.ID)
+	}
+	require.Contains(t, todoIDs, root.ID)
+	require.NotContains(t, todoIDs, child.ID)
+
+	// Nothing is purgeable before the retention period
+	todos, err = testStore.ListPurgeableTodos(context.Background(), ListPurgeableTodosParams{
+		DeletedBefore: time.Now().Add(-time.Hour),
+		Limit:         1000,
+	})
+	require.NoError(t, err)
+	for _, todo := range todos {
+		require.NotEqual(t, root.ID, todo.ID)
+	}
+}
+
+func TestArchiveTodo(t *testing.T) {
+	todo := setTodoStatusTx(t, createRandomTodo(t), "complete")
+
+	archivedTodo, err := testStore.ArchiveTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.NotNil(t, archivedTodo.ArchivedAt)
+
+	// Archiving again keeps the original archive time
+	archivedAgain, err := testStore.ArchiveTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, archivedTodo.ArchivedAt, archivedAgain.ArchivedAt)
+
+	// Reopening the todo takes it out of the archive
+	reopenedTodo := setTodoStatusTx(t, archivedTodo, "incomplete")
+	require.Nil(t, reopenedTodo.ArchivedAt)
+}
+
+func TestUnarchiveTodo(t *testing.T) {
+	todo := setTodoStatusTx(t, createRandomTodo(t), "complete")
+
+	_, err := testStore.ArchiveTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+
+	unarchivedTodo, err := testStore.UnarchiveTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Nil(t, unarchivedTodo.ArchivedAt)
+	require.NotNil(t, unarchivedTodo.CompletedAt)
+}
+
+func TestArchiveCompletedTodos(t *testing.T) {
+	completedTodo := setTodoStatusTx(t, createRandomTodo(t), "complete")
+	incompleteTodo := createRandomTodo(t)
+
+	archived, err := testStore.ArchiveCompletedTodos(context.Background(), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Positive(t, archived)
+
+	todo, err := testStore.GetTodo(context.Background(), completedTodo.ID)
+	require.NoError(t, err)
+	require.NotNil(t, todo.ArchivedAt)
+
+	todo, err = testStore.GetTodo(context.Background(), incompleteTodo.ID)
+	require.NoError(t, err)
+	require.Nil(t, todo.ArchivedAt)
+
+	// Archived todos are only listed in the archive view
+	for _, archivedView := range []bool{false, true} {
+		todos, err := testStore.ListTodos(context.Background(), ListTodosParams{
+			Archived: archivedView,
+			Limit:    1000,
+		})
+		require.NoError(t, err)
+
+		todoIDs := make([]int64, 0, len(todos))
+		for _, todo := range todos {
+			todoIDs = append(todoIDs, todo.ID)
+		}
+		if archivedView {
+			require.Contains(t, todoIDs, completedTodo.ID)
+		} else {
+			require.NotContains(t, todoIDs, completedTodo.ID)
+		}
+	}
+}
+
+func TestUpdateTodoDescription(t *testing.T) {
+	description := "**" + util.RandomString(10) + "**"
+	todo, err := testStore.CreateTodo(context.Background(), CreateTodoParams{
+		Title:       util.RandomString(10),
+		Description: description,
+	})
+	require.NoError(t, err)
+	require.Equal(t, description, todo.Description)
+
+	// Other updates keep the description
+	title := util.RandomString(10)
+	updatedTodo, err := testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:    todo.ID,
+		Title: &title,
+	})
+	require.NoError(t, err)
+	require.Equal(t, description, updatedTodo.Description)
+
+	emptyDescription := ""
+	updatedTodo, err = testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:          todo.ID,
+		Description: &emptyDescription,
+	})
+	require.NoError(t, err)
+	require.Empty(t, updatedTodo.Description)
+}
+
+func TestListSimilarTodos(t *testing.T) {
+	word := util.RandomString(12)
+
+	// Trigram similarity ignores case and tolerates typos
+	same := createRandomTodoTitled(t, "Quarterly "+word+" report")
+	typo := createRandomTodoTitled(t, "quartely "+word+" reprot")
+	completed := setTodoStatusTx(t, createRandomTodoTitled(t, "Quarterly "+word+" report"), "complete")
+	createRandomTodoTitled(t, util.RandomString(20))
+
+	rows, err := testStore.ListSimilarTodos(context.Background(), ListSimilarTodosParams{
+		Title:      "QUARTERLY " + strings.ToUpper(word) + " REPORT",
+		Threshold:  0.5,
+		MaxResults: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	compareTodos(t, same, rows[0].Todo)
+	compareTodos(t, typo, rows[1].Todo)
+	require.Equal(t, float32(1), rows[0].Similarity)
+	require.Less(t, rows[1].Similarity, rows[0].Similarity)
+	require.GreaterOrEqual(t, rows[1].Similarity, float32(0.5))
+	for _, row := range rows {
+		require.NotEqual(t, completed.ID, row.Todo.ID)
+	}
+
+	// A higher threshold leaves the typo out
+	rows, err = testStore.ListSimilarTodos(context.Background(), ListSimilarTodosParams{
+		Title:      "Quarterly " + word + " report",
+		Threshold:  0.99,
+		MaxResults: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	compareTodos(t, same, rows[0].Todo)
+}
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoAssignees(t *testing.T) {
+	todo := createRandomTodo(t)
+	userID1 := util.RandomString(8)
+	userID2 := util.RandomString(8)
+
+	// Assigning a user twice keeps a single assignment
+	err := testStore.AddTodoAssignees(context.Background(), AddTodoAssigneesParams{
+		TodoID:  todo.ID,
+		UserIds: []string{userID1, userID2, userID1},
+	})
+	require.NoError(t, err)
+
+	assignees, err := testStore.ListTodoAssignees(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, assignees, 2)
+	for _, assignee := range assignees {
+		require.Equal(t, todo.ID, assignee.TodoID)
+		require.Contains(t, []string{userID1, userID2}, assignee.UserID)
+		require.NotZero(t, assignee.CreatedAt)
+	}
+
+	rows, err := testStore.RemoveTodoAssignee(context.Background(), RemoveTodoAssigneeParams{
+		TodoID: todo.ID,
+		UserID: userID1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	rows, err = testStore.RemoveTodoAssignee(context.Background(), RemoveTodoAssigneeParams{
+		TodoID: todo.ID,
+		UserID: userID1,
+	})
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	assignees, err = testStore.ListTodoAssignees(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, assignees, 1)
+	require.Equal(t, userID2, assignees[0].UserID)
+}
+
+func TestTodoWatchers(t *testing.T) {
+	todo := createRandomTodo(t)
+	userID := util.RandomString(8)
+
+	for i := 0; i < 2; i++ {
+		err := testStore.AddTodoWatcher(context.Background(), AddTodoWatcherParams{
+			TodoID: todo.ID,
+			UserID: userID,
+		})
+		require.NoError(t, err)
+	}
+
+	watchers, err := testStore.ListTodoWatchers(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, watchers, 1)
+	require.Equal(t, userID, watchers[0].UserID)
+
+	err = testStore.RemoveTodoWatcher(context.Background(), RemoveTodoWatcherParams{
+		TodoID: todo.ID,
+		UserID: userID,
+	})
+	require.NoError(t, err)
+
+	watchers, err = testStore.ListTodoWatchers(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Empty(t, watchers)
+}
+
+func TestListTodoRecipients(t *testing.T) {
+	todo := createRandomTodo(t)
+	assignee := util.RandomString(8)
+	watcher := util.RandomString(8)
+
+	err := testStore.AddTodoAssignees(context.Background(), AddTodoAssigneesParams{
+		TodoID:  todo.ID,
+		UserIds: []string{assignee},
+	})
+	require.NoError(t, err)
+
+	// Users both assigned to and watching the todo are listed once
+	for _, userID := range []string{assignee, watcher} {
+		err = testStore.AddTodoWatcher(context.Background(), AddTodoWatcherParams{
+			TodoID: todo.ID,
+			UserID: userID,
+		})
+		require.NoError(t, err)
+	}
+
+	recipients, err := testStore.ListTodoRecipients(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{assignee, watcher}, recipients)
+}
+
+func TestListTodosByAssigneeAndWatcher(t *testing.T) {
+	assignedTodo := createRandomTodo(t)
+	watchedTodo := createRandomTodo(t)
+	createRandomTodo(t)
+	userID := util.RandomString(8)
+
+	err := testStore.AddTodoAssignees(context.Background(), AddTodoAssigneesParams{
+		TodoID:  assignedTodo.ID,
+		UserIds: []string{userID},
+	})
+	require.NoError(t, err)
+
+	err = testStore.AddTodoWatcher(context.Background(), AddTodoWatcherParams{
+		TodoID: watchedTodo.ID,
+		UserID: userID,
+	})
+	require.NoError(t, err)
+
+	todos, err := testStore.ListTodos(context.Background(), ListTodosParams{
+		Assignee: &userID,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	require.Equal(t, assignedTodo.ID, todos[0].ID)
+
+	todos, err = testStore.ListTodos(context.Background(), ListTodosParams{
+		Watcher: &userID,
+		Limit:   10,
+	})
+	require.NoError(t, err)
+	require.Len(t, todos, 1)
+	require.Equal(t, watchedTodo.ID, todos[0].ID)
+}
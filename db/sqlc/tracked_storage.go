@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	storage "github.com/jaingounchained/todo/storage"
+)
+
+type trackedFile struct {
+	todoID   int64
+	fileName string
+}
+
+// trackedStorage records the todo directories and files created through it during a transaction, so that they
+// can be deleted when the transaction rolls back
+type trackedStorage struct {
+	storage.Storage
+	directories []int64
+	files       []trackedFile
+}
+
+func (s *trackedStorage) CreateTodoDirectory(ctx context.Context, todoID int64) error {
+	err := s.Storage.CreateTodoDirectory(ctx, todoID)
+	if err == nil {
+		s.directories = append(s.directories, todoID)
+	}
+
+	return err
+}
+
+func (s *trackedStorage) CopyFile(ctx context.Context, srcTodoID int64, srcFileName string, dstTodoID int64, dstFileName string) error {
+	err := s.Storage.CopyFile(ctx, srcTodoID, srcFileName, dstTodoID, dstFileName)
+	if err == nil {
+		s.files = append(s.files, trackedFile{todoID: dstTodoID, fileName: dstFileName})
+	}
+
+	return err
+}
+
+func (s *trackedStorage) CopyTemplateFile(ctx context.Context, templateID int64, srcFileName string, dstTodoID int64, dstFileName string) error {
+	err := s.Storage.CopyTemplateFile(ctx, templateID, srcFileName, dstTodoID, dstFileName)
+	if err == nil {
+		s.files = append(s.files, trackedFile{todoID: dstTodoID, fileName: dstFileName})
+	}
+
+	return err
+}
+
+// undo deletes the files copied into todos which existed before the transaction, then the created directories
+// along with their files; it carries on past failures, returning them all
+func (s *trackedStorage) undo(ctx context.Context) error {
+	// The request may have been cancelled, which is one of the reasons for the rollback
+	ctx = context.WithoutCancel(ctx)
+
+	created := make(map[int64]bool, len(s.directories))
+	for _, todoID := range s.directories {
+		created[todoID] = true
+	}
+
+	var errs []error
+	for _, file := range s.files {
+		if !created[file.todoID] {
+			errs = append(errs, s.Storage.DeleteFile(ctx, file.todoID, file.fileName))
+		}
+	}
+
+	for _, todoID := range s.directories {
+		errs = append(errs, s.Storage.DeleteTodoDirectory(ctx, todoID))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+)
+
+// Input parameters for the add todo dependency transaction
+type AddTodoDependencyTxParams struct {
+	TodoID      int64
+	BlockedByID int64
+}
+
+// Result of add todo dependency transaction
+type AddTodoDependencyTxResult struct {
+	Blockers []Todo
+}
+
+// AddTodoDependencyTx declares that the todo is blocked by another todo, refusing links which would form a cycle
+func (store *SQLStore) AddTodoDependencyTx(ctx context.Context, arg AddTodoDependencyTxParams) (AddTodoDependencyTxResult, error) {
+	var result AddTodoDependencyTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// Serialize dependency changes so that concurrent links can't form a cycle
+		err := q.LockTodoDependencies(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Make sure the blocker exists
+		_, err = q.GetTodo(ctx, arg.BlockedByID)
+		if err != nil {
+			return err
+		}
+
+		// The blocker can't be the todo itself or blocked by the todo, directly or not
+		if arg.TodoID == arg.BlockedByID {
+			return ErrTodoDependencyCycle
+		}
+		cycle, err := q.IsTodoBlockedBy(ctx, IsTodoBlockedByParams{
+			TodoID:    arg.BlockedByID,
+			BlockerID: arg.TodoID,
+		})
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return ErrTodoDependencyCycle
+		}
+
+		err = q.AddTodoDependency(ctx, AddTodoDependencyParams{
+			TodoID:      arg.TodoID,
+			BlockedByID: arg.BlockedByID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Blockers, err = q.ListTodoBlockers(ctx, arg.TodoID)
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func addTodoDependencyTx(t *testing.T, todo, blocker Todo) AddTodoDependencyTxResult {
+	result, err := testStore.AddTodoDependencyTx(context.Background(), AddTodoDependencyTxParams{
+		TodoID:      todo.ID,
+		BlockedByID: blocker.ID,
+	})
+	require.NoError(t, err)
+
+	return result
+}
+
+func TestAddTodoDependencyTxOK(t *testing.T) {
+	todo := createRandomTodo(t)
+	blocker := createRandomTodo(t)
+
+	// Adding the same blocker twice is a no-op
+	addTodoDependencyTx(t, todo, blocker)
+	result := addTodoDependencyTx(t, todo, blocker)
+	require.Len(t, result.Blockers, 1)
+	compareTodos(t, blocker, result.Blockers[0])
+
+	blocked, err := testStore.ListTodosBlockedBy(context.Background(), blocker.ID)
+	require.NoError(t, err)
+	require.Len(t, blocked, 1)
+	compareTodos(t, todo, blocked[0])
+}
+
+func TestAddTodoDependencyTxUnknownBlocker(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	_, err := testStore.AddTodoDependencyTx(context.Background(), AddTodoDependencyTxParams{
+		TodoID:      todo.ID,
+		BlockedByID: -1,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestAddTodoDependencyTxCycle(t *testing.T) {
+	todo1 := createRandomTodo(t)
+	todo2 := createRandomTodo(t)
+	todo3 := createRandomTodo(t)
+
+	// todo1 is blocked by todo2, which is blocked by todo3
+	addTodoDependencyTx(t, todo1, todo2)
+	addTodoDependencyTx(t, todo2, todo3)
+
+	for _, arg := range []AddTodoDependencyTxParams{
+		{TodoID: todo1.ID, BlockedByID: todo1.ID},
+		{TodoID: todo2.ID, BlockedByID: todo1.ID},
+		{TodoID: todo3.ID, BlockedByID: todo1.ID},
+	} {
+		_, err := testStore.AddTodoDependencyTx(context.Background(), arg)
+		require.ErrorIs(t, err, ErrTodoDependencyCycle)
+	}
+}
@@ -0,0 +1,231 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jaingounchained/todo/markdown"
+	storage "github.com/jaingounchained/todo/storage"
+)
+
+// Input parameters for the clone todo transaction
+type CloneTodoTxParams struct {
+	Todo               Todo
+	Title              string
+	IncludeAttachments bool
+	IncludeComments    bool
+	IncludeSubtasks    bool
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// Result of clone todo transaction
+type CloneTodoTxResult struct {
+	Todo     Todo
+	Subtasks []Todo
+}
+
+// CloneTodoTx creates a copy of the todo, placed last among its siblings like any new todo, along with its tags,
+// reminders and checklist, and optionally its attachments, comments and subtasks; the copies start in the first
+// state of their workflow with an unchecked checklist, and the attachment references of their description and
+// comments point to the copied attachments, or get stripped when the attachments aren't copied; the directories of
+// the copies are deleted again if the transaction fails
+func (store *SQLStore) CloneTodoTx(ctx context.Context, arg CloneTodoTxParams) (CloneTodoTxResult, error) {
+	var result CloneTodoTxResult
+
+	err := store.execStorageTx(ctx, &arg.Storage, func(q *Queries) error {
+		var err error
+
+		result.Todo, err = cloneTodo(ctx, q, arg, arg.Todo, arg.Title, arg.Todo.ParentID)
+		if err != nil {
+			return err
+		}
+
+		result.Subtasks = []Todo{}
+		if !arg.IncludeSubtasks {
+			return nil
+		}
+
+		descendants, err := q.ListTodoDescendants(ctx, arg.Todo.ID)
+		if err != nil {
+			return err
+		}
+
+		subtasks := make(map[int64][]Todo, len(descendants))
+		for _, descendant := range descendants {
+			subtasks[*descendant.ParentID] = append(subtasks[*descendant.ParentID], descendant)
+		}
+
+		// Parents are cloned before their subtasks, which get attached to the clone of their parent
+		clonedIDs := map[int64]int64{arg.Todo.ID: result.Todo.ID}
+		parents := []Todo{arg.Todo}
+		for i := 0; i < len(parents); i++ {
+			parentID := clonedIDs[parents[i].ID]
+
+			for _, subtask := range subtasks[parents[i].ID] {
+				clone, err := cloneTodo(ctx, q, arg, subtask, subtask.Title, &parentID)
+				if err != nil {
+					return err
+				}
+
+				clonedIDs[subtask.ID] = clone.ID
+				parents = append(parents, subtask)
+				result.Subtasks = append(result.Subtasks, clone)
+			}
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// cloneTodo creates a copy of a single todo under the parent as specified by the clone parameters
+func cloneTodo(ctx context.Context, q *Queries, arg CloneTodoTxParams, todo Todo, title string, parentID *int64) (Todo, error) {
+	clone, err := createTodoWithDirectory(ctx, q, CreateTodoTxParams{
+		TodoTitle:   title,
+		DueAt:       todo.DueAt,
+		DueTimezone: todo.DueTimezone,
+		Priority:    todo.Priority,
+		ParentID:    parentID,
+		ProjectID:   todo.ProjectID,
+		Description: todo.Description,
+		Storage:     arg.Storage,
+	})
+	if err != nil {
+		return clone, err
+	}
+
+	err = q.CopyTodoTags(ctx, CopyTodoTagsParams{
+		TargetTodoID: clone.ID,
+		SourceTodoID: todo.ID,
+	})
+	if err != nil {
+		return clone, err
+	}
+
+	err = q.CopyTodoReminders(ctx, CopyTodoRemindersParams{
+		TargetTodoID: clone.ID,
+		SourceTodoID: todo.ID,
+	})
+	if err != nil {
+		return clone, err
+	}
+
+	err = q.CopyChecklistItems(ctx, CopyChecklistItemsParams{
+		TargetTodoID: clone.ID,
+		SourceTodoID: todo.ID,
+	})
+	if err != nil {
+		return clone, err
+	}
+
+	var attachmentIDs map[int64]int64
+	if arg.IncludeAttachments {
+		clone, attachmentIDs, err = copyAttachments(ctx, q, todo.ID, clone.ID, arg.Storage)
+		if err != nil {
+			return clone, err
+		}
+	}
+
+	droppedIDs, err := uncopiedAttachmentIDs(ctx, q, todo.ID, attachmentIDs)
+	if err != nil {
+		return clone, err
+	}
+
+	if markdown.HasAttachmentReferences(clone.Description) {
+		description := rewriteAttachmentReferences(clone.Description, attachmentIDs, droppedIDs)
+		if description != clone.Description {
+			clone, err = q.UpdateTodoTitleStatus(ctx, UpdateTodoTitleStatusParams{
+				ID:          clone.ID,
+				Description: &description,
+			})
+			if err != nil {
+				return clone, err
+			}
+		}
+	}
+
+	if arg.IncludeComments {
+		err = copyComments(ctx, q, todo.ID, clone.ID, attachmentIDs, droppedIDs)
+		if err != nil {
+			return clone, err
+		}
+	}
+
+	return clone, nil
+}
+
+// uncopiedAttachmentIDs returns the IDs of the attachments of the todo which weren't copied, given the IDs of the
+// copies by the IDs of the copied attachments
+func uncopiedAttachmentIDs(ctx context.Context, q *Queries, todoID int64, attachmentIDs map[int64]int64) (map[int64]bool, error) {
+	attachments, err := listAllAttachmentsOfTodo(ctx, q, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	droppedIDs := make(map[int64]bool, len(attachments))
+	for _, attachment := range attachments {
+		if _, ok := attachmentIDs[attachment.ID]; !ok {
+			droppedIDs[attachment.ID] = true
+		}
+	}
+
+	return droppedIDs, nil
+}
+
+// rewriteAttachmentReferences points the references to the copied attachments to their copies and strips the
+// references to the dropped ones, which don't belong to the copy; references to other attachments are kept
+func rewriteAttachmentReferences(source string, attachmentIDs map[int64]int64, droppedIDs map[int64]bool) string {
+	return markdown.RewriteAttachmentReferences(markdown.StripAttachmentReferences(source, droppedIDs), attachmentIDs)
+}
+
+// copyComments copies the comments of a todo to another one, rewriting their attachment references like the
+// description of the copy
+func copyComments(ctx context.Context, q *Queries, srcTodoID, dstTodoID int64, attachmentIDs map[int64]int64, droppedIDs map[int64]bool) error {
+	comments, err := q.ListAllComments(ctx, srcTodoID)
+	if err != nil {
+		return err
+	}
+
+	commentIDs := make([]int64, 0, len(comments))
+	for _, comment := range comments {
+		commentIDs = append(commentIDs, comment.ID)
+	}
+
+	commentAttachments, err := q.ListCommentAttachments(ctx, commentIDs)
+	if err != nil {
+		return err
+	}
+
+	referencedIDs := make(map[int64][]int64, len(comments))
+	for _, commentAttachment := range commentAttachments {
+		if copiedID, ok := attachmentIDs[commentAttachment.AttachmentID]; ok {
+			referencedIDs[commentAttachment.CommentID] = append(referencedIDs[commentAttachment.CommentID], copiedID)
+		}
+	}
+
+	for _, comment := range comments {
+		copied, err := q.CreateComment(ctx, CreateCommentParams{
+			TodoID: dstTodoID,
+			Body:   rewriteAttachmentReferences(comment.Body, attachmentIDs, droppedIDs),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(referencedIDs[comment.ID]) == 0 {
+			continue
+		}
+
+		err = q.AddCommentAttachments(ctx, AddCommentAttachmentsParams{
+			CommentID:     copied.ID,
+			AttachmentIds: referencedIDs[comment.ID],
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneTodoTxOK(t *testing.T) {
+	// Setup
+	// Insert a todo with a tag, a checked checklist item, an attachment referenced by its description and
+	// a comment, and two levels of subtasks
+	todo := createRandomTodo(t)
+	tag := createRandomTag(t)
+	err := testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: todo.ID,
+		TagIds: []int64{tag.ID},
+	})
+	require.NoError(t, err)
+
+	item := createRandomChecklistItemForTodo(t, todo)
+	checked := true
+	_, err = testStore.UpdateChecklistItem(context.Background(), UpdateChecklistItemParams{
+		ID:      item.ID,
+		Checked: &checked,
+	})
+	require.NoError(t, err)
+
+	attachment := createRandomAttachmentForTodo(t, todo)
+	description := fmt.Sprintf("![diagram](attachment:%d)", attachment.ID)
+	todo, err = testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:          todo.ID,
+		Description: &description,
+	})
+	require.NoError(t, err)
+
+	comment := createRandomCommentForTodo(t, todo, attachment.ID)
+	subtask := createRandomSubtask(t, todo)
+	nestedSubtask := createRandomSubtask(t, subtask)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().CreateTodoDirectory(gomock.Any(), gomock.Any()).Times(3)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(todo.ID), gomock.Eq(attachment.StorageFilename), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	title := util.RandomString(10)
+	result, err := testStore.CloneTodoTx(context.Background(), CloneTodoTxParams{
+		Todo:               todo,
+		Title:              title,
+		IncludeAttachments: true,
+		IncludeComments:    true,
+		IncludeSubtasks:    true,
+		Storage:            testMockStorage,
+	})
+	require.NoError(t, err)
+
+	clone, err := testStore.GetTodo(context.Background(), result.Todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, result.Todo, clone)
+	require.NotEqual(t, todo.ID, clone.ID)
+	require.Equal(t, title, clone.Title)
+	require.Equal(t, todo.Priority, clone.Priority)
+	require.Equal(t, int32(1), clone.FileCount)
+
+	tags, err := testStore.ListTagsOfTodo(context.Background(), clone.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	require.Equal(t, tag.ID, tags[0].ID)
+
+	// The checklist is copied unchecked
+	items, err := testStore.ListChecklistItems(context.Background(), clone.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, item.Text, items[0].Text)
+	require.False(t, items[0].Checked)
+
+	// The references point to the copied attachment
+	attachments, err := testStore.ListAttachmentOfTodo(context.Background(), clone.ID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, attachment.OriginalFilename, attachments[0].OriginalFilename)
+	require.NotEqual(t, attachment.StorageFilename, attachments[0].StorageFilename)
+	require.Equal(t, fmt.Sprintf("![diagram](attachment:%d)", attachments[0].ID), clone.Description)
+
+	comments, err := testStore.ListAllComments(context.Background(), clone.ID)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	require.Equal(t, comment.Comment.Body, comments[0].Body)
+
+	commentAttachments, err := testStore.ListCommentAttachments(context.Background(), []int64{comments[0].ID})
+	require.NoError(t, err)
+	require.Len(t, commentAttachments, 1)
+	require.Equal(t, attachments[0].ID, commentAttachments[0].AttachmentID)
+
+	// The subtasks keep their hierarchy under the clone
+	require.Len(t, result.Subtasks, 2)
+	require.Equal(t, subtask.Title, result.Subtasks[0].Title)
+	require.Equal(t, clone.ID, *result.Subtasks[0].ParentID)
+	require.Equal(t, nestedSubtask.Title, result.Subtasks[1].Title)
+	require.Equal(t, result.Subtasks[0].ID, *result.Subtasks[1].ParentID)
+
+	// The todo is left unchanged
+	attachments, err = testStore.ListAttachmentOfTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, []Attachment{attachment}, attachments)
+}
+
+func TestCloneTodoTxWithoutOptions(t *testing.T) {
+	todo := createRandomTodo(t)
+	createRandomAttachmentForTodo(t, todo)
+	createRandomCommentForTodo(t, todo)
+	createRandomSubtask(t, todo)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().CreateTodoDirectory(gomock.Any(), gomock.Any()).Times(1)
+	testMockStorage.EXPECT().CopyFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := testStore.CloneTodoTx(context.Background(), CloneTodoTxParams{
+		Todo:    todo,
+		Title:   todo.Title,
+		Storage: testMockStorage,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Subtasks)
+	require.Zero(t, result.Todo.FileCount)
+
+	comments, err := testStore.ListAllComments(context.Background(), result.Todo.ID)
+	require.NoError(t, err)
+	require.Empty(t, comments)
+
+	descendants, err := testStore.ListTodoDescendants(context.Background(), result.Todo.ID)
+	require.NoError(t, err)
+	require.Empty(t, descendants)
+}
+
+func TestCloneTodoTxWithoutAttachmentsStripsReferences(t *testing.T) {
+	// Setup
+	// Insert a todo whose description and comment reference its attachment, and an attachment of another todo
+	todo := createRandomTodo(t)
+	attachment := createRandomAttachmentForTodo(t, todo)
+	other := createRandomAttachmentForTodo(t, createRandomTodo(t))
+
+	description := fmt.Sprintf("![diagram](attachment:%d) and [spec](attachment:%d)", attachment.ID, other.ID)
+	todo, err := testStore.UpdateTodoTitleStatus(context.Background(), UpdateTodoTitleStatusParams{
+		ID:          todo.ID,
+		Description: &description,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CreateCommentTx(context.Background(), CreateCommentTxParams{
+		TodoID:        todo.ID,
+		Body:          fmt.Sprintf("See [the diagram](attachment:%d)", attachment.ID),
+		AttachmentIDs: []int64{attachment.ID},
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().CreateTodoDirectory(gomock.Any(), gomock.Any()).Times(1)
+	testMockStorage.EXPECT().CopyFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := testStore.CloneTodoTx(context.Background(), CloneTodoTxParams{
+		Todo:            todo,
+		Title:           todo.Title,
+		IncludeComments: true,
+		Storage:         testMockStorage,
+	})
+	require.NoError(t, err)
+
+	// Only the references to the attachment of another todo are kept
+	require.Equal(t, fmt.Sprintf(" and [spec](attachment:%d)", other.ID), result.Todo.Description)
+
+	comments, err := testStore.ListAllComments(context.Background(), result.Todo.ID)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	require.Equal(t, "See the diagram", comments[0].Body)
+
+	commentAttachments, err := testStore.ListCommentAttachments(context.Background(), []int64{comments[0].ID})
+	require.NoError(t, err)
+	require.Empty(t, commentAttachments)
+}
+
+func TestCloneTodoTxStorageFailure(t *testing.T) {
+	todo := createRandomTodo(t)
+	attachment := createRandomAttachmentForTodo(t, todo)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	expectedError := errors.New("storage failure")
+	var capturedTodoID int64
+	testMockStorage.EXPECT().
+		CreateTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			capturedTodoID = todoID
+		}).
+		Times(1)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(todo.ID), gomock.Eq(attachment.StorageFilename), gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(expectedError)
+	var deletedTodoID int64
+	testMockStorage.EXPECT().
+		DeleteTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			deletedTodoID = todoID
+		}).
+		Times(1)
+
+	_, err := testStore.CloneTodoTx(context.Background(), CloneTodoTxParams{
+		Todo:               todo,
+		Title:              todo.Title,
+		IncludeAttachments: true,
+		Storage:            testMockStorage,
+	})
+	require.Error(t, err)
+	require.EqualError(t, err, expectedError.Error())
+
+	// The clone is rolled back along with its directory
+	_, err = testStore.GetTodo(context.Background(), capturedTodoID)
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+	require.Equal(t, capturedTodoID, deletedTodoID)
+}
+
+func TestCloneTodoTxSubtaskFailureDeletesDirectories(t *testing.T) {
+	todo := createRandomTodo(t)
+	attachment := createRandomAttachmentForTodo(t, todo)
+	subtask := createRandomSubtask(t, todo)
+	subtaskAttachment := createRandomAttachmentForTodo(t, subtask)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	expectedError := errors.New("storage failure")
+	createdTodoIDs := make([]int64, 0)
+	deletedTodoIDs := make([]int64, 0)
+	testMockStorage.EXPECT().
+		CreateTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			createdTodoIDs = append(createdTodoIDs, todoID)
+		}).
+		Times(2)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(todo.ID), gomock.Eq(attachment.StorageFilename), gomock.Any(), gomock.Any()).
+		Times(1)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(subtask.ID), gomock.Eq(subtaskAttachment.StorageFilename), gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(expectedError)
+	testMockStorage.EXPECT().
+		DeleteTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			deletedTodoIDs = append(deletedTodoIDs, todoID)
+		}).
+		Times(2)
+
+	_, err := testStore.CloneTodoTx(context.Background(), CloneTodoTxParams{
+		Todo:               todo,
+		Title:              todo.Title,
+		IncludeAttachments: true,
+		IncludeSubtasks:    true,
+		Storage:            testMockStorage,
+	})
+	require.ErrorIs(t, err, expectedError)
+
+	// The directories of the clone and of its subtask, created before the failure, are deleted
+	require.ElementsMatch(t, createdTodoIDs, deletedTodoIDs)
+	for _, todoID := range createdTodoIDs {
+		_, err = testStore.GetTodo(context.Background(), todoID)
+		require.ErrorIs(t, err, ErrRecordNotFound)
+	}
+}
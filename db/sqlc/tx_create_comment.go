@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+)
+
+// Input parameters for the create comment transaction
+type CreateCommentTxParams struct {
+	TodoID int64
+	Body   string
+	// Attachments of the todo referenced by the comment
+	AttachmentIDs []int64
+}
+
+// Result of create comment transaction
+type CreateCommentTxResult struct {
+	Comment       Comment
+	AttachmentIDs []int64
+}
+
+// CreateCommentTx creates a comment on the todo linking it to some of the todo's attachments
+func (store *SQLStore) CreateCommentTx(ctx context.Context, arg CreateCommentTxParams) (CreateCommentTxResult, error) {
+	var result CreateCommentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Comment, err = q.CreateComment(ctx, CreateCommentParams{
+			TodoID: arg.TodoID,
+			Body:   arg.Body,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.AttachmentIDs, err = setCommentAttachments(ctx, q, result.Comment, arg.AttachmentIDs)
+		return err
+	})
+
+	return result, err
+}
+
+// setCommentAttachments replaces the attachments linked to the comment, which must all belong to the todo
+// of the comment, and returns their IDs
+func setCommentAttachments(ctx context.Context, q *Queries, comment Comment, attachmentIDs []int64) ([]int64, error) {
+	err := q.DeleteCommentAttachments(ctx, comment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attachmentIDs) == 0 {
+		return []int64{}, nil
+	}
+
+	count, err := q.CountAttachmentsOfTodo(ctx, CountAttachmentsOfTodoParams{
+		TodoID:        comment.TodoID,
+		AttachmentIds: attachmentIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count != int64(len(attachmentIDs)) {
+		return nil, ErrUnknownCommentAttachment
+	}
+
+	err = q.AddCommentAttachments(ctx, AddCommentAttachmentsParams{
+		CommentID:     comment.ID,
+		AttachmentIds: attachmentIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return attachmentIDs, nil
+}
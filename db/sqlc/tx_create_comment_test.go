@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCommentTxWithAttachments(t *testing.T) {
+	todo := createRandomTodo(t)
+	attachment1 := createRandomAttachmentForTodo(t, todo)
+	attachment2 := createRandomAttachmentForTodo(t, todo)
+
+	result := createRandomCommentForTodo(t, todo, attachment1.ID, attachment2.ID)
+	require.ElementsMatch(t, []int64{attachment1.ID, attachment2.ID}, result.AttachmentIDs)
+
+	attachments, err := testStore.ListCommentAttachments(context.Background(), []int64{result.Comment.ID})
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+}
+
+func TestCreateCommentTxAttachmentOfOtherTodo(t *testing.T) {
+	todo := createRandomTodo(t)
+	otherTodo := createRandomTodo(t)
+	attachment := createRandomAttachmentForTodo(t, otherTodo)
+
+	_, err := testStore.CreateCommentTx(context.Background(), CreateCommentTxParams{
+		TodoID:        todo.ID,
+		Body:          "see attached",
+		AttachmentIDs: []int64{attachment.ID},
+	})
+	require.ErrorIs(t, err, ErrUnknownCommentAttachment)
+
+	// The comment isn't created either
+	comments, err := testStore.ListComments(context.Background(), ListCommentsParams{
+		TodoID: todo.ID,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, comments)
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+
+	storage "github.com/jaingounchained/todo/storage"
+)
+
+// Input parameters for the create template transaction
+type CreateTemplateTxParams struct {
+	CreateTemplateParams
+	TagIDs []int64
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// Result of create template transaction
+type CreateTemplateTxResult struct {
+	Template Template
+	Tags     []Tag
+}
+
+// CreateTemplateTx creates the template along with its tags and the directory of its attachments
+func (store *SQLStore) CreateTemplateTx(ctx context.Context, arg CreateTemplateTxParams) (CreateTemplateTxResult, error) {
+	var result CreateTemplateTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Template, err = q.CreateTemplate(ctx, arg.CreateTemplateParams)
+		if err != nil {
+			return err
+		}
+
+		err = q.AddTagsToTemplate(ctx, AddTagsToTemplateParams{
+			TemplateID: result.Template.ID,
+			TagIds:     arg.TagIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Tags, err = q.ListTagsOfTemplate(ctx, result.Template.ID)
+		if err != nil {
+			return err
+		}
+
+		return arg.Storage.CreateTemplateDirectory(ctx, result.Template.ID)
+	})
+
+	return result, err
+}
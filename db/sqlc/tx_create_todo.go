@@ -2,13 +2,20 @@ package db
 
 import (
 	"context"
+	"time"
 
 	storage "github.com/jaingounchained/todo/storage"
 )
 
 // Input parameters for the upload attachment transaction
 type CreateTodoTxParams struct {
-	TodoTitle string
+	TodoTitle   string
+	DueAt       *time.Time
+	DueTimezone *string
+	Priority    int16
+	ParentID    *int64
+	ProjectID   *int64
+	Description string
 
 	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
 	Storage storage.Storage
@@ -26,15 +33,29 @@ func (store *SQLStore) CreateTodoTx(ctx context.Context, arg CreateTodoTxParams)
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
 
-		// Insert todo
-		result.Todo, err = q.CreateTodo(ctx, arg.TodoTitle)
-		if err != nil {
-			return err
-		}
-
-		// Create file
-		return arg.Storage.CreateTodoDirectory(ctx, result.Todo.ID)
+		result.Todo, err = createTodoWithDirectory(ctx, q, arg)
+		return err
 	})
 
 	return result, err
 }
+
+// createTodoWithDirectory inserts the todo and creates the directory of its attachments
+func createTodoWithDirectory(ctx context.Context, q *Queries, arg CreateTodoTxParams) (Todo, error) {
+	// Insert todo
+	todo, err := q.CreateTodo(ctx, CreateTodoParams{
+		Title:       arg.TodoTitle,
+		DueAt:       arg.DueAt,
+		DueTimezone: arg.DueTimezone,
+		Priority:    arg.Priority,
+		ParentID:    arg.ParentID,
+		ProjectID:   arg.ProjectID,
+		Description: arg.Description,
+	})
+	if err != nil {
+		return todo, err
+	}
+
+	// Create file
+	return todo, arg.Storage.CreateTodoDirectory(ctx, todo.ID)
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+)
+
+// Input parameters for the create workflow transaction; the first state is the initial status of new todos.
+// WorkflowID and Position of the states and transitions are ignored
+type CreateWorkflowTxParams struct {
+	Name        string
+	States      []WorkflowState
+	Transitions []WorkflowTransition
+}
+
+// Result of create workflow transaction
+type CreateWorkflowTxResult struct {
+	Workflow    Workflow
+	States      []WorkflowState
+	Transitions []WorkflowTransition
+}
+
+// CreateWorkflowTx creates a workflow along with its states and allowed transitions
+func (store *SQLStore) CreateWorkflowTx(ctx context.Context, arg CreateWorkflowTxParams) (CreateWorkflowTxResult, error) {
+	var result CreateWorkflowTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Workflow, err = q.CreateWorkflow(ctx, arg.Name)
+		if err != nil {
+			return err
+		}
+
+		result.States, result.Transitions, err = createWorkflowDefinition(ctx, q, result.Workflow.ID, arg.States, arg.Transitions)
+		return err
+	})
+
+	return result, err
+}
+
+// createWorkflowDefinition inserts the states, in order, and the transitions of a workflow
+func createWorkflowDefinition(ctx context.Context, q *Queries, workflowID int64, states []WorkflowState, transitions []WorkflowTransition) ([]WorkflowState, []WorkflowTransition, error) {
+	stateParams := CreateWorkflowStatesParams{
+		WorkflowID: workflowID,
+		Names:      make([]string, 0, len(states)),
+		Terminals:  make([]bool, 0, len(states)),
+	}
+	for _, state := range states {
+		stateParams.Names = append(stateParams.Names, state.Name)
+		stateParams.Terminals = append(stateParams.Terminals, state.Terminal)
+	}
+
+	err := q.CreateWorkflowStates(ctx, stateParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transitionParams := CreateWorkflowTransitionsParams{
+		WorkflowID: workflowID,
+		FromStates: make([]string, 0, len(transitions)),
+		ToStates:   make([]string, 0, len(transitions)),
+	}
+	for _, transition := range transitions {
+		transitionParams.FromStates = append(transitionParams.FromStates, transition.FromState)
+		transitionParams.ToStates = append(transitionParams.ToStates, transition.ToState)
+	}
+
+	err = q.CreateWorkflowTransitions(ctx, transitionParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdStates, err := q.ListWorkflowStates(ctx, workflowID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdTransitions, err := q.ListWorkflowTransitions(ctx, workflowID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return createdStates, createdTransitions, nil
+}
@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+// createRandomWorkflow creates a backlog -> in-progress <-> review -> done workflow
+func createRandomWorkflow(t *testing.T) Workflow {
+	arg := CreateWorkflowTxParams{
+		Name: util.RandomString(10),
+		States: []WorkflowState{
+			{Name: "backlog"},
+			{Name: "in-progress"},
+			{Name: "review"},
+			{Name: "done", Terminal: true},
+		},
+		Transitions: []WorkflowTransition{
+			{FromState: "backlog", ToState: "in-progress"},
+			{FromState: "in-progress", ToState: "review"},
+			{FromState: "review", ToState: "in-progress"},
+			{FromState: "review", ToState: "done"},
+		},
+	}
+
+	result, err := testStore.CreateWorkflowTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, result.Workflow.Name)
+	require.False(t, result.Workflow.IsDefault)
+
+	require.Len(t, result.States, len(arg.States))
+	for i, state := range result.States {
+		require.Equal(t, result.Workflow.ID, state.WorkflowID)
+		require.Equal(t, arg.States[i].Name, state.Name)
+		require.Equal(t, arg.States[i].Terminal, state.Terminal)
+		require.Equal(t, int32(i), state.Position)
+	}
+	require.Len(t, result.Transitions, len(arg.Transitions))
+
+	return result.Workflow
+}
+
+func TestCreateWorkflowTx(t *testing.T) {
+	createRandomWorkflow(t)
+}
+
+func TestCreateWorkflowTxUnknownState(t *testing.T) {
+	_, err := testStore.CreateWorkflowTx(context.Background(), CreateWorkflowTxParams{
+		Name:        util.RandomString(10),
+		States:      []WorkflowState{{Name: "open"}},
+		Transitions: []WorkflowTransition{{FromState: "open", ToState: "closed"}},
+	})
+	require.Equal(t, ForeignKeyViolation, ErrorCode(err))
+}
+
+func TestUpdateWorkflowTx(t *testing.T) {
+	workflow := createRandomWorkflow(t)
+
+	arg := UpdateWorkflowTxParams{
+		ID:          workflow.ID,
+		Name:        util.RandomString(10),
+		States:      []WorkflowState{{Name: "open"}, {Name: "closed", Terminal: true}},
+		Transitions: []WorkflowTransition{{FromState: "open", ToState: "closed"}},
+	}
+	result, err := testStore.UpdateWorkflowTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, result.Workflow.Name)
+	require.Len(t, result.States, 2)
+	require.Equal(t, "open", result.States[0].Name)
+	require.Len(t, result.Transitions, 1)
+
+	_, err = testStore.UpdateWorkflowTx(context.Background(), UpdateWorkflowTxParams{ID: -1, Name: arg.Name})
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+}
+
+func TestGetTodoWorkflowID(t *testing.T) {
+	workflow := createRandomWorkflow(t)
+
+	workflows, err := testStore.ListWorkflows(context.Background())
+	require.NoError(t, err)
+	var defaultWorkflow Workflow
+	for _, w := range workflows {
+		if w.IsDefault {
+			defaultWorkflow = w
+		}
+	}
+	require.True(t, defaultWorkflow.IsDefault)
+
+	// Todos outside of a project or in a project without a workflow follow the default workflow
+	todo := createRandomTodo(t)
+	workflowID, err := testStore.GetTodoWorkflowID(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, defaultWorkflow.ID, workflowID)
+
+	project := createRandomProject(t, nil)
+	todo, err = testStore.CreateTodo(context.Background(), CreateTodoParams{
+		Title:     util.RandomString(10),
+		ProjectID: &project.ID,
+	})
+	require.NoError(t, err)
+	workflowID, err = testStore.GetTodoWorkflowID(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, defaultWorkflow.ID, workflowID)
+
+	_, err = testStore.UpdateProject(context.Background(), UpdateProjectParams{
+		ID:               project.ID,
+		UpdateWorkflowID: true,
+		WorkflowID:       &workflow.ID,
+	})
+	require.NoError(t, err)
+	workflowID, err = testStore.GetTodoWorkflowID(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, workflow.ID, workflowID)
+}
@@ -14,10 +14,13 @@ type DeleteAttachmentTxParams struct {
 	Storage storage.Storage
 }
 
-// DeleteAttachmentTx performs todo information update and file upload
+// DeleteAttachmentTx performs todo information update and file deletion, recording the removal as a revision
 func (store *SQLStore) DeleteAttachmentTx(ctx context.Context, arg DeleteAttachmentTxParams) error {
 	return store.execTx(ctx, func(q *Queries) error {
-		var err error
+		before, err := listAllAttachmentsOfTodo(ctx, q, arg.TodoID)
+		if err != nil {
+			return err
+		}
 
 		// Decrement file count in todo table
 		todo, err := q.UpdateTodoFileCount(ctx, UpdateTodoFileCountParams{
@@ -34,6 +37,11 @@ func (store *SQLStore) DeleteAttachmentTx(ctx context.Context, arg DeleteAttachm
 			return err
 		}
 
+		err = recordAttachmentsRevision(ctx, q, arg.TodoID, before)
+		if err != nil {
+			return err
+		}
+
 		// Delete file from storage
 		return arg.Storage.DeleteFile(ctx, todo.ID, arg.Attachment.StorageFilename)
 	})
@@ -0,0 +1,25 @@
+package db
+
+import (
+	"context"
+
+	storage "github.com/jaingounchained/todo/storage"
+)
+
+type DeleteTemplateTxParams struct {
+	TemplateID int64
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// DeleteTemplateTx deletes the template along with its attachment files; todos instantiated from it are kept
+func (store *SQLStore) DeleteTemplateTx(ctx context.Context, arg DeleteTemplateTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		if err := q.DeleteTemplate(ctx, arg.TemplateID); err != nil {
+			return err
+		}
+
+		return arg.Storage.DeleteTemplateDirectory(ctx, arg.TemplateID)
+	})
+}
@@ -0,0 +1,25 @@
+package db
+
+import (
+	"context"
+
+	storage "github.com/jaingounchained/todo/storage"
+)
+
+type DeleteTemplateAttachmentTxParams struct {
+	Attachment TemplateAttachment
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// DeleteTemplateAttachmentTx deletes the attachment metadata of the template along with the file
+func (store *SQLStore) DeleteTemplateAttachmentTx(ctx context.Context, arg DeleteTemplateAttachmentTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		if err := q.DeleteTemplateAttachment(ctx, arg.Attachment.ID); err != nil {
+			return err
+		}
+
+		return arg.Storage.DeleteTemplateFile(ctx, arg.Attachment.TemplateID, arg.Attachment.StorageFilename)
+	})
+}
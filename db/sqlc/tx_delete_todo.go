@@ -13,18 +13,30 @@ type DeleteTodoTxParams struct {
 	Storage storage.Storage
 }
 
-// DeleteAttachmentTx performs todo information update and file upload
+// DeleteTodoTx permanently deletes the todo along with its subtasks and their attachment files,
+// whether they are trashed or not; todos are deleted by the user through TrashTodo and purged with DeleteTodoTx
 func (store *SQLStore) DeleteTodoTx(ctx context.Context, arg DeleteTodoTxParams) error {
 	return store.execTx(ctx, func(q *Queries) error {
-		var err error
+		// Collect subtasks before the cascade removes them
+		descendants, err := q.ListAllTodoDescendants(ctx, arg.TodoID)
+		if err != nil {
+			return err
+		}
 
-		// Delete todo and corresponding attachment rows if present
+		// Delete todo, its subtasks and corresponding attachment rows if present
 		err = q.DeleteTodo(ctx, arg.TodoID)
 		if err != nil {
 			return err
 		}
 
-		// Delete file
+		// Delete files
+		for _, descendant := range descendants {
+			err = arg.Storage.DeleteTodoDirectory(ctx, descendant.ID)
+			if err != nil {
+				return err
+			}
+		}
+
 		return arg.Storage.DeleteTodoDirectory(ctx, arg.TodoID)
 	})
 }
@@ -75,3 +75,71 @@ func TestDeleteTodoTxStorageFailure(t *testing.T) {
 	// Check todoID called in storage
 	require.Equal(t, capturedTodoID, todo.ID)
 }
+
+func TestDeleteTodoTxWithSubtasks(t *testing.T) {
+	// Setup: Insert a todo with two levels of subtasks
+	todo := createRandomTodo(t)
+	child := createRandomSubtask(t, todo)
+	grandchild := createRandomSubtask(t, child)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	var capturedTodoIDs []int64
+	testMockStorage.EXPECT().
+		DeleteTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			capturedTodoIDs = append(capturedTodoIDs, todoID)
+		}).
+		Times(3)
+
+	err := testStore.DeleteTodoTx(context.Background(), DeleteTodoTxParams{
+		TodoID:  todo.ID,
+		Storage: testMockStorage,
+	})
+	require.NoError(t, err)
+
+	// Subtasks are gone along with the todo
+	for _, todoID := range []int64{todo.ID, child.ID, grandchild.ID} {
+		_, err := testStore.GetTodo(context.Background(), todoID)
+		require.EqualError(t, err, ErrRecordNotFound.Error())
+	}
+
+	// Storage directories of the subtasks are deleted as well
+	require.ElementsMatch(t, []int64{todo.ID, child.ID, grandchild.ID}, capturedTodoIDs)
+}
+
+func TestDeleteTodoTxTrashedSubtasks(t *testing.T) {
+	// Setup: Trash a subtask, then its parent
+	todo := createRandomTodo(t)
+	child := createRandomSubtask(t, todo)
+	require.NoError(t, testStore.TrashTodo(context.Background(), child.ID))
+	require.NoError(t, testStore.TrashTodo(context.Background(), todo.ID))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	var capturedTodoIDs []int64
+	testMockStorage.EXPECT().
+		DeleteTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			capturedTodoIDs = append(capturedTodoIDs, todoID)
+		}).
+		Times(2)
+
+	err := testStore.DeleteTodoTx(context.Background(), DeleteTodoTxParams{
+		TodoID:  todo.ID,
+		Storage: testMockStorage,
+	})
+	require.NoError(t, err)
+
+	// Trashed todos are purged for good
+	for _, todoID := range []int64{todo.ID, child.ID} {
+		_, err := testStore.GetTrashedTodo(context.Background(), todoID)
+		require.EqualError(t, err, ErrRecordNotFound.Error())
+	}
+
+	require.ElementsMatch(t, []int64{todo.ID, child.ID}, capturedTodoIDs)
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+
+	storage "github.com/jaingounchained/todo/storage"
+	"github.com/jaingounchained/todo/util"
+)
+
+// Input parameters for the instantiate template transaction; the texts are the ones of the template
+// with their placeholders substituted
+type InstantiateTemplateTxParams struct {
+	Template    Template
+	Title       string
+	Description string
+	Checklist   []string
+	Subtasks    []string
+	ProjectID   *int64
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// Result of instantiate template transaction
+type InstantiateTemplateTxResult struct {
+	Todo     Todo
+	Subtasks []Todo
+}
+
+// InstantiateTemplateTx creates a todo from the template along with its tags, checklist, subtasks and
+// copies of the template attachments; the todo and its subtasks start in the first state of their workflow, and
+// their directories are deleted again if the transaction fails
+func (store *SQLStore) InstantiateTemplateTx(ctx context.Context, arg InstantiateTemplateTxParams) (InstantiateTemplateTxResult, error) {
+	var result InstantiateTemplateTxResult
+
+	err := store.execStorageTx(ctx, &arg.Storage, func(q *Queries) error {
+		var err error
+
+		result.Todo, err = createTodoWithDirectory(ctx, q, CreateTodoTxParams{
+			TodoTitle:   arg.Title,
+			Priority:    arg.Template.Priority,
+			ProjectID:   arg.ProjectID,
+			Description: arg.Description,
+			Storage:     arg.Storage,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.CopyTemplateTags(ctx, CopyTemplateTagsParams{
+			TodoID:     result.Todo.ID,
+			TemplateID: arg.Template.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, text := range arg.Checklist {
+			_, err = q.CreateChecklistItem(ctx, CreateChecklistItemParams{
+				TodoID: result.Todo.ID,
+				Text:   text,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Subtasks = make([]Todo, 0, len(arg.Subtasks))
+		for _, title := range arg.Subtasks {
+			subtask, err := createTodoWithDirectory(ctx, q, CreateTodoTxParams{
+				TodoTitle: title,
+				ParentID:  &result.Todo.ID,
+				ProjectID: arg.ProjectID,
+				Storage:   arg.Storage,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Subtasks = append(result.Subtasks, subtask)
+		}
+
+		result.Todo, err = copyTemplateAttachments(ctx, q, arg.Template.ID, result.Todo.ID, arg.Storage)
+		return err
+	})
+
+	return result, err
+}
+
+// copyTemplateAttachments copies the attachment files and metadata of a template to a todo
+func copyTemplateAttachments(ctx context.Context, q *Queries, templateID, todoID int64, storage storage.Storage) (Todo, error) {
+	attachments, err := q.ListTemplateAttachments(ctx, templateID)
+	if err != nil {
+		return Todo{}, err
+	}
+
+	for _, attachment := range attachments {
+		uuid, err := util.GenerateUUID()
+		if err != nil {
+			return Todo{}, err
+		}
+
+		_, err = q.CreateAttachment(ctx, CreateAttachmentParams{
+			TodoID:           todoID,
+			OriginalFilename: attachment.OriginalFilename,
+			StorageFilename:  uuid,
+		})
+		if err != nil {
+			return Todo{}, err
+		}
+
+		err = storage.CopyTemplateFile(ctx, templateID, attachment.StorageFilename, todoID, uuid)
+		if err != nil {
+			return Todo{}, err
+		}
+	}
+
+	return q.UpdateTodoFileCount(ctx, UpdateTodoFileCountParams{
+		ID:        todoID,
+		FileCount: int32(len(attachments)),
+	})
+}
@@ -0,0 +1,174 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstantiateTemplateTxOK(t *testing.T) {
+	// Setup
+	// Insert a template with a tag and an attachment in DB
+	template := createRandomTemplate(t)
+	tag := createRandomTag(t)
+	err := testStore.AddTagsToTemplate(context.Background(), AddTagsToTemplateParams{
+		TemplateID: template.ID,
+		TagIds:     []int64{tag.ID},
+	})
+	require.NoError(t, err)
+
+	attachment, err := testStore.CreateTemplateAttachment(context.Background(), CreateTemplateAttachmentParams{
+		TemplateID:       template.ID,
+		OriginalFilename: util.RandomString(10),
+		StorageFilename:  util.RandomString(10),
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	capturedTodoIDs := make([]int64, 0)
+	testMockStorage.EXPECT().
+		CreateTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			capturedTodoIDs = append(capturedTodoIDs, todoID)
+		}).
+		Times(1 + len(template.Subtasks))
+	testMockStorage.EXPECT().
+		CopyTemplateFile(gomock.Any(), gomock.Eq(template.ID), gomock.Eq(attachment.StorageFilename), gomock.Any(), gomock.Any()).
+		Times(1)
+
+	arg := InstantiateTemplateTxParams{
+		Template:    template,
+		Title:       util.RandomString(10),
+		Description: util.RandomString(30),
+		Checklist:   template.Checklist,
+		Subtasks:    template.Subtasks,
+		Storage:     testMockStorage,
+	}
+	result, err := testStore.InstantiateTemplateTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	// Query the db to find the todo
+	todo, err := testStore.GetTodo(context.Background(), result.Todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, result.Todo, todo)
+	require.Equal(t, arg.Title, todo.Title)
+	require.Equal(t, arg.Description, todo.Description)
+	require.Equal(t, template.Priority, todo.Priority)
+	require.Equal(t, int32(1), todo.FileCount)
+
+	tags, err := testStore.ListTagsOfTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	require.Equal(t, tag.ID, tags[0].ID)
+
+	items, err := testStore.ListChecklistItems(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, items, len(arg.Checklist))
+	for i, item := range items {
+		require.Equal(t, arg.Checklist[i], item.Text)
+	}
+
+	require.Len(t, result.Subtasks, len(arg.Subtasks))
+	for i, subtask := range result.Subtasks {
+		require.Equal(t, arg.Subtasks[i], subtask.Title)
+		require.Equal(t, todo.ID, *subtask.ParentID)
+	}
+
+	attachments, err := testStore.ListAttachmentOfTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, attachment.OriginalFilename, attachments[0].OriginalFilename)
+
+	// Check todoIDs called in storage
+	require.Contains(t, capturedTodoIDs, todo.ID)
+}
+
+func TestInstantiateTemplateTxStorageFailure(t *testing.T) {
+	template := createRandomTemplate(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	expectedError := errors.New("storage failure")
+	var capturedTodoID int64
+	testMockStorage.EXPECT().
+		CreateTodoDirectory(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, todoID int64) error {
+			capturedTodoID = todoID
+			return expectedError
+		}).
+		Times(1)
+
+	_, err := testStore.InstantiateTemplateTx(context.Background(), InstantiateTemplateTxParams{
+		Template:  template,
+		Title:     template.Title,
+		Checklist: template.Checklist,
+		Subtasks:  template.Subtasks,
+		Storage:   testMockStorage,
+	})
+	require.Error(t, err)
+	require.EqualError(t, err, expectedError.Error())
+
+	// The todo creation is rolled back
+	_, err = testStore.GetTodo(context.Background(), capturedTodoID)
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+}
+
+func TestInstantiateTemplateTxCopyFailureDeletesDirectories(t *testing.T) {
+	template := createRandomTemplate(t)
+	_, err := testStore.CreateTemplateAttachment(context.Background(), CreateTemplateAttachmentParams{
+		TemplateID:       template.ID,
+		OriginalFilename: util.RandomString(10),
+		StorageFilename:  util.RandomString(10),
+	})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	expectedError := errors.New("storage failure")
+	createdTodoIDs := make([]int64, 0)
+	deletedTodoIDs := make([]int64, 0)
+	testMockStorage.EXPECT().
+		CreateTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			createdTodoIDs = append(createdTodoIDs, todoID)
+		}).
+		Times(1 + len(template.Subtasks))
+	testMockStorage.EXPECT().
+		CopyTemplateFile(gomock.Any(), gomock.Eq(template.ID), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(expectedError)
+	testMockStorage.EXPECT().
+		DeleteTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			deletedTodoIDs = append(deletedTodoIDs, todoID)
+		}).
+		Times(1 + len(template.Subtasks))
+
+	_, err = testStore.InstantiateTemplateTx(context.Background(), InstantiateTemplateTxParams{
+		Template:  template,
+		Title:     template.Title,
+		Checklist: template.Checklist,
+		Subtasks:  template.Subtasks,
+		Storage:   testMockStorage,
+	})
+	require.ErrorIs(t, err, expectedError)
+
+	// Every directory created before the failure is deleted along with the rolled back todos
+	require.ElementsMatch(t, createdTodoIDs, deletedTodoIDs)
+	for _, todoID := range createdTodoIDs {
+		_, err = testStore.GetTodo(context.Background(), todoID)
+		require.ErrorIs(t, err, ErrRecordNotFound)
+	}
+}
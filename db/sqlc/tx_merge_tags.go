@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+)
+
+// Input parameters for the merge tags transaction
+type MergeTagsTxParams struct {
+	SourceTagID int64
+	TargetTagID int64
+}
+
+// Result of merge tags transaction
+type MergeTagsTxResult struct {
+	Tag Tag
+}
+
+// MergeTagsTx moves every todo of the source tag to the target tag and deletes the source tag
+func (store *SQLStore) MergeTagsTx(ctx context.Context, arg MergeTagsTxParams) (MergeTagsTxResult, error) {
+	var result MergeTagsTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		// Make sure the target tag exists before moving anything
+		result.Tag, err = q.GetTag(ctx, arg.TargetTagID)
+		if err != nil {
+			return err
+		}
+
+		// Assign target tag to the todos of the source tag
+		err = q.MoveTodoTags(ctx, MoveTodoTagsParams{
+			TargetTagID: arg.TargetTagID,
+			SourceTagID: arg.SourceTagID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Delete source tag and its todo assignments
+		return q.DeleteTag(ctx, arg.SourceTagID)
+	})
+
+	return result, err
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTagsTxOK(t *testing.T) {
+	// Setup: todo1 carries source tag, todo2 carries both tags
+	source := createRandomTag(t)
+	target := createRandomTag(t)
+	todo1 := createRandomTodo(t)
+	todo2 := createRandomTodo(t)
+
+	err := testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: todo1.ID,
+		TagIds: []int64{source.ID},
+	})
+	require.NoError(t, err)
+	err = testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: todo2.ID,
+		TagIds: []int64{source.ID, target.ID},
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.MergeTagsTx(context.Background(), MergeTagsTxParams{
+		SourceTagID: source.ID,
+		TargetTagID: target.ID,
+	})
+	require.NoError(t, err)
+	compareTags(t, target, result.Tag)
+
+	// Source tag is gone
+	_, err = testStore.GetTag(context.Background(), source.ID)
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+
+	// Both todos carry only the target tag
+	for _, todo := range []Todo{todo1, todo2} {
+		tags, err := testStore.ListTagsOfTodo(context.Background(), todo.ID)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		compareTags(t, target, tags[0])
+	}
+}
+
+func TestMergeTagsTxTargetNotFound(t *testing.T) {
+	source := createRandomTag(t)
+	todo := createRandomTodo(t)
+
+	err := testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: todo.ID,
+		TagIds: []int64{source.ID},
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.MergeTagsTx(context.Background(), MergeTagsTxParams{
+		SourceTagID: source.ID,
+		TargetTagID: -1,
+	})
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+
+	// Nothing changed
+	tags, err := testStore.ListTagsOfTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	compareTags(t, source, tags[0])
+}
@@ -0,0 +1,229 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	storage "github.com/jaingounchained/todo/storage"
+)
+
+// Input parameters for the merge todo transaction
+type MergeTodoTxParams struct {
+	SourceID int64
+	TargetID int64
+	// Attachments the target may have after the merge; 0 for no limit
+	AttachmentLimit int
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// Result of merge todo transaction
+type MergeTodoTxResult struct {
+	Source Todo
+	Target Todo
+	// Failure to delete the directory of the source once merged, which only leaves unused files behind
+	CleanupErr error
+}
+
+// MergeTodoTx merges the source todo into the target one: the attachments, comments and tags of the source move
+// to the target, and the source gets closed in the first terminal state of its workflow, pointing to the target;
+// the attachment files are copied to the directory of the target. Todos which were already merged can't be merged,
+// nor merged into, and the attachments of both have to fit in the limit. The copies are deleted again if the
+// transaction fails, and the directory of the source only once it is committed, so the files always exist where
+// the attachments point
+func (store *SQLStore) MergeTodoTx(ctx context.Context, arg MergeTodoTxParams) (MergeTodoTxResult, error) {
+	var result MergeTodoTxResult
+
+	err := store.execStorageTx(ctx, &arg.Storage, func(q *Queries) error {
+		// Lock both todos, always in the same order so that concurrent merges don't deadlock, and check them
+		// once locked so that concurrent merges and uploads are accounted for
+		firstID, secondID := arg.SourceID, arg.TargetID
+		if firstID > secondID {
+			firstID, secondID = secondID, firstID
+		}
+		locked := make(map[int64]Todo, 2)
+		for _, todoID := range []int64{firstID, secondID} {
+			todo, err := q.GetTodoForUpdate(ctx, todoID)
+			if err != nil {
+				return err
+			}
+			locked[todoID] = todo
+		}
+
+		source, target := locked[arg.SourceID], locked[arg.TargetID]
+		for _, todo := range []Todo{source, target} {
+			if todo.MergedIntoID != nil {
+				return &TodoMergedError{
+					TodoID:       todo.ID,
+					MergedIntoID: *todo.MergedIntoID,
+				}
+			}
+		}
+
+		attachments := int(source.FileCount + target.FileCount)
+		if arg.AttachmentLimit > 0 && attachments > arg.AttachmentLimit {
+			return &MergeAttachmentLimitError{Attachments: attachments}
+		}
+
+		sourceAttachments, err := listAllAttachmentsOfTodo(ctx, q, arg.SourceID)
+		if err != nil {
+			return err
+		}
+
+		targetAttachments, err := listAllAttachmentsOfTodo(ctx, q, arg.TargetID)
+		if err != nil {
+			return err
+		}
+
+		moved, err := q.MoveTodoAttachments(ctx, MoveTodoAttachmentsParams{
+			TargetTodoID: arg.TargetID,
+			SourceTodoID: arg.SourceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Storage filenames are unique, so the files keep their name in the directory of the target
+		for _, attachment := range moved {
+			err = arg.Storage.CopyFile(ctx, arg.SourceID, attachment.StorageFilename, arg.TargetID, attachment.StorageFilename)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = q.UpdateTodoFileCount(ctx, UpdateTodoFileCountParams{
+			ID:        arg.TargetID,
+			FileCount: int32(len(moved)),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.UpdateTodoFileCount(ctx, UpdateTodoFileCountParams{
+			ID:        arg.SourceID,
+			FileCount: -int32(len(moved)),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(moved) > 0 {
+			err = recordAttachmentsRevision(ctx, q, arg.TargetID, targetAttachments)
+			if err != nil {
+				return err
+			}
+
+			err = recordAttachmentsRevision(ctx, q, arg.SourceID, sourceAttachments)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Comments keep referencing the attachments, which moved along with them
+		err = q.MoveTodoComments(ctx, MoveTodoCommentsParams{
+			TargetTodoID: arg.TargetID,
+			SourceTodoID: arg.SourceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.CopyTodoTags(ctx, CopyTodoTagsParams{
+			TargetTodoID: arg.TargetID,
+			SourceTodoID: arg.SourceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteTodoTags(ctx, arg.SourceID)
+		if err != nil {
+			return err
+		}
+
+		err = closeTodo(ctx, q, arg.SourceID)
+		if err != nil {
+			return err
+		}
+
+		result.Source, err = q.SetTodoMergedInto(ctx, SetTodoMergedIntoParams{
+			ID:           arg.SourceID,
+			MergedIntoID: &arg.TargetID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Target, err = q.GetTodo(ctx, arg.TargetID)
+		return err
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.CleanupErr = arg.Storage.DeleteTodoDirectory(ctx, arg.SourceID)
+	return result, nil
+}
+
+// closeTodo moves the todo to the first terminal state of its workflow, regardless of the allowed transitions,
+// recording the status change; a todo which is already completed is left as is
+func closeTodo(ctx context.Context, q *Queries, todoID int64) error {
+	before, err := q.GetTodo(ctx, todoID)
+	if err != nil {
+		return err
+	}
+
+	if before.CompletedAt != nil {
+		return nil
+	}
+
+	workflowID, err := q.GetTodoWorkflowID(ctx, todoID)
+	if err != nil {
+		return err
+	}
+
+	states, err := q.ListWorkflowStates(ctx, workflowID)
+	if err != nil {
+		return err
+	}
+
+	var terminal *WorkflowState
+	for i := range states {
+		if states[i].Terminal {
+			terminal = &states[i]
+			break
+		}
+	}
+	if terminal == nil {
+		return ErrWorkflowWithoutTerminalState
+	}
+
+	now := time.Now()
+	after, err := q.UpdateTodoTitleStatus(ctx, UpdateTodoTitleStatusParams{
+		ID:                todoID,
+		Status:            &terminal.Name,
+		UpdateCompletedAt: true,
+		CompletedAt:       &now,
+	})
+	if err != nil {
+		return err
+	}
+
+	changes, err := todoChanges(before, after)
+	if err != nil {
+		return err
+	}
+
+	err = recordTodoRevision(ctx, q, todoID, changes)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateTodoTransition(ctx, CreateTodoTransitionParams{
+		TodoID:    todoID,
+		FromState: before.Status,
+		ToState:   after.Status,
+	})
+	return err
+}
@@ -0,0 +1,341 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTodoTxOK(t *testing.T) {
+	// Setup
+	// Insert a source todo with an attachment, a comment and two tags, one of which the target carries already
+	source := createRandomTodo(t)
+	target := createRandomTodo(t)
+	sharedTag := createRandomTag(t)
+	sourceTag := createRandomTag(t)
+	err := testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: source.ID,
+		TagIds: []int64{sharedTag.ID, sourceTag.ID},
+	})
+	require.NoError(t, err)
+	err = testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: target.ID,
+		TagIds: []int64{sharedTag.ID},
+	})
+	require.NoError(t, err)
+
+	attachment := createRandomAttachmentForTodo(t, source)
+	_, err = testStore.UpdateTodoFileCount(context.Background(), UpdateTodoFileCountParams{
+		ID:        source.ID,
+		FileCount: 1,
+	})
+	require.NoError(t, err)
+	comment := createRandomCommentForTodo(t, source, attachment.ID)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(attachment.StorageFilename), gomock.Eq(target.ID), gomock.Eq(attachment.StorageFilename)).
+		Times(1)
+	testMockStorage.EXPECT().DeleteTodoDirectory(gomock.Any(), gomock.Eq(source.ID)).Times(1)
+
+	result, err := testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID: source.ID,
+		TargetID: target.ID,
+		Storage:  testMockStorage,
+	})
+	require.NoError(t, err)
+
+	// The source is closed and points to the target
+	require.Equal(t, target.ID, *result.Source.MergedIntoID)
+	require.NotNil(t, result.Source.CompletedAt)
+	require.NotEqual(t, source.Status, result.Source.Status)
+	require.Zero(t, result.Source.FileCount)
+	require.Equal(t, int32(1), result.Target.FileCount)
+
+	transitions, err := testStore.ListTodoTransitions(context.Background(), source.ID)
+	require.NoError(t, err)
+	require.Len(t, transitions, 1)
+	require.Equal(t, result.Source.Status, transitions[0].ToState)
+
+	attachments, err := testStore.ListAttachmentOfTodo(context.Background(), target.ID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, attachment.ID, attachments[0].ID)
+
+	comments, err := testStore.ListAllComments(context.Background(), target.ID)
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	require.Equal(t, comment.Comment.ID, comments[0].ID)
+
+	tags, err := testStore.ListTagsOfTodo(context.Background(), target.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+
+	tags, err = testStore.ListTagsOfTodo(context.Background(), source.ID)
+	require.NoError(t, err)
+	require.Empty(t, tags)
+}
+
+func TestMergeTodoTxStorageFailure(t *testing.T) {
+	source := createRandomTodo(t)
+	target := createRandomTodo(t)
+	attachment := createRandomAttachmentForTodo(t, source)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	expectedError := errors.New("storage failure")
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(attachment.StorageFilename), gomock.Eq(target.ID), gomock.Any()).
+		Times(1).
+		Return(expectedError)
+	testMockStorage.EXPECT().DeleteTodoDirectory(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID: source.ID,
+		TargetID: target.ID,
+		Storage:  testMockStorage,
+	})
+	require.Error(t, err)
+	require.EqualError(t, err, expectedError.Error())
+
+	// Nothing is merged
+	unmerged, err := testStore.GetTodo(context.Background(), source.ID)
+	require.NoError(t, err)
+	require.Nil(t, unmerged.MergedIntoID)
+
+	attachments, err := testStore.ListAttachmentOfTodo(context.Background(), source.ID)
+	require.NoError(t, err)
+	require.Equal(t, []Attachment{attachment}, attachments)
+}
+
+func TestMergeTodoTxStorageFailureDeletesCopiedFiles(t *testing.T) {
+	source := createRandomTodo(t)
+	target := createRandomTodo(t)
+	copied := createRandomAttachmentForTodo(t, source)
+	failed := createRandomAttachmentForTodo(t, source)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	expectedError := errors.New("storage failure")
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(copied.StorageFilename), gomock.Eq(target.ID), gomock.Eq(copied.StorageFilename)).
+		MaxTimes(1)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(failed.StorageFilename), gomock.Eq(target.ID), gomock.Eq(failed.StorageFilename)).
+		Times(1).
+		Return(expectedError)
+	// Whichever file got copied before the failure is deleted from the target, which keeps its directory
+	testMockStorage.EXPECT().
+		DeleteFile(gomock.Any(), gomock.Eq(target.ID), gomock.Eq(copied.StorageFilename)).
+		MaxTimes(1)
+	testMockStorage.EXPECT().DeleteTodoDirectory(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID: source.ID,
+		TargetID: target.ID,
+		Storage:  testMockStorage,
+	})
+	require.ErrorIs(t, err, expectedError)
+
+	attachments, err := testStore.ListAttachmentOfTodo(context.Background(), source.ID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+}
+
+func TestMergeTodoTxAlreadyMerged(t *testing.T) {
+	source := createRandomTodo(t)
+	target := createRandomTodo(t)
+	other := createRandomTodo(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().DeleteTodoDirectory(gomock.Any(), gomock.Eq(source.ID)).Times(1)
+
+	_, err := testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID: source.ID,
+		TargetID: target.ID,
+		Storage:  testMockStorage,
+	})
+	require.NoError(t, err)
+
+	// Neither the reverse merge nor a merge into the merged todo go through
+	var mergedErr *TodoMergedError
+	_, err = testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID: target.ID,
+		TargetID: source.ID,
+		Storage:  testMockStorage,
+	})
+	require.ErrorAs(t, err, &mergedErr)
+	require.Equal(t, source.ID, mergedErr.TodoID)
+	require.Equal(t, target.ID, mergedErr.MergedIntoID)
+
+	_, err = testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID: other.ID,
+		TargetID: source.ID,
+		Storage:  testMockStorage,
+	})
+	require.ErrorAs(t, err, &mergedErr)
+
+	unmerged, err := testStore.GetTodo(context.Background(), target.ID)
+	require.NoError(t, err)
+	require.Nil(t, unmerged.MergedIntoID)
+}
+
+func TestMergeTodoTxAttachmentLimitExceeded(t *testing.T) {
+	source := createRandomTodo(t)
+	target := createRandomTodo(t)
+	for _, todo := range []Todo{source, target} {
+		_, err := testStore.UpdateTodoFileCount(context.Background(), UpdateTodoFileCountParams{
+			ID:        todo.ID,
+			FileCount: 3,
+		})
+		require.NoError(t, err)
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().CopyFile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	var limitErr *MergeAttachmentLimitError
+	_, err := testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID:        source.ID,
+		TargetID:        target.ID,
+		AttachmentLimit: 5,
+		Storage:         testMockStorage,
+	})
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, 6, limitErr.Attachments)
+}
+
+func TestMergeTodoTxFailureKeepsSourceFiles(t *testing.T) {
+	// Closing the source fails once its files are copied, as its workflow has no terminal state
+	workflow, err := testStore.CreateWorkflow(context.Background(), util.RandomString(10))
+	require.NoError(t, err)
+	err = testStore.CreateWorkflowStates(context.Background(), CreateWorkflowStatesParams{
+		WorkflowID: workflow.ID,
+		Names:      []string{"incomplete"},
+		Terminals:  []bool{false},
+	})
+	require.NoError(t, err)
+	project := createRandomProject(t, &workflow.ID)
+
+	source, err := testStore.CreateTodo(context.Background(), CreateTodoParams{
+		Title:     util.RandomString(10),
+		ProjectID: &project.ID,
+	})
+	require.NoError(t, err)
+	target := createRandomTodo(t)
+	attachment := createRandomAttachmentForTodo(t, source)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(source.ID), gomock.Eq(attachment.StorageFilename), gomock.Eq(target.ID), gomock.Eq(attachment.StorageFilename)).
+		Times(1)
+	// Only the copy is deleted, the files of the source stay where its attachments point
+	testMockStorage.EXPECT().
+		DeleteFile(gomock.Any(), gomock.Eq(target.ID), gomock.Eq(attachment.StorageFilename)).
+		Times(1)
+	testMockStorage.EXPECT().DeleteTodoDirectory(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err = testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID: source.ID,
+		TargetID: target.ID,
+		Storage:  testMockStorage,
+	})
+	require.ErrorIs(t, err, ErrWorkflowWithoutTerminalState)
+
+	attachments, err := testStore.ListAttachmentOfTodo(context.Background(), source.ID)
+	require.NoError(t, err)
+	require.Equal(t, []Attachment{attachment}, attachments)
+}
+
+func TestMergeTodoTxSourceDirectoryLeftOver(t *testing.T) {
+	source := createRandomTodo(t)
+	target := createRandomTodo(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	expectedError := errors.New("storage failure")
+	testMockStorage.EXPECT().
+		DeleteTodoDirectory(gomock.Any(), gomock.Eq(source.ID)).
+		Times(1).
+		Return(expectedError)
+
+	// The merge is committed all the same
+	result, err := testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID: source.ID,
+		TargetID: target.ID,
+		Storage:  testMockStorage,
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, result.CleanupErr, expectedError)
+
+	merged, err := testStore.GetTodo(context.Background(), source.ID)
+	require.NoError(t, err)
+	require.Equal(t, target.ID, *merged.MergedIntoID)
+}
+
+func TestMergeTodoTxRecordsAllAttachments(t *testing.T) {
+	source := createRandomTodo(t)
+	target := createRandomTodo(t)
+
+	// More attachments than a page of ListAttachmentOfTodo
+	attachments := make([]Attachment, 0, 7)
+	for i := 0; i < cap(attachments); i++ {
+		attachments = append(attachments, createRandomAttachmentForTodo(t, source))
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(source.ID), gomock.Any(), gomock.Eq(target.ID), gomock.Any()).
+		Times(len(attachments))
+	testMockStorage.EXPECT().DeleteTodoDirectory(gomock.Any(), gomock.Eq(source.ID)).Times(1)
+
+	_, err := testStore.MergeTodoTx(context.Background(), MergeTodoTxParams{
+		SourceID: source.ID,
+		TargetID: target.ID,
+		Storage:  testMockStorage,
+	})
+	require.NoError(t, err)
+
+	for _, todoID := range []int64{source.ID, target.ID} {
+		revisions, err := testStore.ListTodoRevisions(context.Background(), todoID)
+		require.NoError(t, err)
+
+		var recorded []Attachment
+		for _, revision := range revisions {
+			if change, ok := revision.Changes[RevisionFieldAttachments]; ok {
+				before, after := []Attachment{}, []Attachment{}
+				require.NoError(t, json.Unmarshal(change.Before, &before))
+				require.NoError(t, json.Unmarshal(change.After, &after))
+				recorded = append(before, after...)
+			}
+		}
+		require.Len(t, recorded, len(attachments))
+	}
+}
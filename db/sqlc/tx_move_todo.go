@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+// Gap between the positions of two adjacent todos after a rebalance, must match
+// the spacing used by CreateTodo and RebalanceTodoPositions
+const TodoPositionGap = 65536
+
+// Input parameters for the move todo transaction; exactly one of BeforeID and AfterID is expected
+type MoveTodoTxParams struct {
+	TodoID   int64
+	BeforeID *int64
+	AfterID  *int64
+}
+
+// Result of move todo transaction
+type MoveTodoTxResult struct {
+	Todo Todo
+}
+
+// MoveTodoTx places the todo right before or after the anchor todo. The new position
+// is the midpoint between the anchor and its neighbour, so only the moved todo is
+// rewritten unless the gap is exhausted, in which case every position is spread out again
+func (store *SQLStore) MoveTodoTx(ctx context.Context, arg MoveTodoTxParams) (MoveTodoTxResult, error) {
+	var result MoveTodoTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// Make sure the moved todo exists
+		_, err := q.GetTodo(ctx, arg.TodoID)
+		if err != nil {
+			return err
+		}
+
+		position, err := newTodoPosition(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		result.Todo, err = q.UpdateTodoPosition(ctx, UpdateTodoPositionParams{
+			ID:       arg.TodoID,
+			Position: position,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+func newTodoPosition(ctx context.Context, q *Queries, arg MoveTodoTxParams) (int64, error) {
+	lower, upper, err := todoPositionBounds(ctx, q, arg)
+	if err != nil {
+		return 0, err
+	}
+
+	if upper-lower < 2 {
+		// No room left between the neighbours
+		if err := q.RebalanceTodoPositions(ctx); err != nil {
+			return 0, err
+		}
+
+		lower, upper, err = todoPositionBounds(ctx, q, arg)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return lower + (upper-lower)/2, nil
+}
+
+// todoPositionBounds returns the positions between which the moved todo has to be placed
+func todoPositionBounds(ctx context.Context, q *Queries, arg MoveTodoTxParams) (lower, upper int64, err error) {
+	anchorID := arg.TodoID
+	if arg.BeforeID != nil {
+		anchorID = *arg.BeforeID
+	} else if arg.AfterID != nil {
+		anchorID = *arg.AfterID
+	}
+
+	anchor, err := q.GetTodo(ctx, anchorID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if arg.BeforeID != nil {
+		upper = anchor.Position
+		lower, err = q.GetTodoPositionBefore(ctx, GetTodoPositionBeforeParams{
+			Position:  anchor.Position,
+			ID:        anchor.ID,
+			ExcludeID: arg.TodoID,
+		})
+		if errors.Is(err, ErrRecordNotFound) {
+			// Anchor is the first todo
+			return upper - 2*TodoPositionGap, upper, nil
+		}
+		return lower, upper, err
+	}
+
+	lower = anchor.Position
+	upper, err = q.GetTodoPositionAfter(ctx, GetTodoPositionAfterParams{
+		Position:  anchor.Position,
+		ID:        anchor.ID,
+		ExcludeID: arg.TodoID,
+	})
+	if errors.Is(err, ErrRecordNotFound) {
+		// Anchor is the last todo
+		return lower, lower + 2*TodoPositionGap, nil
+	}
+	return lower, upper, err
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func requireTodoOrder(t *testing.T, todos ...Todo) {
+	var previous int64
+	for i, todo := range todos {
+		got, err := testStore.GetTodo(context.Background(), todo.ID)
+		require.NoError(t, err)
+		if i > 0 {
+			require.Greater(t, got.Position, previous)
+		}
+		previous = got.Position
+	}
+}
+
+func TestCreateTodoAppendsToOrder(t *testing.T) {
+	todo1 := createRandomTodo(t)
+	todo2 := createRandomTodo(t)
+
+	require.Equal(t, todo1.Position+TodoPositionGap, todo2.Position)
+}
+
+func TestMoveTodoTxBefore(t *testing.T) {
+	todo1 := createRandomTodo(t)
+	todo2 := createRandomTodo(t)
+	todo3 := createRandomTodo(t)
+
+	result, err := testStore.MoveTodoTx(context.Background(), MoveTodoTxParams{
+		TodoID:   todo3.ID,
+		BeforeID: &todo2.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, todo3.ID, result.Todo.ID)
+
+	// Only the moved todo is rewritten
+	require.Equal(t, todo1.Position+(todo2.Position-todo1.Position)/2, result.Todo.Position)
+	requireTodoOrder(t, todo1, todo3, todo2)
+}
+
+func TestMoveTodoTxAfter(t *testing.T) {
+	todo1 := createRandomTodo(t)
+	todo2 := createRandomTodo(t)
+	todo3 := createRandomTodo(t)
+
+	_, err := testStore.MoveTodoTx(context.Background(), MoveTodoTxParams{
+		TodoID:  todo1.ID,
+		AfterID: &todo2.ID,
+	})
+	require.NoError(t, err)
+	requireTodoOrder(t, todo2, todo1, todo3)
+}
+
+func TestMoveTodoTxAfterLast(t *testing.T) {
+	todo1 := createRandomTodo(t)
+	todo2 := createRandomTodo(t)
+
+	result, err := testStore.MoveTodoTx(context.Background(), MoveTodoTxParams{
+		TodoID:  todo1.ID,
+		AfterID: &todo2.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, todo2.Position+TodoPositionGap, result.Todo.Position)
+}
+
+func TestMoveTodoTxRebalance(t *testing.T) {
+	todo1 := createRandomTodo(t)
+	todo2 := createRandomTodo(t)
+	todo3 := createRandomTodo(t)
+
+	// Exhaust the gap between todo1 and todo2
+	_, err := testStore.UpdateTodoPosition(context.Background(), UpdateTodoPositionParams{
+		ID:       todo2.ID,
+		Position: todo1.Position + 1,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.MoveTodoTx(context.Background(), MoveTodoTxParams{
+		TodoID:   todo3.ID,
+		BeforeID: &todo2.ID,
+	})
+	require.NoError(t, err)
+	require.Zero(t, result.Todo.Position%(TodoPositionGap/2))
+	requireTodoOrder(t, todo1, todo3, todo2)
+}
+
+func TestMoveTodoTxAnchorNotFound(t *testing.T) {
+	todo := createRandomTodo(t)
+	anchorID := int64(-1)
+
+	_, err := testStore.MoveTodoTx(context.Background(), MoveTodoTxParams{
+		TodoID:   todo.ID,
+		BeforeID: &anchorID,
+	})
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+
+	got, err := testStore.GetTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, todo.Position, got.Position)
+}
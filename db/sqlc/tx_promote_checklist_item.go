@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+
+	storage "github.com/jaingounchained/todo/storage"
+)
+
+// Input parameters for the promote checklist item transaction
+type PromoteChecklistItemTxParams struct {
+	ItemID int64
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// Result of promote checklist item transaction
+type PromoteChecklistItemTxResult struct {
+	Todo Todo
+}
+
+// PromoteChecklistItemTx replaces the checklist item with a subtask of its todo titled after the item's text;
+// the subtask starts in the first state of its workflow
+func (store *SQLStore) PromoteChecklistItemTx(ctx context.Context, arg PromoteChecklistItemTxParams) (PromoteChecklistItemTxResult, error) {
+	var result PromoteChecklistItemTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		item, err := q.GetChecklistItem(ctx, arg.ItemID)
+		if err != nil {
+			return err
+		}
+
+		parent, err := q.GetTodo(ctx, item.TodoID)
+		if err != nil {
+			return err
+		}
+
+		result.Todo, err = q.CreateTodo(ctx, CreateTodoParams{
+			Title:     item.Text,
+			ParentID:  &parent.ID,
+			ProjectID: parent.ProjectID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := q.DeleteChecklistItem(ctx, item.ID); err != nil {
+			return err
+		}
+
+		return arg.Storage.CreateTodoDirectory(ctx, result.Todo.ID)
+	})
+
+	return result, err
+}
@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromoteChecklistItemTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().
+		CreateTodoDirectory(gomock.Any(), gomock.Any()).
+		Times(1)
+
+	todo := createRandomTodo(t)
+	item := createRandomChecklistItemForTodo(t, todo)
+
+	result, err := testStore.PromoteChecklistItemTx(context.Background(), PromoteChecklistItemTxParams{
+		ItemID:  item.ID,
+		Storage: testMockStorage,
+	})
+	require.NoError(t, err)
+	require.Equal(t, item.Text, result.Todo.Title)
+	require.Equal(t, &todo.ID, result.Todo.ParentID)
+	require.Equal(t, todo.ProjectID, result.Todo.ProjectID)
+	require.Equal(t, "incomplete", result.Todo.Status)
+
+	_, err = testStore.GetChecklistItem(context.Background(), item.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"slices"
+)
+
+// Input parameters for the reorder checklist items transaction
+type ReorderChecklistItemsTxParams struct {
+	TodoID int64
+	// Every item of the todo's checklist, in the new order
+	ItemIDs []int64
+}
+
+// Result of reorder checklist items transaction
+type ReorderChecklistItemsTxResult struct {
+	Items []ChecklistItem
+}
+
+// ReorderChecklistItemsTx puts the checklist items of the todo in the given order
+func (store *SQLStore) ReorderChecklistItemsTx(ctx context.Context, arg ReorderChecklistItemsTxParams) (ReorderChecklistItemsTxResult, error) {
+	var result ReorderChecklistItemsTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		items, err := q.ListChecklistItems(ctx, arg.TodoID)
+		if err != nil {
+			return err
+		}
+
+		// The new order must list every item of the checklist exactly once
+		itemIDs := make([]int64, 0, len(items))
+		for _, item := range items {
+			itemIDs = append(itemIDs, item.ID)
+		}
+		newOrder := slices.Clone(arg.ItemIDs)
+		slices.Sort(itemIDs)
+		slices.Sort(newOrder)
+		if !slices.Equal(itemIDs, newOrder) {
+			return ErrChecklistOrderMismatch
+		}
+
+		err = q.ReorderChecklistItems(ctx, ReorderChecklistItemsParams{
+			ItemIds: arg.ItemIDs,
+			TodoID:  arg.TodoID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Items, err = q.ListChecklistItems(ctx, arg.TodoID)
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReorderChecklistItemsTx(t *testing.T) {
+	todo := createRandomTodo(t)
+	first := createRandomChecklistItemForTodo(t, todo)
+	second := createRandomChecklistItemForTodo(t, todo)
+	third := createRandomChecklistItemForTodo(t, todo)
+
+	result, err := testStore.ReorderChecklistItemsTx(context.Background(), ReorderChecklistItemsTxParams{
+		TodoID:  todo.ID,
+		ItemIDs: []int64{third.ID, first.ID, second.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 3)
+	require.Equal(t, third.ID, result.Items[0].ID)
+	require.Equal(t, first.ID, result.Items[1].ID)
+	require.Equal(t, second.ID, result.Items[2].ID)
+}
+
+func TestReorderChecklistItemsTxMismatch(t *testing.T) {
+	todo := createRandomTodo(t)
+	first := createRandomChecklistItemForTodo(t, todo)
+	second := createRandomChecklistItemForTodo(t, todo)
+	other := createRandomChecklistItemForTodo(t, createRandomTodo(t))
+
+	orders := [][]int64{
+		{first.ID},
+		{first.ID, first.ID},
+		{first.ID, other.ID},
+		{first.ID, second.ID, other.ID},
+	}
+	for _, order := range orders {
+		_, err := testStore.ReorderChecklistItemsTx(context.Background(), ReorderChecklistItemsTxParams{
+			TodoID:  todo.ID,
+			ItemIDs: order,
+		})
+		require.ErrorIs(t, err, ErrChecklistOrderMismatch)
+	}
+
+	items, err := testStore.ListChecklistItems(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, []ChecklistItem{first, second}, items)
+}
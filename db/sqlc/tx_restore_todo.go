@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+// Result of restore todo transaction
+type RestoreTodoTxResult struct {
+	Todo Todo
+}
+
+// RestoreTodoTx moves the todo out of the trash along with the subtasks trashed with it;
+// a subtask can't be restored while its parent is still in the trash
+func (store *SQLStore) RestoreTodoTx(ctx context.Context, todoID int64) (RestoreTodoTxResult, error) {
+	var result RestoreTodoTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		todo, err := q.GetTrashedTodo(ctx, todoID)
+		if err != nil {
+			return err
+		}
+
+		if todo.ParentID != nil {
+			_, err = q.GetTodo(ctx, *todo.ParentID)
+			if errors.Is(err, ErrRecordNotFound) {
+				return ErrTodoParentTrashed
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := q.RestoreTodo(ctx, todoID); err != nil {
+			return err
+		}
+
+		result.Todo, err = q.GetTodo(ctx, todoID)
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreTodoTxOK(t *testing.T) {
+	todo := createRandomTodo(t)
+	child := createRandomSubtask(t, todo)
+	require.NoError(t, testStore.TrashTodo(context.Background(), todo.ID))
+
+	result, err := testStore.RestoreTodoTx(context.Background(), todo.ID)
+	require.NoError(t, err)
+	compareTodos(t, todo, result.Todo)
+	require.Nil(t, result.Todo.DeletedAt)
+
+	// The subtask trashed along with the todo is restored too
+	_, err = testStore.GetTodo(context.Background(), child.ID)
+	require.NoError(t, err)
+}
+
+func TestRestoreTodoTxNotInTrash(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	_, err := testStore.RestoreTodoTx(context.Background(), todo.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestRestoreTodoTxParentTrashed(t *testing.T) {
+	todo := createRandomTodo(t)
+	child := createRandomSubtask(t, todo)
+	require.NoError(t, testStore.TrashTodo(context.Background(), todo.ID))
+
+	_, err := testStore.RestoreTodoTx(context.Background(), child.ID)
+	require.ErrorIs(t, err, ErrTodoParentTrashed)
+
+	_, err = testStore.GetTrashedTodo(context.Background(), child.ID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+
+	storage "github.com/jaingounchained/todo/storage"
+)
+
+// Input parameters for the revert todo revision transaction
+type RevertTodoRevisionTxParams struct {
+	RevisionID int64
+
+	// Refuse reverting the todo to a terminal state while any of its subtasks isn't in one
+	RequireCompleteSubtasks bool
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// RevertTodoRevisionTx restores the fields changed by the revision to their previous values; the revert goes
+// through the same checks as any other update, except that open blockers don't prevent restoring a completion,
+// and is recorded as a new revision
+func (store *SQLStore) RevertTodoRevisionTx(ctx context.Context, arg RevertTodoRevisionTxParams) (UpdateTodoTxResult, error) {
+	var result UpdateTodoTxResult
+
+	err := store.execStorageTx(ctx, &arg.Storage, func(q *Queries) error {
+		revision, err := q.GetTodoRevision(ctx, arg.RevisionID)
+		if err != nil {
+			return err
+		}
+
+		params, err := revision.Changes.revertParams(revision.TodoID)
+		if err != nil {
+			return err
+		}
+
+		result, err = updateTodo(ctx, q, UpdateTodoTxParams{
+			UpdateTodoTitleStatusParams: params,
+			RequireCompleteSubtasks:     arg.RequireCompleteSubtasks,
+			Force:                       true,
+			Storage:                     arg.Storage,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateTodoTxRecordsRevision(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	title := util.RandomString(10)
+	dueAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	_, err := testStore.UpdateTodoTx(context.Background(), UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: UpdateTodoTitleStatusParams{
+			ID:          todo.ID,
+			Title:       &title,
+			UpdateDueAt: true,
+			DueAt:       &dueAt,
+		},
+	})
+	require.NoError(t, err)
+
+	revisions, err := testStore.ListTodoRevisions(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+
+	changes := revisions[0].Changes
+	require.Len(t, changes, 2)
+
+	var before, after string
+	require.NoError(t, json.Unmarshal(changes[RevisionFieldTitle].Before, &before))
+	require.NoError(t, json.Unmarshal(changes[RevisionFieldTitle].After, &after))
+	require.Equal(t, todo.Title, before)
+	require.Equal(t, title, after)
+	require.JSONEq(t, "null", string(changes[RevisionFieldDueAt].Before))
+}
+
+func TestUpdateTodoTxWithoutChangesRecordsNoRevision(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	_, err := testStore.UpdateTodoTx(context.Background(), UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: UpdateTodoTitleStatusParams{
+			ID:    todo.ID,
+			Title: &todo.Title,
+		},
+	})
+	require.NoError(t, err)
+
+	revisions, err := testStore.ListTodoRevisions(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+}
+
+func TestRevertTodoRevisionTxTitle(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	title := util.RandomString(10)
+	_, err := testStore.UpdateTodoTx(context.Background(), UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: UpdateTodoTitleStatusParams{
+			ID:    todo.ID,
+			Title: &title,
+		},
+	})
+	require.NoError(t, err)
+
+	revisions, err := testStore.ListTodoRevisions(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+
+	result, err := testStore.RevertTodoRevisionTx(context.Background(), RevertTodoRevisionTxParams{
+		RevisionID: revisions[0].ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, todo.Title, result.Todo.Title)
+
+	// The revert is a revision of its own
+	revisions, err = testStore.ListTodoRevisions(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+}
+
+func TestRevertTodoRevisionTxCompletion(t *testing.T) {
+	todo := createRandomTodo(t)
+	completed := setTodoStatusTx(t, todo, "complete")
+	require.NotNil(t, completed.CompletedAt)
+
+	revisions, err := testStore.ListTodoRevisions(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+
+	result, err := testStore.RevertTodoRevisionTx(context.Background(), RevertTodoRevisionTxParams{
+		RevisionID: revisions[0].ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, todo.Status, result.Todo.Status)
+	require.Nil(t, result.Todo.CompletedAt)
+}
+
+func TestRevertTodoRevisionTxAttachments(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().SaveMultipleFilesSafely(gomock.Any(), gomock.Eq(todo.ID), gomock.Any()).Times(1)
+
+	err := testStore.UploadAttachmentTx(context.Background(), UploadAttachmentTxParams{
+		Todo: todo,
+		FileContents: map[string][]byte{
+			util.RandomString(10): []byte(util.RandomString(1)),
+		},
+		Storage: testMockStorage,
+	})
+	require.NoError(t, err)
+
+	revisions, err := testStore.ListTodoRevisions(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	require.Contains(t, revisions[0].Changes, RevisionFieldAttachments)
+
+	_, err = testStore.RevertTodoRevisionTx(context.Background(), RevertTodoRevisionTxParams{
+		RevisionID: revisions[0].ID,
+	})
+	require.ErrorIs(t, err, ErrTodoRevisionNotRevertible)
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+)
+
+// Input parameters for the set todo parent transaction; a nil ParentID turns the todo into a top level todo
+type SetTodoParentTxParams struct {
+	TodoID   int64
+	ParentID *int64
+}
+
+// Result of set todo parent transaction
+type SetTodoParentTxResult struct {
+	Todo Todo
+}
+
+// SetTodoParentTx moves the todo along with its subtasks under the new parent
+func (store *SQLStore) SetTodoParentTx(ctx context.Context, arg SetTodoParentTxParams) (SetTodoParentTxResult, error) {
+	var result SetTodoParentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// Serialize hierarchy changes so that concurrent moves can't form a cycle
+		err := q.LockTodoHierarchy(ctx)
+		if err != nil {
+			return err
+		}
+
+		if arg.ParentID != nil {
+			// Make sure the parent exists
+			_, err = q.GetTodo(ctx, *arg.ParentID)
+			if err != nil {
+				return err
+			}
+
+			// The parent can't be the todo itself or one of its descendants
+			cycle, err := q.IsTodoAncestor(ctx, IsTodoAncestorParams{
+				TodoID:     *arg.ParentID,
+				AncestorID: arg.TodoID,
+			})
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return ErrTodoParentCycle
+			}
+		}
+
+		result.Todo, err = q.UpdateTodoParent(ctx, UpdateTodoParentParams{
+			ID:       arg.TodoID,
+			ParentID: arg.ParentID,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTodoParentTxOK(t *testing.T) {
+	parent := createRandomTodo(t)
+	todo := createRandomTodo(t)
+	child := createRandomSubtask(t, todo)
+
+	result, err := testStore.SetTodoParentTx(context.Background(), SetTodoParentTxParams{
+		TodoID:   todo.ID,
+		ParentID: &parent.ID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Todo.ParentID)
+	require.Equal(t, parent.ID, *result.Todo.ParentID)
+
+	// Subtasks move along with the todo
+	descendants, err := testStore.ListTodoDescendants(context.Background(), parent.ID)
+	require.NoError(t, err)
+	require.Len(t, descendants, 2)
+	compareTodos(t, result.Todo, descendants[0])
+	compareTodos(t, child, descendants[1])
+}
+
+func TestSetTodoParentTxTopLevel(t *testing.T) {
+	parent := createRandomTodo(t)
+	todo := createRandomSubtask(t, parent)
+
+	result, err := testStore.SetTodoParentTx(context.Background(), SetTodoParentTxParams{
+		TodoID: todo.ID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, result.Todo.ParentID)
+}
+
+func TestSetTodoParentTxCycle(t *testing.T) {
+	todo := createRandomTodo(t)
+	child := createRandomSubtask(t, todo)
+	grandchild := createRandomSubtask(t, child)
+
+	for _, parentID := range []int64{todo.ID, child.ID, grandchild.ID} {
+		_, err := testStore.SetTodoParentTx(context.Background(), SetTodoParentTxParams{
+			TodoID:   todo.ID,
+			ParentID: &parentID,
+		})
+		require.ErrorIs(t, err, ErrTodoParentCycle)
+	}
+
+	// Nothing changed
+	got, err := testStore.GetTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Nil(t, got.ParentID)
+}
+
+func TestSetTodoParentTxParentNotFound(t *testing.T) {
+	todo := createRandomTodo(t)
+	parentID := int64(-1)
+
+	_, err := testStore.SetTodoParentTx(context.Background(), SetTodoParentTxParams{
+		TodoID:   todo.ID,
+		ParentID: &parentID,
+	})
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+)
+
+// Input parameters for the set todo recurrence transaction
+type SetTodoRecurrenceTxParams struct {
+	TodoID          int64
+	Rrule           string
+	CopyAttachments bool
+}
+
+// Result of set todo recurrence transaction
+type SetTodoRecurrenceTxResult struct {
+	Recurrence Recurrence
+	Todo       Todo
+}
+
+// SetTodoRecurrenceTx starts a series at the due date of the todo, or replaces the rule of its current series
+func (store *SQLStore) SetTodoRecurrenceTx(ctx context.Context, arg SetTodoRecurrenceTxParams) (SetTodoRecurrenceTxResult, error) {
+	var result SetTodoRecurrenceTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Todo, err = q.GetTodoForUpdate(ctx, arg.TodoID)
+		if err != nil {
+			return err
+		}
+
+		// The due date is the start of the series
+		if result.Todo.DueAt == nil {
+			return ErrTodoWithoutDueDate
+		}
+
+		// Occurrences are computed in the timezone of the due date
+		timezone := "UTC"
+		if result.Todo.DueTimezone != nil {
+			timezone = *result.Todo.DueTimezone
+		}
+
+		if result.Todo.RecurrenceID != nil {
+			result.Recurrence, err = q.UpdateRecurrence(ctx, UpdateRecurrenceParams{
+				ID:              *result.Todo.RecurrenceID,
+				Rrule:           arg.Rrule,
+				Dtstart:         *result.Todo.DueAt,
+				Timezone:        timezone,
+				CopyAttachments: arg.CopyAttachments,
+			})
+			return err
+		}
+
+		result.Recurrence, err = q.CreateRecurrence(ctx, CreateRecurrenceParams{
+			Rrule:           arg.Rrule,
+			Dtstart:         *result.Todo.DueAt,
+			Timezone:        timezone,
+			CopyAttachments: arg.CopyAttachments,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Todo, err = q.UpdateTodoRecurrence(ctx, UpdateTodoRecurrenceParams{
+			ID:           arg.TodoID,
+			RecurrenceID: &result.Recurrence.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createRecurringTodo(t *testing.T, dueAt time.Time, rrule string, copyAttachments bool) (Todo, Recurrence) {
+	todo := createRandomTodoDueAt(t, dueAt)
+
+	result, err := testStore.SetTodoRecurrenceTx(context.Background(), SetTodoRecurrenceTxParams{
+		TodoID:          todo.ID,
+		Rrule:           rrule,
+		CopyAttachments: copyAttachments,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Todo.RecurrenceID)
+	require.Equal(t, result.Recurrence.ID, *result.Todo.RecurrenceID)
+	require.Equal(t, rrule, result.Recurrence.Rrule)
+	require.Equal(t, "UTC", result.Recurrence.Timezone)
+	require.WithinDuration(t, dueAt, result.Recurrence.Dtstart, time.Second)
+
+	return result.Todo, result.Recurrence
+}
+
+func TestSetTodoRecurrenceTxOK(t *testing.T) {
+	createRecurringTodo(t, time.Now().Add(time.Hour), "FREQ=DAILY", false)
+}
+
+func TestSetTodoRecurrenceTxReplace(t *testing.T) {
+	todo, recurrence := createRecurringTodo(t, time.Now().Add(time.Hour), "FREQ=DAILY", false)
+
+	result, err := testStore.SetTodoRecurrenceTx(context.Background(), SetTodoRecurrenceTxParams{
+		TodoID:          todo.ID,
+		Rrule:           "FREQ=WEEKLY",
+		CopyAttachments: true,
+	})
+	require.NoError(t, err)
+
+	// The series is updated in place
+	require.Equal(t, recurrence.ID, result.Recurrence.ID)
+	require.Equal(t, "FREQ=WEEKLY", result.Recurrence.Rrule)
+	require.True(t, result.Recurrence.CopyAttachments)
+}
+
+func TestSetTodoRecurrenceTxWithoutDueDate(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	_, err := testStore.SetTodoRecurrenceTx(context.Background(), SetTodoRecurrenceTxParams{
+		TodoID: todo.ID,
+		Rrule:  "FREQ=DAILY",
+	})
+	require.ErrorIs(t, err, ErrTodoWithoutDueDate)
+}
+
+func TestDeleteRecurrenceEndsSeries(t *testing.T) {
+	todo, recurrence := createRecurringTodo(t, time.Now().Add(time.Hour), "FREQ=DAILY", false)
+
+	err := testStore.DeleteRecurrence(context.Background(), recurrence.ID)
+	require.NoError(t, err)
+
+	got, err := testStore.GetTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Nil(t, got.RecurrenceID)
+}
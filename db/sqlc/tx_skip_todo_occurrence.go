@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+)
+
+// Result of skip todo occurrence transaction
+type SkipTodoOccurrenceTxResult struct {
+	Todo Todo
+}
+
+// SkipTodoOccurrenceTx moves the due date of the current instance of a recurring todo to the next occurrence,
+// recording the change as a revision
+func (store *SQLStore) SkipTodoOccurrenceTx(ctx context.Context, todoID int64) (SkipTodoOccurrenceTxResult, error) {
+	var result SkipTodoOccurrenceTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		todo, err := q.GetTodoForUpdate(ctx, todoID)
+		if err != nil {
+			return err
+		}
+
+		if todo.RecurrenceID == nil {
+			return ErrTodoNotRecurring
+		}
+
+		recurrence, err := q.GetRecurrence(ctx, *todo.RecurrenceID)
+		if err != nil {
+			return err
+		}
+
+		dueAt, err := recurrence.NextOccurrence(currentOccurrence(todo))
+		if err != nil {
+			return err
+		}
+
+		result.Todo, err = q.UpdateTodoTitleStatus(ctx, UpdateTodoTitleStatusParams{
+			ID:          todoID,
+			UpdateDueAt: true,
+			DueAt:       &dueAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		changes, err := todoChanges(todo, result.Todo)
+		if err != nil {
+			return err
+		}
+
+		return recordTodoRevision(ctx, q, todoID, changes)
+	})
+
+	return result, err
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkipTodoOccurrenceTxOK(t *testing.T) {
+	dueAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	todo, _ := createRecurringTodo(t, dueAt, "FREQ=DAILY", false)
+
+	revisions, err := testStore.ListTodoRevisions(context.Background(), todo.ID)
+	require.NoError(t, err)
+	revisionCount := len(revisions)
+
+	result, err := testStore.SkipTodoOccurrenceTx(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.NotNil(t, result.Todo.DueAt)
+	require.WithinDuration(t, dueAt.AddDate(0, 0, 1), *result.Todo.DueAt, time.Second)
+	require.Equal(t, todo.RecurrenceID, result.Todo.RecurrenceID)
+
+	// The skip is recorded as a due date change
+	revisions, err = testStore.ListTodoRevisions(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, revisionCount+1)
+
+	changes := revisions[0].Changes
+	require.Len(t, changes, 1)
+
+	var before, after time.Time
+	require.NoError(t, json.Unmarshal(changes[RevisionFieldDueAt].Before, &before))
+	require.NoError(t, json.Unmarshal(changes[RevisionFieldDueAt].After, &after))
+	require.WithinDuration(t, *todo.DueAt, before, time.Second)
+	require.WithinDuration(t, *result.Todo.DueAt, after, time.Second)
+}
+
+func TestSkipTodoOccurrenceTxEnded(t *testing.T) {
+	todo, _ := createRecurringTodo(t, time.Now().Add(time.Hour), "FREQ=DAILY;COUNT=1", false)
+
+	_, err := testStore.SkipTodoOccurrenceTx(context.Background(), todo.ID)
+	require.ErrorIs(t, err, ErrRecurrenceEnded)
+}
+
+func TestSkipTodoOccurrenceTxNotRecurring(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	_, err := testStore.SkipTodoOccurrenceTx(context.Background(), todo.ID)
+	require.ErrorIs(t, err, ErrTodoNotRecurring)
+}
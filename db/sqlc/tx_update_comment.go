@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+)
+
+// Input parameters for the update comment transaction
+type UpdateCommentTxParams struct {
+	ID   int64
+	Body string
+	// Replace the attachments referenced by the comment
+	UpdateAttachments bool
+	AttachmentIDs     []int64
+}
+
+// Result of update comment transaction
+type UpdateCommentTxResult struct {
+	Comment       Comment
+	AttachmentIDs []int64
+}
+
+// UpdateCommentTx edits the body of the comment, marking it as edited, and optionally replaces its attachments
+func (store *SQLStore) UpdateCommentTx(ctx context.Context, arg UpdateCommentTxParams) (UpdateCommentTxResult, error) {
+	var result UpdateCommentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Comment, err = q.UpdateCommentBody(ctx, UpdateCommentBodyParams{
+			ID:   arg.ID,
+			Body: arg.Body,
+		})
+		if err != nil {
+			return err
+		}
+
+		if arg.UpdateAttachments {
+			result.AttachmentIDs, err = setCommentAttachments(ctx, q, result.Comment, arg.AttachmentIDs)
+			return err
+		}
+
+		attachments, err := q.ListCommentAttachments(ctx, []int64{arg.ID})
+		if err != nil {
+			return err
+		}
+
+		result.AttachmentIDs = make([]int64, 0, len(attachments))
+		for _, attachment := range attachments {
+			result.AttachmentIDs = append(result.AttachmentIDs, attachment.AttachmentID)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateCommentTxKeepsAttachments(t *testing.T) {
+	todo := createRandomTodo(t)
+	attachment := createRandomAttachmentForTodo(t, todo)
+	comment := createRandomCommentForTodo(t, todo, attachment.ID).Comment
+
+	result, err := testStore.UpdateCommentTx(context.Background(), UpdateCommentTxParams{
+		ID:   comment.ID,
+		Body: "edited",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "edited", result.Comment.Body)
+	require.NotNil(t, result.Comment.EditedAt)
+	require.Equal(t, []int64{attachment.ID}, result.AttachmentIDs)
+}
+
+func TestUpdateCommentTxReplacesAttachments(t *testing.T) {
+	todo := createRandomTodo(t)
+	attachment1 := createRandomAttachmentForTodo(t, todo)
+	attachment2 := createRandomAttachmentForTodo(t, todo)
+	comment := createRandomCommentForTodo(t, todo, attachment1.ID).Comment
+
+	result, err := testStore.UpdateCommentTx(context.Background(), UpdateCommentTxParams{
+		ID:                comment.ID,
+		Body:              comment.Body,
+		UpdateAttachments: true,
+		AttachmentIDs:     []int64{attachment2.ID},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{attachment2.ID}, result.AttachmentIDs)
+
+	// Clearing the attachments
+	result, err = testStore.UpdateCommentTx(context.Background(), UpdateCommentTxParams{
+		ID:                comment.ID,
+		Body:              comment.Body,
+		UpdateAttachments: true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.AttachmentIDs)
+}
+
+func TestUpdateCommentTxNotFound(t *testing.T) {
+	_, err := testStore.UpdateCommentTx(context.Background(), UpdateCommentTxParams{
+		ID:   -1,
+		Body: "edited",
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+)
+
+// Input parameters for the update template transaction
+type UpdateTemplateTxParams struct {
+	UpdateTemplateParams
+	// The tags of the template are replaced with TagIDs only when UpdateTags is set
+	UpdateTags bool
+	TagIDs     []int64
+}
+
+// Result of update template transaction
+type UpdateTemplateTxResult struct {
+	Template Template
+	Tags     []Tag
+}
+
+// UpdateTemplateTx updates the fields of the template and optionally replaces its tags
+func (store *SQLStore) UpdateTemplateTx(ctx context.Context, arg UpdateTemplateTxParams) (UpdateTemplateTxResult, error) {
+	var result UpdateTemplateTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Template, err = q.UpdateTemplate(ctx, arg.UpdateTemplateParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.UpdateTags {
+			if err := q.DeleteTemplateTags(ctx, result.Template.ID); err != nil {
+				return err
+			}
+
+			err = q.AddTagsToTemplate(ctx, AddTagsToTemplateParams{
+				TemplateID: result.Template.ID,
+				TagIds:     arg.TagIDs,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Tags, err = q.ListTagsOfTemplate(ctx, result.Template.ID)
+		return err
+	})
+
+	return result, err
+}
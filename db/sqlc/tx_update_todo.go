@@ -0,0 +1,182 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	storage "github.com/jaingounchained/todo/storage"
+)
+
+// Input parameters for the update todo transaction
+type UpdateTodoTxParams struct {
+	UpdateTodoTitleStatusParams
+
+	// Refuse moving the todo to a terminal state while any of its subtasks isn't in one
+	RequireCompleteSubtasks bool
+	// Move the todo to a terminal state even though some of its blockers are open
+	Force bool
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// Result of update todo transaction
+type UpdateTodoTxResult struct {
+	Todo Todo
+	// Next instance of a recurring todo, created when the todo gets completed
+	NextTodo *Todo
+}
+
+// UpdateTodoTx updates the todo, validating and recording a status change against the workflow of the todo,
+// recording the change as a revision and creating the next instance when a recurring todo gets completed; the
+// directory of the next instance is deleted again if the transaction fails
+func (store *SQLStore) UpdateTodoTx(ctx context.Context, arg UpdateTodoTxParams) (UpdateTodoTxResult, error) {
+	var result UpdateTodoTxResult
+
+	err := store.execStorageTx(ctx, &arg.Storage, func(q *Queries) error {
+		var err error
+		result, err = updateTodo(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// updateTodo does the work of UpdateTodoTx within the given transaction
+func updateTodo(ctx context.Context, q *Queries, arg UpdateTodoTxParams) (UpdateTodoTxResult, error) {
+	var result UpdateTodoTxResult
+
+	// Lock the todo so that concurrent completions create a single next instance
+	before, err := q.GetTodoForUpdate(ctx, arg.ID)
+	if err != nil {
+		return result, err
+	}
+
+	params := arg.UpdateTodoTitleStatusParams
+	transitioned := params.Status != nil && *params.Status != before.Status
+	if transitioned {
+		state, err := checkTodoTransition(ctx, q, before, *params.Status)
+		if err != nil {
+			return result, err
+		}
+
+		if state.Terminal {
+			err = checkTodoCompletable(ctx, q, before.ID, arg.RequireCompleteSubtasks, arg.Force)
+			if err != nil {
+				return result, err
+			}
+		}
+
+		// completed_at is kept while the todo moves between terminal states
+		if state.Terminal != (before.CompletedAt != nil) {
+			params.UpdateCompletedAt = true
+			if state.Terminal {
+				now := time.Now()
+				params.CompletedAt = &now
+			}
+		}
+	}
+
+	result.Todo, err = q.UpdateTodoTitleStatus(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	changes, err := todoChanges(before, result.Todo)
+	if err != nil {
+		return result, err
+	}
+
+	err = recordTodoRevision(ctx, q, before.ID, changes)
+	if err != nil {
+		return result, err
+	}
+
+	if !transitioned {
+		return result, nil
+	}
+
+	_, err = q.CreateTodoTransition(ctx, CreateTodoTransitionParams{
+		TodoID:    before.ID,
+		FromState: before.Status,
+		ToState:   result.Todo.Status,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	completed := before.CompletedAt == nil && result.Todo.CompletedAt != nil
+	if !completed || result.Todo.RecurrenceID == nil {
+		return result, nil
+	}
+
+	result.Todo, result.NextTodo, err = createNextRecurringTodo(ctx, q, result.Todo, arg.Storage)
+	return result, err
+}
+
+// checkTodoTransition returns the workflow state the todo moves to, provided that the workflow of the todo
+// allows the transition; a todo whose status isn't a state of its workflow, e.g. after its project switched
+// workflows, may move to any state
+func checkTodoTransition(ctx context.Context, q *Queries, todo Todo, status string) (WorkflowState, error) {
+	workflowID, err := q.GetTodoWorkflowID(ctx, todo.ID)
+	if err != nil {
+		return WorkflowState{}, err
+	}
+
+	state, err := q.GetWorkflowState(ctx, GetWorkflowStateParams{
+		WorkflowID: workflowID,
+		Name:       status,
+	})
+	if errors.Is(err, ErrRecordNotFound) {
+		return WorkflowState{}, ErrUnknownTodoStatus
+	}
+	if err != nil {
+		return WorkflowState{}, err
+	}
+
+	_, err = q.GetWorkflowState(ctx, GetWorkflowStateParams{
+		WorkflowID: workflowID,
+		Name:       todo.Status,
+	})
+	if errors.Is(err, ErrRecordNotFound) {
+		return state, nil
+	}
+	if err != nil {
+		return WorkflowState{}, err
+	}
+
+	allowed, err := q.IsWorkflowTransitionAllowed(ctx, IsWorkflowTransitionAllowedParams{
+		WorkflowID: workflowID,
+		FromState:  todo.Status,
+		ToState:    status,
+	})
+	if err != nil {
+		return WorkflowState{}, err
+	}
+	if !allowed {
+		return WorkflowState{}, ErrTodoTransitionNotAllowed
+	}
+
+	return state, nil
+}
+
+// checkTodoCompletable makes sure the todo has no open blockers unless forced and, if required, that all of
+// its subtasks are in a terminal state
+func checkTodoCompletable(ctx context.Context, q *Queries, todoID int64, requireCompleteSubtasks, force bool) error {
+	rollups, err := q.ListTodoRollups(ctx, []int64{todoID})
+	if err != nil {
+		return err
+	}
+
+	for _, rollup := range rollups {
+		if !force && rollup.OpenBlockers > 0 {
+			return ErrTodoBlocked
+		}
+		if requireCompleteSubtasks && rollup.ChildrenCompleted < rollup.ChildrenTotal {
+			return ErrTodoHasIncompleteSubtasks
+		}
+	}
+
+	return nil
+}
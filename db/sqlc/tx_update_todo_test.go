@@ -0,0 +1,288 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func completeTodoTx(t *testing.T, todo Todo, storage *mockStorage.MockStorage) UpdateTodoTxResult {
+	complete := "complete"
+	result, err := testStore.UpdateTodoTx(context.Background(), UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: UpdateTodoTitleStatusParams{
+			ID:     todo.ID,
+			Status: &complete,
+		},
+		Storage: storage,
+	})
+	require.NoError(t, err)
+	require.Equal(t, complete, result.Todo.Status)
+
+	return result
+}
+
+func setTodoStatusTx(t *testing.T, todo Todo, status string) Todo {
+	result, err := testStore.UpdateTodoTx(context.Background(), UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: UpdateTodoTitleStatusParams{
+			ID:     todo.ID,
+			Status: &status,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, status, result.Todo.Status)
+
+	return result.Todo
+}
+
+func TestUpdateTodoTxWorkflowTransitions(t *testing.T) {
+	workflow := createRandomWorkflow(t)
+	project := createRandomProject(t, &workflow.ID)
+
+	todo, err := testStore.CreateTodo(context.Background(), CreateTodoParams{
+		Title:     util.RandomString(10),
+		ProjectID: &project.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "backlog", todo.Status)
+
+	updateStatus := func(status string) error {
+		_, err := testStore.UpdateTodoTx(context.Background(), UpdateTodoTxParams{
+			UpdateTodoTitleStatusParams: UpdateTodoTitleStatusParams{
+				ID:     todo.ID,
+				Status: &status,
+			},
+		})
+		return err
+	}
+
+	// States of other workflows and skipped steps are refused
+	require.ErrorIs(t, updateStatus("complete"), ErrUnknownTodoStatus)
+	require.ErrorIs(t, updateStatus("done"), ErrTodoTransitionNotAllowed)
+
+	todo = setTodoStatusTx(t, todo, "in-progress")
+	require.Nil(t, todo.CompletedAt)
+	todo = setTodoStatusTx(t, todo, "review")
+	todo = setTodoStatusTx(t, todo, "done")
+	require.NotNil(t, todo.CompletedAt)
+
+	transitions, err := testStore.ListTodoTransitions(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Len(t, transitions, 3)
+	for i, states := range [][2]string{{"backlog", "in-progress"}, {"in-progress", "review"}, {"review", "done"}} {
+		require.Equal(t, states[0], transitions[i].FromState)
+		require.Equal(t, states[1], transitions[i].ToState)
+		require.NotZero(t, transitions[i].CreatedAt)
+	}
+}
+
+func TestUpdateTodoTxReopen(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	todo = setTodoStatusTx(t, todo, "complete")
+	require.NotNil(t, todo.CompletedAt)
+
+	todo = setTodoStatusTx(t, todo, "incomplete")
+	require.Nil(t, todo.CompletedAt)
+}
+
+func TestUpdateTodoTxRequireCompleteSubtasks(t *testing.T) {
+	parent := createRandomTodo(t)
+	child := createRandomSubtask(t, parent)
+
+	complete := "complete"
+	arg := UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: UpdateTodoTitleStatusParams{
+			ID:     parent.ID,
+			Status: &complete,
+		},
+		RequireCompleteSubtasks: true,
+	}
+
+	_, err := testStore.UpdateTodoTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrTodoHasIncompleteSubtasks)
+
+	setTodoStatusTx(t, child, complete)
+	result, err := testStore.UpdateTodoTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotNil(t, result.Todo.CompletedAt)
+}
+
+func TestUpdateTodoTxBlocked(t *testing.T) {
+	todo := createRandomTodo(t)
+	blocker := createRandomTodo(t)
+	addTodoDependencyTx(t, todo, blocker)
+
+	complete := "complete"
+	arg := UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: UpdateTodoTitleStatusParams{
+			ID:     todo.ID,
+			Status: &complete,
+		},
+	}
+
+	_, err := testStore.UpdateTodoTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrTodoBlocked)
+
+	arg.Force = true
+	result, err := testStore.UpdateTodoTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotNil(t, result.Todo.CompletedAt)
+}
+
+func TestUpdateTodoTxNotRecurring(t *testing.T) {
+	todo := createRandomTodo(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().CreateTodoDirectory(gomock.Any(), gomock.Any()).Times(0)
+
+	result := completeTodoTx(t, todo, testMockStorage)
+	require.Nil(t, result.NextTodo)
+}
+
+func TestUpdateTodoTxCreatesNextInstance(t *testing.T) {
+	dueAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	todo, recurrence := createRecurringTodo(t, dueAt, "FREQ=WEEKLY", false)
+	tag := createRandomTag(t)
+	err := testStore.AddTagsToTodo(context.Background(), AddTagsToTodoParams{
+		TodoID: todo.ID,
+		TagIds: []int64{tag.ID},
+	})
+	require.NoError(t, err)
+	createReminderForTodo(t, todo, 30)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().CreateTodoDirectory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+	result := completeTodoTx(t, todo, testMockStorage)
+
+	// The series moves to the next instance
+	require.Nil(t, result.Todo.RecurrenceID)
+	require.NotNil(t, result.NextTodo)
+	next := *result.NextTodo
+	require.Equal(t, todo.Title, next.Title)
+	require.Equal(t, "incomplete", next.Status)
+	require.Equal(t, &recurrence.ID, next.RecurrenceID)
+	require.WithinDuration(t, dueAt.AddDate(0, 0, 7), *next.DueAt, time.Second)
+
+	// Tags and reminders are carried over
+	tags, err := testStore.ListTagsOfTodo(context.Background(), next.ID)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	require.Equal(t, tag.ID, tags[0].ID)
+
+	reminders, err := testStore.ListRemindersOfTodo(context.Background(), next.ID)
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	require.Equal(t, int32(30), reminders[0].OffsetMinutes)
+
+	// Completing the old instance again doesn't create another one
+	setTodoStatusTx(t, todo, "incomplete")
+	result = completeTodoTx(t, todo, testMockStorage)
+	require.Nil(t, result.NextTodo)
+}
+
+func TestUpdateTodoTxCopiesAttachments(t *testing.T) {
+	todo, _ := createRecurringTodo(t, time.Now().Add(time.Hour), "FREQ=DAILY", true)
+	attachment := createRandomAttachmentForTodo(t, todo)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().CreateTodoDirectory(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(todo.ID), gomock.Eq(attachment.StorageFilename), gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(nil)
+
+	result := completeTodoTx(t, todo, testMockStorage)
+	require.NotNil(t, result.NextTodo)
+	require.Equal(t, int32(1), result.NextTodo.FileCount)
+
+	attachments, err := testStore.ListAttachmentOfTodo(context.Background(), result.NextTodo.ID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, attachment.OriginalFilename, attachments[0].OriginalFilename)
+	require.NotEqual(t, attachment.StorageFilename, attachments[0].StorageFilename)
+}
+
+func TestUpdateTodoTxCopyFailureDeletesNextInstanceDirectory(t *testing.T) {
+	todo, recurrence := createRecurringTodo(t, time.Now().Add(time.Hour), "FREQ=DAILY", true)
+	createRandomAttachmentForTodo(t, todo)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	expectedError := errors.New("storage failure")
+	var createdTodoID, deletedTodoID int64
+	testMockStorage.EXPECT().
+		CreateTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			createdTodoID = todoID
+		}).
+		Times(1).
+		Return(nil)
+	testMockStorage.EXPECT().
+		CopyFile(gomock.Any(), gomock.Eq(todo.ID), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(expectedError)
+	testMockStorage.EXPECT().
+		DeleteTodoDirectory(gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, todoID int64) {
+			deletedTodoID = todoID
+		}).
+		Times(1).
+		Return(nil)
+
+	complete := "complete"
+	_, err := testStore.UpdateTodoTx(context.Background(), UpdateTodoTxParams{
+		UpdateTodoTitleStatusParams: UpdateTodoTitleStatusParams{
+			ID:     todo.ID,
+			Status: &complete,
+		},
+		Storage: testMockStorage,
+	})
+	require.ErrorIs(t, err, expectedError)
+
+	// The directory of the rolled back next instance is deleted, and the todo stays the current instance
+	require.NotZero(t, createdTodoID)
+	require.Equal(t, createdTodoID, deletedTodoID)
+
+	_, err = testStore.GetTodo(context.Background(), createdTodoID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	actual, err := testStore.GetTodo(context.Background(), todo.ID)
+	require.NoError(t, err)
+	require.Equal(t, "incomplete", actual.Status)
+	require.Equal(t, &recurrence.ID, actual.RecurrenceID)
+}
+
+func TestUpdateTodoTxEndsSeries(t *testing.T) {
+	todo, recurrence := createRecurringTodo(t, time.Now().Add(time.Hour), "FREQ=DAILY;COUNT=1", false)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testMockStorage := mockStorage.NewMockStorage(ctrl)
+	testMockStorage.EXPECT().CreateTodoDirectory(gomock.Any(), gomock.Any()).Times(0)
+
+	result := completeTodoTx(t, todo, testMockStorage)
+	require.Nil(t, result.NextTodo)
+	require.Nil(t, result.Todo.RecurrenceID)
+
+	_, err := testStore.GetRecurrence(context.Background(), recurrence.ID)
+	require.EqualError(t, err, ErrRecordNotFound.Error())
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+)
+
+// Input parameters for the update workflow transaction; the states and transitions replace the current ones
+type UpdateWorkflowTxParams struct {
+	ID          int64
+	Name        string
+	States      []WorkflowState
+	Transitions []WorkflowTransition
+}
+
+// Result of update workflow transaction
+type UpdateWorkflowTxResult struct {
+	Workflow    Workflow
+	States      []WorkflowState
+	Transitions []WorkflowTransition
+}
+
+// UpdateWorkflowTx replaces the definition of a workflow; todos keep their status even if it isn't a state of
+// the workflow anymore
+func (store *SQLStore) UpdateWorkflowTx(ctx context.Context, arg UpdateWorkflowTxParams) (UpdateWorkflowTxResult, error) {
+	var result UpdateWorkflowTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Workflow, err = q.UpdateWorkflowName(ctx, UpdateWorkflowNameParams{
+			ID:   arg.ID,
+			Name: arg.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Transitions go away along with the states
+		err = q.DeleteWorkflowStates(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		result.States, result.Transitions, err = createWorkflowDefinition(ctx, q, arg.ID, arg.States, arg.Transitions)
+		return err
+	})
+
+	return result, err
+}
@@ -16,10 +16,13 @@ type UploadAttachmentTxParams struct {
 	Storage storage.Storage
 }
 
-// UploadAttachmentTx performs todo information update and file upload
+// UploadAttachmentTx performs todo information update and file upload, recording the new attachments as a revision
 func (store *SQLStore) UploadAttachmentTx(ctx context.Context, arg UploadAttachmentTxParams) error {
 	return store.execTx(ctx, func(q *Queries) error {
-		var err error
+		before, err := listAllAttachmentsOfTodo(ctx, q, arg.Todo.ID)
+		if err != nil {
+			return err
+		}
 
 		// Increment todo file count
 		_, err = q.UpdateTodoFileCount(ctx, UpdateTodoFileCountParams{
@@ -51,6 +54,11 @@ func (store *SQLStore) UploadAttachmentTx(ctx context.Context, arg UploadAttachm
 			StorageFileNameToBytesMap[uuid] = arg.FileContents[fileName]
 		}
 
+		err = recordAttachmentsRevision(ctx, q, arg.Todo.ID, before)
+		if err != nil {
+			return err
+		}
+
 		// Save file
 		return arg.Storage.SaveMultipleFilesSafely(ctx, arg.Todo.ID, StorageFileNameToBytesMap)
 	})
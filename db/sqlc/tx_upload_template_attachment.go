@@ -0,0 +1,43 @@
+package db
+
+import (
+	"context"
+
+	storage "github.com/jaingounchained/todo/storage"
+	"github.com/jaingounchained/todo/util"
+)
+
+// Input parameters for the upload template attachment transaction
+type UploadTemplateAttachmentTxParams struct {
+	TemplateID   int64
+	FileContents storage.FileContents
+
+	// TODO: Can improve this by returning only relevant closure from Storage instead of whole object
+	Storage storage.Storage
+}
+
+// UploadTemplateAttachmentTx stores the attachment metadata of the template along with the files
+func (store *SQLStore) UploadTemplateAttachmentTx(ctx context.Context, arg UploadTemplateAttachmentTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		storageFileContents := make(storage.FileContents, len(arg.FileContents))
+		for fileName, contents := range arg.FileContents {
+			uuid, err := util.GenerateUUID()
+			if err != nil {
+				return err
+			}
+
+			_, err = q.CreateTemplateAttachment(ctx, CreateTemplateAttachmentParams{
+				TemplateID:       arg.TemplateID,
+				OriginalFilename: fileName,
+				StorageFilename:  uuid,
+			})
+			if err != nil {
+				return err
+			}
+
+			storageFileContents[uuid] = contents
+		}
+
+		return arg.Storage.SaveTemplateFiles(ctx, arg.TemplateID, storageFileContents)
+	})
+}
@@ -10,12 +10,17 @@ import (
 	"path/filepath"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 
 	_ "github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jaingounchained/todo/api"
 	db "github.com/jaingounchained/todo/db/sqlc"
 	_ "github.com/jaingounchained/todo/docs"
+	notification "github.com/jaingounchained/todo/notification"
+	logNotifier "github.com/jaingounchained/todo/notification/logger"
+	webhookNotifier "github.com/jaingounchained/todo/notification/webhook"
+	"github.com/jaingounchained/todo/scheduler"
 	storage "github.com/jaingounchained/todo/storage"
 	localStorage "github.com/jaingounchained/todo/storage/local_directory"
 	"github.com/jaingounchained/todo/util"
@@ -80,17 +85,47 @@ func main() {
 		logger.Fatal("Invalid file storage type chosen")
 	}
 
+	// Setup notifier
+	var notifier notification.Notifier
+	switch config.NotifierType {
+	case "LOG":
+		notifier = logNotifier.New(logger)
+	case "WEBHOOK":
+		notifier, err = webhookNotifier.New(config.NotifierWebhookURL)
+		if err != nil {
+			logger.Fatal("cannot setup notifier for the webhook notifierType: ", zap.Error(err))
+		}
+	default:
+		logger.Fatal("Invalid notifier type chosen")
+	}
+
+	// Start background jobs
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+
+	// Notify the assignees and watchers of todos about their changes, outside of the requests making them
+	dispatcher := notification.NewAsyncDispatcher(notification.NewTodoDispatcher(store, notifier, logger), logger)
+	go dispatcher.Run(schedulerCtx)
+
+	jobScheduler := scheduler.New(logger)
+	jobScheduler.Register(scheduler.NewReminderJob(store, notifier, logger), config.ReminderInterval)
+	jobScheduler.Register(scheduler.NewPurgeJob(store, storage, config.TrashRetention, logger), config.PurgeInterval)
+	jobScheduler.Register(scheduler.NewArchiveJob(store, config.AutoArchiveAfter, logger), config.ArchiveInterval)
+	jobScheduler.Start(schedulerCtx)
+
 	// Initializing the http server
-	httpServer := api.NewGinHandler(store, storage, logger).HttpServer(config.ServerAddress)
+	httpServer := api.NewGinHandler(config, store, storage, dispatcher, logger).HttpServer(config.ServerAddress)
 	go startHTTPServer(logger, httpServer)
 
-	applicationShutdown(logger, done, httpServer, connPool, storage)
+	applicationShutdown(logger, done, httpServer, connPool, storage, stopScheduler)
 }
 
-func applicationShutdown(logger *zap.Logger, done <-chan os.Signal, httpServer *http.Server, connPool *pgxpool.Pool, storage storage.Storage) {
+func applicationShutdown(logger *zap.Logger, done <-chan os.Signal, httpServer *http.Server, connPool *pgxpool.Pool, storage storage.Storage, stopScheduler context.CancelFunc) {
 	<-done
 	logger.Info("Shutting down server...")
 
+	// Stop background jobs
+	stopScheduler()
+
 	// Server has 5 seconds to finish
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
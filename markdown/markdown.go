@@ -0,0 +1,179 @@
+// Package markdown renders user supplied Markdown to HTML which is safe to embed in a page:
+// raw HTML is dropped, links and images are limited to http(s) and links open in a new tab
+// without leaking the referrer. Attachments may be referenced as attachment:<id> and get resolved to their URL
+package markdown
+
+import (
+	"bytes"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+const htmlFlags = blackfriday.SkipHTML |
+	blackfriday.Safelink |
+	blackfriday.NofollowLinks |
+	blackfriday.NoreferrerLinks |
+	blackfriday.NoopenerLinks |
+	blackfriday.HrefTargetBlank
+
+// AttachmentScheme is the URL scheme referencing an attachment by ID, e.g. ![](attachment:42)
+const AttachmentScheme = "attachment"
+
+// AttachmentResolver returns the URL of the referenced attachment, or false if it doesn't exist
+type AttachmentResolver func(attachmentID int64) (string, bool)
+
+// renderer drops images whose source could run script, which blackfriday's Safelink doesn't cover
+type renderer struct {
+	*blackfriday.HTMLRenderer
+	resolved map[*blackfriday.Node]bool
+}
+
+func (r renderer) RenderNode(w io.Writer, node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+	if node.Type == blackfriday.Image && !r.resolved[node] && !isSafeURL(string(node.LinkData.Destination)) {
+		return blackfriday.SkipChildren
+	}
+
+	return r.HTMLRenderer.RenderNode(w, node, entering)
+}
+
+func isSafeURL(dest string) bool {
+	u, err := url.Parse(dest)
+	if err != nil {
+		return false
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	return scheme == "http" || scheme == "https"
+}
+
+// HasAttachmentReferences tells whether the source may reference attachments, without parsing it
+func HasAttachmentReferences(source string) bool {
+	return strings.Contains(strings.ToLower(source), AttachmentScheme+":")
+}
+
+// attachmentReference matches the attachment references in a Markdown source
+var attachmentReference = regexp.MustCompile(`(?i)\b(` + AttachmentScheme + `):([0-9]+)`)
+
+// RewriteAttachmentReferences replaces the IDs of the attachments referenced by the Markdown source with their
+// mapped ones, e.g. when the attachments get copied to another todo; references to other attachments are kept
+func RewriteAttachmentReferences(source string, attachmentIDs map[int64]int64) string {
+	return attachmentReference.ReplaceAllStringFunc(source, func(reference string) string {
+		match := attachmentReference.FindStringSubmatch(reference)
+		attachmentID, err := strconv.ParseInt(match[2], 10, 64)
+		if err != nil {
+			return reference
+		}
+
+		mappedID, ok := attachmentIDs[attachmentID]
+		if !ok {
+			return reference
+		}
+
+		return match[1] + ":" + strconv.FormatInt(mappedID, 10)
+	})
+}
+
+// attachmentLink matches the inline links and images of a Markdown source referencing an attachment, with an
+// optional title
+var attachmentLink = regexp.MustCompile(`(?i)(!?)\[([^\]]*)\]\(\s*<?` + AttachmentScheme + `:([0-9]+)>?(?:\s+"[^"]*")?\s*\)`)
+
+// StripAttachmentReferences removes the inline links and images referencing the given attachments from the
+// Markdown source, e.g. when they don't get copied along with it; links are replaced by their text and images are
+// dropped, like ToHTMLWithAttachments renders unresolved references
+func StripAttachmentReferences(source string, attachmentIDs map[int64]bool) string {
+	if len(attachmentIDs) == 0 {
+		return source
+	}
+
+	return attachmentLink.ReplaceAllStringFunc(source, func(link string) string {
+		match := attachmentLink.FindStringSubmatch(link)
+		attachmentID, err := strconv.ParseInt(match[3], 10, 64)
+		if err != nil || !attachmentIDs[attachmentID] {
+			return link
+		}
+
+		if match[1] == "!" {
+			return ""
+		}
+
+		return match[2]
+	})
+}
+
+// parseAttachmentReference tells whether the destination references an attachment and returns the attachment ID,
+// which is zero when the reference is malformed
+func parseAttachmentReference(dest string) (int64, bool) {
+	scheme, id, found := strings.Cut(dest, ":")
+	if !found || !strings.EqualFold(scheme, AttachmentScheme) {
+		return 0, false
+	}
+
+	attachmentID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || attachmentID < 1 {
+		return 0, true
+	}
+
+	return attachmentID, true
+}
+
+// ToHTML renders the Markdown source to sanitized HTML
+func ToHTML(source string) string {
+	html, _ := ToHTMLWithAttachments(source, nil)
+	return html
+}
+
+// ToHTMLWithAttachments renders the Markdown source to sanitized HTML, replacing the links and images referencing
+// attachments with their URL; it also returns the references which couldn't be resolved, whose links are rendered
+// as plain text and images are dropped
+func ToHTMLWithAttachments(source string, resolve AttachmentResolver) (string, []string) {
+	r := renderer{
+		HTMLRenderer: blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{
+			Flags: htmlFlags,
+		}),
+		resolved: map[*blackfriday.Node]bool{},
+	}
+
+	parser := blackfriday.New(
+		blackfriday.WithRenderer(r),
+		blackfriday.WithExtensions(blackfriday.CommonExtensions),
+	)
+	ast := parser.Parse([]byte(source))
+
+	broken := []string{}
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering || (node.Type != blackfriday.Link && node.Type != blackfriday.Image) {
+			return blackfriday.GoToNext
+		}
+
+		dest := string(node.LinkData.Destination)
+		attachmentID, isReference := parseAttachmentReference(dest)
+		if !isReference {
+			return blackfriday.GoToNext
+		}
+
+		if attachmentID > 0 && resolve != nil {
+			if attachmentURL, ok := resolve(attachmentID); ok {
+				node.LinkData.Destination = []byte(attachmentURL)
+				r.resolved[node] = true
+				return blackfriday.GoToNext
+			}
+		}
+
+		broken = append(broken, dest)
+		return blackfriday.GoToNext
+	})
+
+	var buf bytes.Buffer
+	r.RenderHeader(&buf, ast)
+	ast.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		return r.RenderNode(&buf, node, entering)
+	})
+	r.RenderFooter(&buf, ast)
+
+	return buf.String(), broken
+}
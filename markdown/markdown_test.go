@@ -0,0 +1,147 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToHTML(t *testing.T) {
+	tcs := []struct {
+		name        string
+		source      string
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:     "Emphasis",
+			source:   "some **bold** and _italic_ text",
+			contains: []string{"<strong>bold</strong>", "<em>italic</em>"},
+		},
+		{
+			name:     "Link",
+			source:   "[docs](https://example.com)",
+			contains: []string{`href="https://example.com"`, `rel="nofollow noreferrer noopener"`, `target="_blank"`},
+		},
+		{
+			name:        "RawHTML",
+			source:      "hello <script>alert(1)</script> <b onclick=\"x()\">there</b>",
+			notContains: []string{"<script>", "onclick"},
+		},
+		{
+			name:        "ScriptLink",
+			source:      "[click](javascript:alert(1))",
+			notContains: []string{"href"},
+		},
+		{
+			name:     "Image",
+			source:   "![logo](https://example.com/logo.png)",
+			contains: []string{`<img src="https://example.com/logo.png" alt="logo" />`},
+		},
+		{
+			name:        "ScriptImage",
+			source:      "![logo](javascript:alert(1))",
+			notContains: []string{"<img", "javascript"},
+		},
+		{
+			name:     "EscapedText",
+			source:   "1 < 2 & 3 > 2",
+			contains: []string{"1 &lt; 2 &amp; 3 &gt; 2"},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			html := ToHTML(tc.source)
+			for _, s := range tc.contains {
+				require.Contains(t, html, s)
+			}
+			for _, s := range tc.notContains {
+				require.NotContains(t, html, s)
+			}
+		})
+	}
+}
+
+func TestToHTMLWithAttachments(t *testing.T) {
+	resolve := func(attachmentID int64) (string, bool) {
+		if attachmentID == 42 {
+			return "/todos/1/attachments/42", true
+		}
+		return "", false
+	}
+
+	tcs := []struct {
+		name           string
+		source         string
+		contains       []string
+		notContains    []string
+		expectedBroken []string
+	}{
+		{
+			name:           "Image",
+			source:         "![diagram](attachment:42)",
+			contains:       []string{`<img src="/todos/1/attachments/42" alt="diagram" />`},
+			expectedBroken: []string{},
+		},
+		{
+			name:           "Link",
+			source:         "[spec](attachment:42)",
+			contains:       []string{`href="/todos/1/attachments/42"`},
+			expectedBroken: []string{},
+		},
+		{
+			name:           "UnknownAttachment",
+			source:         "![diagram](attachment:7) and [spec](attachment:7)",
+			notContains:    []string{"<img", "href", "attachment:7"},
+			expectedBroken: []string{"attachment:7", "attachment:7"},
+		},
+		{
+			name:           "MalformedReference",
+			source:         "![diagram](attachment:abc)",
+			notContains:    []string{"<img"},
+			expectedBroken: []string{"attachment:abc"},
+		},
+		{
+			name:           "NoReferences",
+			source:         "![logo](https://example.com/logo.png)",
+			contains:       []string{`<img src="https://example.com/logo.png" alt="logo" />`},
+			expectedBroken: []string{},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			html, broken := ToHTMLWithAttachments(tc.source, resolve)
+			for _, s := range tc.contains {
+				require.Contains(t, html, s)
+			}
+			for _, s := range tc.notContains {
+				require.NotContains(t, html, s)
+			}
+			require.Equal(t, tc.expectedBroken, broken)
+		})
+	}
+}
+
+func TestToHTMLDropsAttachmentReferences(t *testing.T) {
+	require.NotContains(t, ToHTML("![diagram](attachment:42)"), "<img")
+	require.True(t, HasAttachmentReferences("see ![diagram](Attachment:42)"))
+	require.False(t, HasAttachmentReferences("plain text"))
+}
+
+func TestRewriteAttachmentReferences(t *testing.T) {
+	source := "![diagram](attachment:4) and [notes](Attachment:42), [other](attachment:7)"
+	rewritten := RewriteAttachmentReferences(source, map[int64]int64{4: 10, 42: 11})
+	require.Equal(t, "![diagram](attachment:10) and [notes](Attachment:11), [other](attachment:7)", rewritten)
+
+	require.Equal(t, source, RewriteAttachmentReferences(source, nil))
+}
+
+func TestStripAttachmentReferences(t *testing.T) {
+	source := "See ![diagram](attachment:4) and [the notes](Attachment:42 \"Notes\"), [other](attachment:7)"
+	stripped := StripAttachmentReferences(source, map[int64]bool{4: true, 42: true})
+	require.Equal(t, "See  and the notes, [other](attachment:7)", stripped)
+
+	require.Equal(t, source, StripAttachmentReferences(source, nil))
+}
@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	asyncDispatchQueueSize = 256
+	// Time given to each notification, from listing its recipients to notifying them
+	asyncDispatchTimeout = 15 * time.Second
+)
+
+type queuedNotification struct {
+	notification Notification
+	actor        string
+}
+
+// AsyncDispatcher hands the notifications over to a background worker, so that slow notifiers don't hold up the
+// requests making the changes; notifications are dropped when the queue is full
+type AsyncDispatcher struct {
+	dispatcher Dispatcher
+	queue      chan queuedNotification
+	timeout    time.Duration
+	logger     *zap.Logger
+}
+
+func NewAsyncDispatcher(dispatcher Dispatcher, logger *zap.Logger) *AsyncDispatcher {
+	return &AsyncDispatcher{
+		dispatcher: dispatcher,
+		queue:      make(chan queuedNotification, asyncDispatchQueueSize),
+		timeout:    asyncDispatchTimeout,
+		logger:     logger,
+	}
+}
+
+// Dispatch queues the notification without waiting for it; the context isn't used past the call, as it usually
+// ends with the request
+func (dispatcher *AsyncDispatcher) Dispatch(ctx context.Context, n Notification, actor string) {
+	select {
+	case dispatcher.queue <- queuedNotification{notification: n, actor: actor}:
+	default:
+		dispatcher.logger.Error("Notification queue full, dropping notification", zap.Int64("todo_id", n.TodoID))
+	}
+}
+
+// Run dispatches the queued notifications one at a time until the context is cancelled
+func (dispatcher *AsyncDispatcher) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case queued := <-dispatcher.queue:
+			dispatcher.dispatch(ctx, queued)
+		}
+	}
+}
+
+func (dispatcher *AsyncDispatcher) dispatch(ctx context.Context, queued queuedNotification) {
+	ctx, cancel := context.WithTimeout(ctx, dispatcher.timeout)
+	defer cancel()
+
+	dispatcher.dispatcher.Dispatch(ctx, queued.notification, queued.actor)
+}
@@ -0,0 +1,56 @@
+package notification
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+)
+
+// Dispatcher delivers notifications about the changes of a todo to the users concerned by it
+type Dispatcher interface {
+	// Dispatch notifies the users assigned to or watching the todo, except the actor who made the change
+	Dispatch(ctx context.Context, notification Notification, actor string)
+}
+
+// RecipientLister lists the users concerned by the changes of a todo
+type RecipientLister interface {
+	ListTodoRecipients(ctx context.Context, todoID int64) ([]string, error)
+}
+
+// TodoDispatcher dispatches the notifications to the assignees and watchers of the todo through a notifier
+type TodoDispatcher struct {
+	recipients RecipientLister
+	notifier   Notifier
+	logger     *zap.Logger
+}
+
+func NewTodoDispatcher(recipients RecipientLister, notifier Notifier, logger *zap.Logger) *TodoDispatcher {
+	return &TodoDispatcher{
+		recipients: recipients,
+		notifier:   notifier,
+		logger:     logger,
+	}
+}
+
+// Dispatch logs failures instead of returning them, as the change was already made when it's notified
+func (dispatcher *TodoDispatcher) Dispatch(ctx context.Context, n Notification, actor string) {
+	recipients, err := dispatcher.recipients.ListTodoRecipients(ctx, n.TodoID)
+	if err != nil {
+		dispatcher.logger.Error("Failed to list notification recipients", zap.Int64("todo_id", n.TodoID), zap.Error(err))
+		return
+	}
+
+	// Users aren't notified about their own changes
+	recipients = slices.DeleteFunc(recipients, func(recipient string) bool {
+		return recipient == actor
+	})
+	if len(recipients) == 0 {
+		return
+	}
+
+	n.Recipients = recipients
+	if err := dispatcher.notifier.Notify(ctx, n); err != nil {
+		dispatcher.logger.Error("Failed to dispatch notification", zap.Int64("todo_id", n.TodoID), zap.Error(err))
+	}
+}
@@ -0,0 +1,157 @@
+package notification_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	"github.com/jaingounchained/todo/notification"
+	mockNotification "github.com/jaingounchained/todo/notification/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestTodoDispatcherDispatch(t *testing.T) {
+	n := notification.Notification{
+		TodoID:  util.RandomInt(1, 1000),
+		Subject: util.RandomString(10),
+		Message: util.RandomString(20),
+	}
+	actor := util.RandomString(8)
+	assignee := util.RandomString(8)
+	watcher := util.RandomString(8)
+
+	withRecipients := func(recipients ...string) notification.Notification {
+		notified := n
+		notified.Recipients = recipients
+		return notified
+	}
+
+	tcs := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier) {
+				store.EXPECT().
+					ListTodoRecipients(gomock.Any(), gomock.Eq(n.TodoID)).
+					Times(1).
+					Return([]string{assignee, watcher}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Eq(withRecipients(assignee, watcher))).Times(1).Return(nil)
+			},
+		},
+		{
+			name: "ActorNotNotified",
+			buildStubs: func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier) {
+				store.EXPECT().
+					ListTodoRecipients(gomock.Any(), gomock.Eq(n.TodoID)).
+					Times(1).
+					Return([]string{actor, watcher}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Eq(withRecipients(watcher))).Times(1).Return(nil)
+			},
+		},
+		{
+			name: "OnlyActor",
+			buildStubs: func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier) {
+				store.EXPECT().
+					ListTodoRecipients(gomock.Any(), gomock.Eq(n.TodoID)).
+					Times(1).
+					Return([]string{actor}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "NoRecipients",
+			buildStubs: func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier) {
+				store.EXPECT().
+					ListTodoRecipients(gomock.Any(), gomock.Eq(n.TodoID)).
+					Times(1).
+					Return([]string{}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "StoreFailure",
+			buildStubs: func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier) {
+				store.EXPECT().
+					ListTodoRecipients(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "NotifierFailure",
+			buildStubs: func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier) {
+				store.EXPECT().
+					ListTodoRecipients(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]string{assignee}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("notifier failure"))
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			notifier := mockNotification.NewMockNotifier(ctrl)
+			tc.buildStubs(store, notifier)
+
+			dispatcher := notification.NewTodoDispatcher(store, notifier, zap.NewNop())
+			dispatcher.Dispatch(context.Background(), n, actor)
+		})
+	}
+}
+
+func TestAsyncDispatcherDispatch(t *testing.T) {
+	n := notification.Notification{
+		TodoID:  util.RandomInt(1, 1000),
+		Subject: util.RandomString(10),
+		Message: util.RandomString(20),
+	}
+	actor := util.RandomString(8)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dispatched := make(chan error, 1)
+	inner := mockNotification.NewMockDispatcher(ctrl)
+	inner.EXPECT().
+		Dispatch(gomock.Any(), gomock.Eq(n), gomock.Eq(actor)).
+		Times(1).
+		Do(func(ctx context.Context, _ notification.Notification, _ string) {
+			if _, ok := ctx.Deadline(); !ok {
+				dispatched <- errors.New("notification dispatched without deadline")
+				return
+			}
+			dispatched <- ctx.Err()
+		})
+
+	runCtx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	dispatcher := notification.NewAsyncDispatcher(inner, zap.NewNop())
+	go dispatcher.Run(runCtx)
+
+	// The notification outlives the context it was dispatched with, and gets a deadline of its own
+	requestCtx, cancel := context.WithCancel(context.Background())
+	dispatcher.Dispatch(requestCtx, n, actor)
+	cancel()
+
+	select {
+	case err := <-dispatched:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("notification not dispatched")
+	}
+}
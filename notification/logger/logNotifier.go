@@ -0,0 +1,30 @@
+package notification
+
+import (
+	"context"
+
+	notification "github.com/jaingounchained/todo/notification"
+	"go.uber.org/zap"
+)
+
+// LogNotifier delivers notifications by writing them to the application log
+type LogNotifier struct {
+	logger *zap.Logger
+}
+
+func New(logger *zap.Logger) *LogNotifier {
+	return &LogNotifier{
+		logger: logger,
+	}
+}
+
+func (notifier *LogNotifier) Notify(ctx context.Context, n notification.Notification) error {
+	notifier.logger.Info("Notification",
+		zap.Int64("todo_id", n.TodoID),
+		zap.String("subject", n.Subject),
+		zap.String("message", n.Message),
+		zap.Strings("recipients", n.Recipients),
+	)
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package notification
+
+import "context"
+
+type Notification struct {
+	TodoID  int64  `json:"todoId"`
+	Subject string `json:"subject"`
+	Message string `json:"message"`
+	// Users the notification is meant for; empty for notifications about the todo itself, such as reminders
+	Recipients []string `json:"recipients,omitempty"`
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	notification "github.com/jaingounchained/todo/notification"
+)
+
+const requestTimeout = 10 * time.Second
+
+// WebhookNotifier delivers notifications by POSTing them as JSON to a URL
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func New(webhookURL string) (*WebhookNotifier, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("Webhook URL must be an http(s) URL")
+	}
+
+	return &WebhookNotifier{
+		url: webhookURL,
+		client: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}, nil
+}
+
+func (notifier *WebhookNotifier) Notify(ctx context.Context, n notification.Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := notifier.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return newWebhookResponseStatusError(response.StatusCode)
+	}
+
+	return nil
+}
+
+type WebhookResponseStatusError error
+
+func newWebhookResponseStatusError(status int) WebhookResponseStatusError {
+	return fmt.Errorf("Webhook responded with non success status: %d", status)
+}
@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	notification "github.com/jaingounchained/todo/notification"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWebhookNotifierInvalidURL(t *testing.T) {
+	_, err := New("ftp://example.com")
+	require.Error(t, err)
+}
+
+func TestNotify(t *testing.T) {
+	n := notification.Notification{
+		TodoID:  util.RandomInt(1, 1000),
+		Subject: util.RandomString(10),
+		Message: util.RandomString(20),
+	}
+
+	tcs := []struct {
+		name          string
+		status        int
+		errorExpected bool
+	}{
+		{
+			name:   "OK",
+			status: http.StatusOK,
+		},
+		{
+			name:          "NonSuccessStatus",
+			status:        http.StatusInternalServerError,
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var received notification.Notification
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			notifier, err := New(server.URL)
+			require.NoError(t, err)
+
+			err = notifier.Notify(context.Background(), n)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, n, received)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package placeholder substitutes the {{name}} placeholders of template texts, such as {{date}},
+// with their values when a template gets instantiated
+package placeholder
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// pattern matches a placeholder, allowing spaces around its name as in {{ date }}
+var pattern = regexp.MustCompile(`\{\{\s*([A-Za-z][A-Za-z0-9_]*)\s*\}\}`)
+
+// UnknownPlaceholderError is returned when a text holds a placeholder without a value
+type UnknownPlaceholderError struct {
+	Name string
+}
+
+func (err *UnknownPlaceholderError) Error() string {
+	return fmt.Sprintf("placeholder '{{%s}}' has no value", err.Name)
+}
+
+// Builtins returns the values of the placeholders available in every template, for the instant in its location
+func Builtins(now time.Time) map[string]string {
+	return map[string]string{
+		"date":    now.Format("2006-01-02"),
+		"time":    now.Format("15:04"),
+		"weekday": now.Weekday().String(),
+		"month":   now.Month().String(),
+		"year":    now.Format("2006"),
+	}
+}
+
+// Expand replaces each placeholder of the text with its value; values aren't expanded themselves
+func Expand(text string, values map[string]string) (string, error) {
+	var err error
+	expanded := pattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := pattern.FindStringSubmatch(match)[1]
+		value, ok := values[name]
+		if !ok && err == nil {
+			err = &UnknownPlaceholderError{Name: name}
+		}
+
+		return value
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return expanded, nil
+}
+
+// ExpandAll expands each of the texts, see Expand
+func ExpandAll(texts []string, values map[string]string) ([]string, error) {
+	expanded := make([]string, 0, len(texts))
+	for _, text := range texts {
+		e, err := Expand(text, values)
+		if err != nil {
+			return nil, err
+		}
+
+		expanded = append(expanded, e)
+	}
+
+	return expanded, nil
+}
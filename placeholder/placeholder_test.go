@@ -0,0 +1,93 @@
+package placeholder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpand(t *testing.T) {
+	values := map[string]string{
+		"date":    "2024-05-06",
+		"release": "v1.2",
+		"nested":  "{{date}}",
+	}
+
+	tcs := []struct {
+		name         string
+		text         string
+		expected     string
+		errorMessage string
+	}{
+		{
+			name:     "NoPlaceholder",
+			text:     "Onboarding checklist",
+			expected: "Onboarding checklist",
+		},
+		{
+			name:     "Placeholders",
+			text:     "Release {{release}} on {{date}}",
+			expected: "Release v1.2 on 2024-05-06",
+		},
+		{
+			name:     "Spaces",
+			text:     "Release {{ release }}",
+			expected: "Release v1.2",
+		},
+		{
+			name:     "ValuesNotExpanded",
+			text:     "{{nested}}",
+			expected: "{{date}}",
+		},
+		{
+			name:     "NotAPlaceholder",
+			text:     "{{1}} {date} {{}}",
+			expected: "{{1}} {date} {{}}",
+		},
+		{
+			name:         "UnknownPlaceholder",
+			text:         "Hello {{name}} on {{other}}",
+			errorMessage: "placeholder '{{name}}' has no value",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			expanded, err := Expand(tc.text, values)
+			if tc.errorMessage != "" {
+				require.EqualError(t, err, tc.errorMessage)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, expanded)
+		})
+	}
+}
+
+func TestExpandAll(t *testing.T) {
+	values := map[string]string{"name": "Alice"}
+
+	expanded, err := ExpandAll([]string{"Welcome {{name}}", "Laptop"}, values)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Welcome Alice", "Laptop"}, expanded)
+
+	_, err = ExpandAll([]string{"Laptop", "{{unknown}}"}, values)
+	require.Error(t, err)
+}
+
+func TestBuiltins(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+
+	// Still the 5th in UTC, already the 6th in Kolkata
+	now := time.Date(2024, time.May, 5, 20, 0, 0, 0, time.UTC).In(loc)
+
+	builtins := Builtins(now)
+	require.Equal(t, "2024-05-06", builtins["date"])
+	require.Equal(t, "01:30", builtins["time"])
+	require.Equal(t, "Monday", builtins["weekday"])
+	require.Equal(t, "May", builtins["month"])
+	require.Equal(t, "2024", builtins["year"])
+}
@@ -0,0 +1,276 @@
+package rrule
+
+import (
+	"sort"
+	"time"
+)
+
+// Next returns the first occurrence of the series starting at dtstart that is strictly after the
+// given time, or false once the series has ended. DTSTART is always the first occurrence, and
+// every occurrence keeps the wall clock time of dtstart in its location
+func (rule Rule) Next(dtstart, after time.Time) (time.Time, bool) {
+	until := rule.until(dtstart.Location())
+	if until != nil && dtstart.After(*until) {
+		return time.Time{}, false
+	}
+	if dtstart.After(after) {
+		return dtstart, true
+	}
+
+	count := 1
+	for period := 0; period < maxPeriods; period++ {
+		for _, occurrence := range rule.periodOccurrences(dtstart, period) {
+			if !occurrence.After(dtstart) {
+				continue
+			}
+			if until != nil && occurrence.After(*until) {
+				return time.Time{}, false
+			}
+
+			count++
+			if rule.Count > 0 && count > rule.Count {
+				return time.Time{}, false
+			}
+
+			if occurrence.After(after) {
+				return occurrence, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (rule Rule) until(loc *time.Location) *time.Time {
+	if rule.Until == nil || !rule.untilFloating {
+		return rule.Until
+	}
+
+	u := rule.Until
+	until := time.Date(u.Year(), u.Month(), u.Day(), u.Hour(), u.Minute(), u.Second(), 0, loc)
+	return &until
+}
+
+// periodOccurrences returns the sorted occurrences within the period-th interval after dtstart
+func (rule Rule) periodOccurrences(dtstart time.Time, period int) []time.Time {
+	year, month, day := dtstart.Date()
+	step := period * rule.Interval
+
+	// Day arithmetic happens in UTC so DST transitions don't shift dates
+	var days []time.Time
+	switch rule.Freq {
+	case Daily:
+		date := time.Date(year, month, day+step, 0, 0, 0, 0, time.UTC)
+		if rule.matchesDate(date) {
+			days = append(days, date)
+		}
+	case Weekly:
+		date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		monday := date.AddDate(0, 0, -(int(date.Weekday())+6)%7+7*step)
+		for i := 0; i < 7; i++ {
+			date := monday.AddDate(0, 0, i)
+			if rule.matchesWeekday(date, dtstart.Weekday()) && rule.matchesMonth(date.Month()) {
+				days = append(days, date)
+			}
+		}
+	case Monthly:
+		first := time.Date(year, month+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		if rule.matchesMonth(first.Month()) {
+			days = rule.monthDays(first.Year(), first.Month(), day)
+		}
+	case Yearly:
+		year += step
+		if len(rule.ByMonth) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByDay) > 0 {
+			// BYDAY ordinals are within the year when no month is given
+			days = weekdaysWithin(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC), rule.ByDay)
+			break
+		}
+
+		months := rule.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{month}
+		}
+		for _, month := range months {
+			days = append(days, rule.monthDays(year, month, day)...)
+		}
+	}
+
+	days = sortedUnique(days)
+	days = rule.applySetPos(days)
+
+	hour, minute, second := dtstart.Clock()
+	occurrences := make([]time.Time, 0, len(days))
+	for _, date := range days {
+		occurrences = append(occurrences, time.Date(date.Year(), date.Month(), date.Day(), hour, minute, second, 0, dtstart.Location()))
+	}
+
+	return occurrences
+}
+
+func (rule Rule) matchesDate(date time.Time) bool {
+	if !rule.matchesMonth(date.Month()) {
+		return false
+	}
+
+	if len(rule.ByMonthDay) > 0 {
+		matched := false
+		n := daysIn(date.Year(), date.Month())
+		for _, monthDay := range rule.ByMonthDay {
+			if resolveMonthDay(monthDay, n) == date.Day() {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return len(rule.ByDay) == 0 || rule.matchesWeekday(date, date.Weekday())
+}
+
+// matchesWeekday reports whether the date falls on one of the BYDAY weekdays, or on the
+// default weekday when BYDAY isn't given
+func (rule Rule) matchesWeekday(date time.Time, defaultWeekday time.Weekday) bool {
+	if len(rule.ByDay) == 0 {
+		return date.Weekday() == defaultWeekday
+	}
+
+	for _, day := range rule.ByDay {
+		if day.Weekday == date.Weekday() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (rule Rule) matchesMonth(month time.Month) bool {
+	if len(rule.ByMonth) == 0 {
+		return true
+	}
+
+	for _, m := range rule.ByMonth {
+		if m == month {
+			return true
+		}
+	}
+
+	return false
+}
+
+// monthDays expands BYMONTHDAY and BYDAY within the month, falling back to the day of DTSTART
+func (rule Rule) monthDays(year int, month time.Month, defaultDay int) []time.Time {
+	n := daysIn(year, month)
+
+	var byMonthDay []time.Time
+	for _, monthDay := range rule.ByMonthDay {
+		if day := resolveMonthDay(monthDay, n); day > 0 {
+			byMonthDay = append(byMonthDay, time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+		}
+	}
+
+	var byDay []time.Time
+	if len(rule.ByDay) > 0 {
+		byDay = weekdaysWithin(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC), rule.ByDay)
+	}
+
+	switch {
+	case len(rule.ByMonthDay) > 0 && len(rule.ByDay) > 0:
+		return intersect(byMonthDay, byDay)
+	case len(rule.ByMonthDay) > 0:
+		return byMonthDay
+	case len(rule.ByDay) > 0:
+		return byDay
+	case defaultDay <= n:
+		return []time.Time{time.Date(year, month, defaultDay, 0, 0, 0, 0, time.UTC)}
+	}
+
+	return nil
+}
+
+// weekdaysWithin returns the days in [start, end) matching the BYDAY entries, ordinals counting within the range
+func weekdaysWithin(start, end time.Time, byDay []WeekdayNum) []time.Time {
+	var days []time.Time
+	for _, day := range byDay {
+		var matches []time.Time
+		for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+			if date.Weekday() == day.Weekday {
+				matches = append(matches, date)
+			}
+		}
+
+		switch {
+		case day.N == 0:
+			days = append(days, matches...)
+		case day.N > 0 && day.N <= len(matches):
+			days = append(days, matches[day.N-1])
+		case day.N < 0 && -day.N <= len(matches):
+			days = append(days, matches[len(matches)+day.N])
+		}
+	}
+
+	return days
+}
+
+func (rule Rule) applySetPos(days []time.Time) []time.Time {
+	if len(rule.BySetPos) == 0 {
+		return days
+	}
+
+	var selected []time.Time
+	for _, pos := range rule.BySetPos {
+		index := pos - 1
+		if pos < 0 {
+			index = len(days) + pos
+		}
+		if index >= 0 && index < len(days) {
+			selected = append(selected, days[index])
+		}
+	}
+
+	return sortedUnique(selected)
+}
+
+// resolveMonthDay turns a possibly negative BYMONTHDAY into a day of the month, 0 if the month is too short
+func resolveMonthDay(monthDay, daysInMonth int) int {
+	if monthDay < 0 {
+		monthDay = daysInMonth + monthDay + 1
+	}
+	if monthDay < 1 || monthDay > daysInMonth {
+		return 0
+	}
+
+	return monthDay
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func intersect(a, b []time.Time) []time.Time {
+	var days []time.Time
+	for _, x := range a {
+		for _, y := range b {
+			if x.Equal(y) {
+				days = append(days, x)
+				break
+			}
+		}
+	}
+
+	return days
+}
+
+func sortedUnique(days []time.Time) []time.Time {
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	unique := days[:0]
+	for i, day := range days {
+		if i == 0 || !day.Equal(days[i-1]) {
+			unique = append(unique, day)
+		}
+	}
+
+	return unique
+}
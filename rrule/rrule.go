@@ -0,0 +1,226 @@
+// Package rrule implements the subset of RFC 5545 recurrence rules needed for recurring todos:
+// FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, COUNT, UNTIL, BYDAY (with ordinals),
+// BYMONTHDAY, BYMONTH, BYSETPOS and WKST=MO
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// maxPeriods bounds the search for the next occurrence, so rules that can never match
+// (e.g. BYMONTH=2;BYMONTHDAY=30) terminate
+const maxPeriods = 10000
+
+var (
+	ErrEmptyRule       = errors.New("rrule: empty rule")
+	ErrMissingFreq     = errors.New("rrule: FREQ is required")
+	ErrCountWithUntil  = errors.New("rrule: COUNT and UNTIL can't be used together")
+	ErrInvalidInterval = errors.New("rrule: INTERVAL must be a positive integer")
+)
+
+// WeekdayNum is a BYDAY entry; N is the ordinal of the weekday within the month or year
+// (1 first, -1 last) and 0 means every such weekday
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	BySetPos   []int
+
+	// UNTIL without a UTC designator is a wall clock time in the timezone of DTSTART
+	untilFloating bool
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var frequencies = map[string]Frequency{
+	"DAILY":   Daily,
+	"WEEKLY":  Weekly,
+	"MONTHLY": Monthly,
+	"YEARLY":  Yearly,
+}
+
+// Parse parses a recurrence rule such as "FREQ=WEEKLY;BYDAY=MO,WE", with or without the "RRULE:" prefix
+func Parse(s string) (Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return Rule{}, ErrEmptyRule
+	}
+
+	rule := Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return Rule{}, fmt.Errorf("rrule: invalid part %q", part)
+		}
+		name = strings.ToUpper(name)
+		value = strings.ToUpper(value)
+		if seen[name] {
+			return Rule{}, fmt.Errorf("rrule: %s specified more than once", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			freq, ok := frequencies[value]
+			if !ok {
+				return Rule{}, fmt.Errorf("rrule: unsupported FREQ %q", value)
+			}
+			rule.Freq = freq
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(value)
+			if err != nil || rule.Interval < 1 {
+				return Rule{}, ErrInvalidInterval
+			}
+		case "COUNT":
+			rule.Count, err = strconv.Atoi(value)
+			if err != nil || rule.Count < 1 {
+				return Rule{}, errors.New("rrule: COUNT must be a positive integer")
+			}
+		case "UNTIL":
+			err = rule.parseUntil(value)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseIntList(value, "BYMONTHDAY", 1, 31)
+		case "BYMONTH":
+			var months []int
+			months, err = parseIntList(value, "BYMONTH", 1, 12)
+			for _, month := range months {
+				if month < 0 {
+					return Rule{}, errors.New("rrule: BYMONTH must be between 1 and 12")
+				}
+				rule.ByMonth = append(rule.ByMonth, time.Month(month))
+			}
+		case "BYSETPOS":
+			rule.BySetPos, err = parseIntList(value, "BYSETPOS", 1, 366)
+		case "WKST":
+			if value != "MO" {
+				return Rule{}, errors.New("rrule: only WKST=MO is supported")
+			}
+		default:
+			return Rule{}, fmt.Errorf("rrule: unsupported part %s", name)
+		}
+		if err != nil {
+			return Rule{}, err
+		}
+	}
+
+	if !seen["FREQ"] {
+		return Rule{}, ErrMissingFreq
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return Rule{}, ErrCountWithUntil
+	}
+	for _, day := range rule.ByDay {
+		if day.N != 0 && rule.Freq != Monthly && rule.Freq != Yearly {
+			return Rule{}, errors.New("rrule: BYDAY ordinals are only allowed with FREQ=MONTHLY or FREQ=YEARLY")
+		}
+	}
+	if len(rule.ByMonthDay) > 0 && rule.Freq == Weekly {
+		return Rule{}, errors.New("rrule: BYMONTHDAY can't be used with FREQ=WEEKLY")
+	}
+
+	return rule, nil
+}
+
+func (rule *Rule) parseUntil(value string) error {
+	layouts := []struct {
+		layout   string
+		floating bool
+	}{
+		{"20060102T150405Z", false},
+		{"20060102T150405", true},
+		{"20060102", true},
+	}
+	for _, l := range layouts {
+		until, err := time.Parse(l.layout, value)
+		if err != nil {
+			continue
+		}
+		if l.layout == "20060102" {
+			// A date is inclusive of the whole day
+			until = until.Add(24*time.Hour - time.Second)
+		}
+		rule.Until = &until
+		rule.untilFloating = l.floating
+		return nil
+	}
+
+	return fmt.Errorf("rrule: invalid UNTIL %q", value)
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("rrule: invalid BYDAY %q", item)
+		}
+		weekday, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("rrule: invalid BYDAY %q", item)
+		}
+
+		n := 0
+		if ordinal := item[:len(item)-2]; ordinal != "" {
+			var err error
+			n, err = strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("rrule: invalid BYDAY %q", item)
+			}
+		}
+		days = append(days, WeekdayNum{Weekday: weekday, N: n})
+	}
+
+	return days, nil
+}
+
+// parseIntList parses a comma separated list of non zero integers within [-max, max]
+func parseIntList(value, name string, min, max int) ([]int, error) {
+	var values []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		abs := n
+		if abs < 0 {
+			abs = -abs
+		}
+		if err != nil || abs < min || abs > max {
+			return nil, fmt.Errorf("rrule: invalid %s %q", name, item)
+		}
+		values = append(values, n)
+	}
+
+	return values, nil
+}
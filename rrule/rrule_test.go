@@ -0,0 +1,214 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tcs := []struct {
+		name        string
+		rule        string
+		errExpected bool
+	}{
+		{name: "Weekly", rule: "FREQ=WEEKLY;BYDAY=MO,WE"},
+		{name: "Prefixed", rule: "RRULE:FREQ=DAILY;INTERVAL=2"},
+		{name: "LastBusinessDay", rule: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{name: "Until", rule: "FREQ=DAILY;UNTIL=20300101T000000Z"},
+		{name: "Empty", rule: "", errExpected: true},
+		{name: "MissingFreq", rule: "INTERVAL=2", errExpected: true},
+		{name: "UnsupportedFreq", rule: "FREQ=HOURLY", errExpected: true},
+		{name: "UnsupportedPart", rule: "FREQ=DAILY;BYHOUR=9", errExpected: true},
+		{name: "InvalidInterval", rule: "FREQ=DAILY;INTERVAL=0", errExpected: true},
+		{name: "InvalidByDay", rule: "FREQ=WEEKLY;BYDAY=XX", errExpected: true},
+		{name: "OrdinalInWeekly", rule: "FREQ=WEEKLY;BYDAY=1MO", errExpected: true},
+		{name: "CountWithUntil", rule: "FREQ=DAILY;COUNT=2;UNTIL=20300101", errExpected: true},
+		{name: "DuplicatePart", rule: "FREQ=DAILY;FREQ=WEEKLY", errExpected: true},
+		{name: "InvalidMonth", rule: "FREQ=YEARLY;BYMONTH=13", errExpected: true},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.rule)
+			if tc.errExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	date := func(year int, month time.Month, day, hour int, loc *time.Location) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, loc)
+	}
+
+	tcs := []struct {
+		name     string
+		rule     string
+		dtstart  time.Time
+		after    time.Time
+		expected []time.Time
+	}{
+		{
+			name:    "DailyInterval",
+			rule:    "FREQ=DAILY;INTERVAL=3",
+			dtstart: date(2030, time.January, 30, 9, time.UTC),
+			after:   date(2030, time.January, 30, 9, time.UTC),
+			expected: []time.Time{
+				date(2030, time.February, 2, 9, time.UTC),
+				date(2030, time.February, 5, 9, time.UTC),
+			},
+		},
+		{
+			// 2030-01-07 is a monday
+			name:    "WeeklyMonWed",
+			rule:    "FREQ=WEEKLY;BYDAY=MO,WE",
+			dtstart: date(2030, time.January, 7, 9, time.UTC),
+			after:   date(2030, time.January, 7, 9, time.UTC),
+			expected: []time.Time{
+				date(2030, time.January, 9, 9, time.UTC),
+				date(2030, time.January, 14, 9, time.UTC),
+				date(2030, time.January, 16, 9, time.UTC),
+			},
+		},
+		{
+			name:    "BiweeklyDefaultWeekday",
+			rule:    "FREQ=WEEKLY;INTERVAL=2",
+			dtstart: date(2030, time.January, 9, 9, time.UTC),
+			after:   date(2030, time.January, 9, 9, time.UTC),
+			expected: []time.Time{
+				date(2030, time.January, 23, 9, time.UTC),
+				date(2030, time.February, 6, 9, time.UTC),
+			},
+		},
+		{
+			// 2030-03-31 is a sunday, 2030-05-31 a friday
+			name:    "LastBusinessDayOfMonth",
+			rule:    "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			dtstart: date(2030, time.January, 31, 17, time.UTC),
+			after:   date(2030, time.January, 31, 17, time.UTC),
+			expected: []time.Time{
+				date(2030, time.February, 28, 17, time.UTC),
+				date(2030, time.March, 29, 17, time.UTC),
+				date(2030, time.April, 30, 17, time.UTC),
+				date(2030, time.May, 31, 17, time.UTC),
+			},
+		},
+		{
+			name:    "MonthlySkipsShortMonths",
+			rule:    "FREQ=MONTHLY",
+			dtstart: date(2030, time.January, 31, 9, time.UTC),
+			after:   date(2030, time.January, 31, 9, time.UTC),
+			expected: []time.Time{
+				date(2030, time.March, 31, 9, time.UTC),
+				date(2030, time.May, 31, 9, time.UTC),
+			},
+		},
+		{
+			name:    "MonthlyLastDay",
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart: date(2030, time.January, 31, 9, time.UTC),
+			after:   date(2030, time.January, 31, 9, time.UTC),
+			expected: []time.Time{
+				date(2030, time.February, 28, 9, time.UTC),
+				date(2030, time.March, 31, 9, time.UTC),
+			},
+		},
+		{
+			name:    "MonthlySecondTuesday",
+			rule:    "FREQ=MONTHLY;BYDAY=2TU",
+			dtstart: date(2030, time.January, 8, 9, time.UTC),
+			after:   date(2030, time.January, 8, 9, time.UTC),
+			expected: []time.Time{
+				date(2030, time.February, 12, 9, time.UTC),
+				date(2030, time.March, 12, 9, time.UTC),
+			},
+		},
+		{
+			name:    "YearlyThanksgiving",
+			rule:    "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			dtstart: date(2030, time.November, 28, 12, time.UTC),
+			after:   date(2030, time.November, 28, 12, time.UTC),
+			expected: []time.Time{
+				date(2031, time.November, 27, 12, time.UTC),
+				date(2032, time.November, 25, 12, time.UTC),
+			},
+		},
+		{
+			name:    "Count",
+			rule:    "FREQ=DAILY;COUNT=3",
+			dtstart: date(2030, time.January, 1, 9, time.UTC),
+			after:   date(2030, time.January, 1, 9, time.UTC),
+			expected: []time.Time{
+				date(2030, time.January, 2, 9, time.UTC),
+				date(2030, time.January, 3, 9, time.UTC),
+			},
+		},
+		{
+			name:    "Until",
+			rule:    "FREQ=WEEKLY;UNTIL=20300115",
+			dtstart: date(2030, time.January, 1, 9, time.UTC),
+			after:   date(2030, time.January, 1, 9, time.UTC),
+			expected: []time.Time{
+				date(2030, time.January, 8, 9, time.UTC),
+				date(2030, time.January, 15, 9, time.UTC),
+			},
+		},
+		{
+			name:    "AfterBeforeStart",
+			rule:    "FREQ=DAILY",
+			dtstart: date(2030, time.January, 1, 9, time.UTC),
+			after:   date(2029, time.December, 1, 9, time.UTC),
+			expected: []time.Time{
+				date(2030, time.January, 1, 9, time.UTC),
+				date(2030, time.January, 2, 9, time.UTC),
+			},
+		},
+		{
+			// Wall clock time is kept across the DST change on 2030-03-31
+			name:    "KeepsWallClockAcrossDST",
+			rule:    "FREQ=WEEKLY",
+			dtstart: date(2030, time.March, 24, 9, berlin),
+			after:   date(2030, time.March, 24, 9, berlin),
+			expected: []time.Time{
+				date(2030, time.March, 31, 9, berlin),
+				date(2030, time.April, 7, 9, berlin),
+			},
+		},
+		{
+			name:     "NeverMatches",
+			rule:     "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			dtstart:  date(2030, time.January, 1, 9, time.UTC),
+			after:    date(2030, time.January, 1, 9, time.UTC),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := Parse(tc.rule)
+			require.NoError(t, err)
+
+			after := tc.after
+			for _, expected := range tc.expected {
+				next, ok := rule.Next(tc.dtstart, after)
+				require.True(t, ok)
+				require.True(t, expected.Equal(next), "expected %s, got %s", expected, next)
+				after = next
+			}
+
+			// The series ends once the expected occurrences are exhausted for bounded rules
+			if len(tc.expected) == 0 || rule.Count > 0 || rule.Until != nil {
+				_, ok := rule.Next(tc.dtstart, after)
+				require.False(t, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"go.uber.org/zap"
+)
+
+// ArchiveJob archives the todos which were completed longer than the configured delay ago
+type ArchiveJob struct {
+	store  db.Store
+	after  time.Duration
+	logger *zap.Logger
+}
+
+func NewArchiveJob(store db.Store, after time.Duration, logger *zap.Logger) *ArchiveJob {
+	return &ArchiveJob{
+		store:  store,
+		after:  after,
+		logger: logger,
+	}
+}
+
+func (job *ArchiveJob) Name() string {
+	return "archive"
+}
+
+// Run archives every todo completed before the delay in a single update
+func (job *ArchiveJob) Run(ctx context.Context, now time.Time) error {
+	archived, err := job.store.ArchiveCompletedTodos(ctx, now.Add(-job.after))
+	if err != nil {
+		return err
+	}
+
+	if archived > 0 {
+		job.logger.Info("Archived completed todos", zap.Int64("count", archived))
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestArchiveJobRun(t *testing.T) {
+	now := time.Now()
+	after := 14 * 24 * time.Hour
+
+	tcs := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		errorExpected bool
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ArchiveCompletedTodos(gomock.Any(), gomock.Eq(now.Add(-after))).
+					Times(1).
+					Return(int64(3), nil)
+			},
+		},
+		{
+			name: "StoreFailure",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ArchiveCompletedTodos(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(0), sql.ErrConnDone)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			job := NewArchiveJob(store, after, zap.NewNop())
+			err := job.Run(context.Background(), now)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
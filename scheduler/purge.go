@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	db "github.com/jaingounchained/todo/db/sqlc"
+	storage "github.com/jaingounchained/todo/storage"
+	"go.uber.org/zap"
+)
+
+const purgeBatchSize = 100
+
+// PurgeJob permanently deletes the todos which stayed in the trash longer than the retention period, along with their attachment files
+type PurgeJob struct {
+	store     db.Store
+	storage   storage.Storage
+	retention time.Duration
+	logger    *zap.Logger
+}
+
+func NewPurgeJob(store db.Store, storage storage.Storage, retention time.Duration, logger *zap.Logger) *PurgeJob {
+	return &PurgeJob{
+		store:     store,
+		storage:   storage,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+func (job *PurgeJob) Name() string {
+	return "purge"
+}
+
+// Run purges the expired todos; a todo that fails to be purged is retried on the next run
+func (job *PurgeJob) Run(ctx context.Context, now time.Time) error {
+	todos, err := job.store.ListPurgeableTodos(ctx, db.ListPurgeableTodosParams{
+		DeletedBefore: now.Add(-job.retention),
+		Limit:         purgeBatchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, todo := range todos {
+		err := job.store.DeleteTodoTx(ctx, db.DeleteTodoTxParams{
+			TodoID:  todo.ID,
+			Storage: job.storage,
+		})
+		if err != nil {
+			job.logger.Error("Failed to purge todo", zap.Int64("todo_id", todo.ID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
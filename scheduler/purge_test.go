@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	mockStorage "github.com/jaingounchained/todo/storage/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func randomTrashedTodo(deletedAt time.Time) db.Todo {
+	return db.Todo{
+		ID:        util.RandomInt(1, 1000),
+		Title:     util.RandomString(10),
+		DeletedAt: &deletedAt,
+	}
+}
+
+func TestPurgeJobRun(t *testing.T) {
+	now := time.Now()
+	retention := 30 * 24 * time.Hour
+	todo1 := randomTrashedTodo(now.Add(-retention - time.Hour))
+	todo2 := randomTrashedTodo(now.Add(-retention - time.Minute))
+
+	tcs := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, storage *mockStorage.MockStorage)
+		errorExpected bool
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, storage *mockStorage.MockStorage) {
+				store.EXPECT().
+					ListPurgeableTodos(gomock.Any(), gomock.Eq(db.ListPurgeableTodosParams{DeletedBefore: now.Add(-retention), Limit: purgeBatchSize})).
+					Times(1).
+					Return([]db.Todo{todo1, todo2}, nil)
+				store.EXPECT().
+					DeleteTodoTx(gomock.Any(), gomock.Eq(db.DeleteTodoTxParams{TodoID: todo1.ID, Storage: storage})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					DeleteTodoTx(gomock.Any(), gomock.Eq(db.DeleteTodoTxParams{TodoID: todo2.ID, Storage: storage})).
+					Times(1).
+					Return(nil)
+			},
+		},
+		{
+			name: "PurgeFailureLeavesTodoInTrash",
+			buildStubs: func(store *mockdb.MockStore, storage *mockStorage.MockStorage) {
+				store.EXPECT().
+					ListPurgeableTodos(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Todo{todo1, todo2}, nil)
+				store.EXPECT().
+					DeleteTodoTx(gomock.Any(), gomock.Eq(db.DeleteTodoTxParams{TodoID: todo1.ID, Storage: storage})).
+					Times(1).
+					Return(sql.ErrConnDone)
+				store.EXPECT().
+					DeleteTodoTx(gomock.Any(), gomock.Eq(db.DeleteTodoTxParams{TodoID: todo2.ID, Storage: storage})).
+					Times(1).
+					Return(nil)
+			},
+		},
+		{
+			name: "StoreFailure",
+			buildStubs: func(store *mockdb.MockStore, storage *mockStorage.MockStorage) {
+				store.EXPECT().
+					ListPurgeableTodos(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				store.EXPECT().DeleteTodoTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			storage := mockStorage.NewMockStorage(ctrl)
+			tc.buildStubs(store, storage)
+
+			job := NewPurgeJob(store, storage, retention, zap.NewNop())
+			err := job.Run(context.Background(), now)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
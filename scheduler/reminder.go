@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/jaingounchained/todo/db/sqlc"
+	notification "github.com/jaingounchained/todo/notification"
+	"go.uber.org/zap"
+)
+
+const (
+	reminderBatchSize = 100
+
+	// Delays before retrying a reminder that failed to notify, doubling with each failed attempt up to the max
+	reminderRetryBaseDelay = time.Minute
+	reminderRetryMaxDelay  = time.Hour
+)
+
+// ReminderJob notifies about the reminders whose offset before the todo due date has been reached
+type ReminderJob struct {
+	store    db.Store
+	notifier notification.Notifier
+	logger   *zap.Logger
+}
+
+func NewReminderJob(store db.Store, notifier notification.Notifier, logger *zap.Logger) *ReminderJob {
+	return &ReminderJob{
+		store:    store,
+		notifier: notifier,
+		logger:   logger,
+	}
+}
+
+func (job *ReminderJob) Name() string {
+	return "reminders"
+}
+
+// Run fires the due reminders; a reminder that fails to notify is retried after a backoff, and only once the
+// reminders that never failed have been fired, so that a failing batch doesn't hold back newer reminders
+func (job *ReminderJob) Run(ctx context.Context, now time.Time) error {
+	reminders, err := job.store.ListDueReminders(ctx, db.ListDueRemindersParams{
+		Now:   now,
+		Limit: reminderBatchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, reminder := range reminders {
+		if err := job.notifier.Notify(ctx, newReminderNotification(reminder)); err != nil {
+			job.logger.Error("Failed to notify reminder", zap.Int64("reminder_id", reminder.ID), zap.Error(err))
+
+			err := job.store.MarkReminderFailed(ctx, db.MarkReminderFailedParams{
+				RetryAt: now.Add(reminderRetryDelay(reminder.FailedAttempts)),
+				ID:      reminder.ID,
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := job.store.MarkReminderFired(ctx, db.MarkReminderFiredParams{
+			FiredAt:       now,
+			FiredForDueAt: *reminder.DueAt,
+			ID:            reminder.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reminderRetryDelay returns the backoff before the next attempt of a reminder that already failed the given
+// number of times
+func reminderRetryDelay(failedAttempts int32) time.Duration {
+	delay := reminderRetryBaseDelay
+	for i := int32(0); i < failedAttempts && delay < reminderRetryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, reminderRetryMaxDelay)
+}
+
+func newReminderNotification(reminder db.ListDueRemindersRow) notification.Notification {
+	dueAt := *reminder.DueAt
+	if reminder.DueTimezone != nil {
+		if loc, err := time.LoadLocation(*reminder.DueTimezone); err == nil {
+			dueAt = dueAt.In(loc)
+		}
+	}
+
+	return notification.Notification{
+		TodoID:  reminder.TodoID,
+		Subject: fmt.Sprintf("Reminder: %s", reminder.Title),
+		Message: fmt.Sprintf("Todo '%s' is due at %s", reminder.Title, dueAt.Format(time.RFC1123)),
+	}
+}
@@ -0,0 +1,130 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mockdb "github.com/jaingounchained/todo/db/mock"
+	db "github.com/jaingounchained/todo/db/sqlc"
+	mockNotification "github.com/jaingounchained/todo/notification/mock"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func randomDueReminder() db.ListDueRemindersRow {
+	dueAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	return db.ListDueRemindersRow{
+		ID:            util.RandomInt(1, 1000),
+		TodoID:        util.RandomInt(1, 1000),
+		OffsetMinutes: 60,
+		Title:         util.RandomString(10),
+		DueAt:         &dueAt,
+	}
+}
+
+func TestReminderJobRun(t *testing.T) {
+	now := time.Now()
+	reminder1 := randomDueReminder()
+	reminder2 := randomDueReminder()
+
+	tcs := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier)
+		errorExpected bool
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier) {
+				store.EXPECT().
+					ListDueReminders(gomock.Any(), gomock.Eq(db.ListDueRemindersParams{Now: now, Limit: reminderBatchSize})).
+					Times(1).
+					Return([]db.ListDueRemindersRow{reminder1, reminder2}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Eq(newReminderNotification(reminder1))).Times(1).Return(nil)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Eq(newReminderNotification(reminder2))).Times(1).Return(nil)
+				store.EXPECT().
+					MarkReminderFired(gomock.Any(), gomock.Eq(db.MarkReminderFiredParams{FiredAt: now, FiredForDueAt: *reminder1.DueAt, ID: reminder1.ID})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					MarkReminderFired(gomock.Any(), gomock.Eq(db.MarkReminderFiredParams{FiredAt: now, FiredForDueAt: *reminder2.DueAt, ID: reminder2.ID})).
+					Times(1).
+					Return(nil)
+			},
+		},
+		{
+			name: "NotifierFailureDelaysRetry",
+			buildStubs: func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier) {
+				store.EXPECT().
+					ListDueReminders(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.ListDueRemindersRow{reminder1, reminder2}, nil)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Eq(newReminderNotification(reminder1))).Times(1).Return(errors.New("notifier failure"))
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Eq(newReminderNotification(reminder2))).Times(1).Return(nil)
+				store.EXPECT().
+					MarkReminderFailed(gomock.Any(), gomock.Eq(db.MarkReminderFailedParams{RetryAt: now.Add(reminderRetryBaseDelay), ID: reminder1.ID})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					MarkReminderFired(gomock.Any(), gomock.Eq(db.MarkReminderFiredParams{FiredAt: now, FiredForDueAt: *reminder2.DueAt, ID: reminder2.ID})).
+					Times(1).
+					Return(nil)
+			},
+		},
+		{
+			name: "StoreFailure",
+			buildStubs: func(store *mockdb.MockStore, notifier *mockNotification.MockNotifier) {
+				store.EXPECT().
+					ListDueReminders(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any()).Times(0)
+			},
+			errorExpected: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			notifier := mockNotification.NewMockNotifier(ctrl)
+			tc.buildStubs(store, notifier)
+
+			job := NewReminderJob(store, notifier, zap.NewNop())
+			err := job.Run(context.Background(), now)
+			if tc.errorExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReminderRetryDelay(t *testing.T) {
+	require.Equal(t, time.Minute, reminderRetryDelay(0))
+	require.Equal(t, 2*time.Minute, reminderRetryDelay(1))
+	require.Equal(t, 32*time.Minute, reminderRetryDelay(5))
+	require.Equal(t, reminderRetryMaxDelay, reminderRetryDelay(6))
+	require.Equal(t, reminderRetryMaxDelay, reminderRetryDelay(1000))
+}
+
+func TestNewReminderNotificationUsesDueTimezone(t *testing.T) {
+	reminder := randomDueReminder()
+	timezone := "Asia/Kolkata"
+	reminder.DueTimezone = &timezone
+
+	loc, err := time.LoadLocation(timezone)
+	require.NoError(t, err)
+
+	n := newReminderNotification(reminder)
+	require.Equal(t, reminder.TodoID, n.TodoID)
+	require.Contains(t, n.Message, reminder.DueAt.In(loc).Format(time.RFC1123))
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Job is a unit of background work run periodically by the scheduler
+type Job interface {
+	Name() string
+	Run(ctx context.Context, now time.Time) error
+}
+
+type scheduledJob struct {
+	job      Job
+	interval time.Duration
+}
+
+// Scheduler runs registered jobs at their interval until its context is cancelled
+type Scheduler struct {
+	jobs   []scheduledJob
+	logger *zap.Logger
+}
+
+func New(logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		logger: logger,
+	}
+}
+
+// Register adds a job to the scheduler; jobs with a non positive interval are disabled
+func (scheduler *Scheduler) Register(job Job, interval time.Duration) {
+	if interval <= 0 {
+		scheduler.logger.Info("Background job disabled", zap.String("job", job.Name()))
+		return
+	}
+
+	scheduler.jobs = append(scheduler.jobs, scheduledJob{
+		job:      job,
+		interval: interval,
+	})
+}
+
+// Start runs every registered job in its own goroutine
+func (scheduler *Scheduler) Start(ctx context.Context) {
+	for _, j := range scheduler.jobs {
+		go scheduler.run(ctx, j)
+	}
+}
+
+func (scheduler *Scheduler) run(ctx context.Context, j scheduledJob) {
+	scheduler.logger.Info("Starting background job", zap.String("job", j.job.Name()), zap.Duration("interval", j.interval))
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			scheduler.logger.Info("Stopping background job", zap.String("job", j.job.Name()))
+			return
+		case now := <-ticker.C:
+			if err := j.job.Run(ctx, now); err != nil {
+				scheduler.logger.Error("Background job failed", zap.String("job", j.job.Name()), zap.Error(err))
+			}
+		}
+	}
+}
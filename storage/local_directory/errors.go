@@ -27,3 +27,27 @@ type FileDoesNotExistForTheTodoError error
 func newFileDoesNotExistForTheTodoError(todo int64, filename string) FileDoesNotExistForTheTodoError {
 	return fmt.Errorf("Filename: %s does not exist for the todo: %d", filename, todo)
 }
+
+type LocalDirectoryForTemplateAlreadyExistError error
+
+func newLocalDirectoryForTemplateAlreadyExistError(template int64) LocalDirectoryForTemplateAlreadyExistError {
+	return fmt.Errorf("Local directory already exist for the template: %d", template)
+}
+
+type LocalDirectoryForTemplateDoesNotExistError error
+
+func newLocalDirectoryForTemplateDoesNotExistError(template int64) LocalDirectoryForTemplateDoesNotExistError {
+	return fmt.Errorf("Local directory does not exist for the template: %d", template)
+}
+
+type FileAlreadyExistForTheTemplateError error
+
+func newFileAlreadyExistForTheTemplateError(template int64, filename string) FileAlreadyExistForTheTemplateError {
+	return fmt.Errorf("Filename: %s already exist for the template: %d", filename, template)
+}
+
+type FileDoesNotExistForTheTemplateError error
+
+func newFileDoesNotExistForTheTemplateError(template int64, filename string) FileDoesNotExistForTheTemplateError {
+	return fmt.Errorf("Filename: %s does not exist for the template: %d", filename, template)
+}
@@ -58,6 +58,47 @@ func (storage *LocalStorage) DeleteFile(ctx context.Context, todoID int64, fileN
 	return os.Remove(todoFilePath)
 }
 
+func (storage *LocalStorage) CopyFile(ctx context.Context, srcTodoID int64, srcFileName string, dstTodoID int64, dstFileName string) error {
+	srcFilePath := filepath.Join(storage.todoAbsoluteDirectory(srcTodoID), srcFileName)
+	if !util.FileExists(srcFilePath) {
+		return newFileDoesNotExistForTheTodoError(srcTodoID, srcFileName)
+	}
+
+	dstTodoDirectory := storage.todoAbsoluteDirectory(dstTodoID)
+	if !util.DirExists(dstTodoDirectory) {
+		return newLocalDirectoryForTodoDoesNotExistError(dstTodoID)
+	}
+
+	dstFilePath := filepath.Join(dstTodoDirectory, dstFileName)
+	if util.FileExists(dstFilePath) {
+		return newFileAlreadyExistForTheTodoError(dstTodoID, dstFileName)
+	}
+
+	return copyFile(srcFilePath, dstFilePath)
+}
+
+// copyFile copies the source file to a new destination file, removing it when the copy fails
+func copyFile(srcFilePath, dstFilePath string) error {
+	src, err := os.Open(srcFilePath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(dstFilePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(dst, src); err != nil {
+		dst.Close()
+		os.Remove(dstFilePath)
+		return err
+	}
+
+	return dst.Close()
+}
+
 // TODO: Optimize this function, pass in a byte array instead of returning
 func (storage *LocalStorage) GetFileContents(ctx context.Context, todoID int64, fileName string) ([]byte, error) {
 	todoDirectory := storage.todoAbsoluteDirectory(todoID)
@@ -78,6 +78,34 @@ func TestDeleteFile(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestCopyFile(t *testing.T) {
+	srcTodoID, _ := createTodoDirectory(t)
+	dstTodoID, expectedDstTodoDir := createTodoDirectory(t)
+	srcFileName, dstFileName := util.RandomString(10), util.RandomString(10)
+	fileContents := []byte(util.RandomString(100))
+
+	// Attempt to copy the file before it is created
+	err := localStorageTest.CopyFile(context.Background(), srcTodoID, srcFileName, dstTodoID, dstFileName)
+	require.Error(t, err)
+
+	// Attempt to create the file
+	err = localStorageTest.SaveFile(context.Background(), srcTodoID, srcFileName, fileContents)
+	require.NoError(t, err)
+
+	// Attempt to copy the file
+	err = localStorageTest.CopyFile(context.Background(), srcTodoID, srcFileName, dstTodoID, dstFileName)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(expectedDstTodoDir, dstFileName))
+
+	bytes, err := localStorageTest.GetFileContents(context.Background(), dstTodoID, dstFileName)
+	require.NoError(t, err)
+	require.Equal(t, fileContents, bytes)
+
+	// Attempt to copy the file again
+	err = localStorageTest.CopyFile(context.Background(), srcTodoID, srcFileName, dstTodoID, dstFileName)
+	require.Error(t, err)
+}
+
 func TestGetFileContents(t *testing.T) {
 	todoID, expectedTodoDir := createTodoDirectory(t)
 	fileName, fileContents := util.RandomString(10), []byte(util.RandomString(100))
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	storage "github.com/jaingounchained/todo/storage"
+	"github.com/jaingounchained/todo/util"
+)
+
+// Template directories are kept apart from the todo directories, which are named after the todo ID
+const templatesDirectoryName = "templates"
+
+func (storage *LocalStorage) CreateTemplateDirectory(ctx context.Context, templateID int64) error {
+	templateDirectory := storage.templateAbsoluteDirectory(templateID)
+	if util.DirExists(templateDirectory) {
+		return newLocalDirectoryForTemplateAlreadyExistError(templateID)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(templateDirectory), 0755); err != nil {
+		return err
+	}
+
+	return os.Mkdir(templateDirectory, 0755)
+}
+
+func (storage *LocalStorage) DeleteTemplateDirectory(ctx context.Context, templateID int64) error {
+	templateDirectory := storage.templateAbsoluteDirectory(templateID)
+	if !util.DirExists(templateDirectory) {
+		return newLocalDirectoryForTemplateDoesNotExistError(templateID)
+	}
+
+	return os.RemoveAll(templateDirectory)
+}
+
+// SaveTemplateFiles saves all the files or none of them
+func (storage *LocalStorage) SaveTemplateFiles(ctx context.Context, templateID int64, fileContents storage.FileContents) error {
+	templateDirectory := storage.templateAbsoluteDirectory(templateID)
+	if !util.DirExists(templateDirectory) {
+		return newLocalDirectoryForTemplateDoesNotExistError(templateID)
+	}
+
+	saved := make([]string, 0, len(fileContents))
+	for name, data := range fileContents {
+		filePath := filepath.Join(templateDirectory, name)
+		if util.FileExists(filePath) {
+			removeFiles(saved)
+			return newFileAlreadyExistForTheTemplateError(templateID, name)
+		}
+
+		if err := os.WriteFile(filePath, data, 0644); err != nil {
+			removeFiles(saved)
+			return err
+		}
+
+		saved = append(saved, filePath)
+	}
+
+	return nil
+}
+
+func (storage *LocalStorage) DeleteTemplateFile(ctx context.Context, templateID int64, fileName string) error {
+	templateDirectory := storage.templateAbsoluteDirectory(templateID)
+	if !util.DirExists(templateDirectory) {
+		return newLocalDirectoryForTemplateDoesNotExistError(templateID)
+	}
+
+	templateFilePath := filepath.Join(templateDirectory, fileName)
+	if !util.FileExists(templateFilePath) {
+		return newFileDoesNotExistForTheTemplateError(templateID, fileName)
+	}
+
+	return os.Remove(templateFilePath)
+}
+
+func (storage *LocalStorage) CopyTemplateFile(ctx context.Context, templateID int64, srcFileName string, dstTodoID int64, dstFileName string) error {
+	srcFilePath := filepath.Join(storage.templateAbsoluteDirectory(templateID), srcFileName)
+	if !util.FileExists(srcFilePath) {
+		return newFileDoesNotExistForTheTemplateError(templateID, srcFileName)
+	}
+
+	dstTodoDirectory := storage.todoAbsoluteDirectory(dstTodoID)
+	if !util.DirExists(dstTodoDirectory) {
+		return newLocalDirectoryForTodoDoesNotExistError(dstTodoID)
+	}
+
+	dstFilePath := filepath.Join(dstTodoDirectory, dstFileName)
+	if util.FileExists(dstFilePath) {
+		return newFileAlreadyExistForTheTodoError(dstTodoID, dstFileName)
+	}
+
+	return copyFile(srcFilePath, dstFilePath)
+}
+
+func (storage *LocalStorage) templateAbsoluteDirectory(templateID int64) string {
+	return filepath.Join(storage.directoryPath, templatesDirectoryName, fmt.Sprintf("%08d", templateID))
+}
+
+func removeFiles(filePaths []string) {
+	for _, filePath := range filePaths {
+		os.Remove(filePath)
+	}
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	storage "github.com/jaingounchained/todo/storage"
+	"github.com/jaingounchained/todo/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createTemplateDirectory(t *testing.T) (int64, string) {
+	templateID := util.RandomInt(1, 100000)
+	expectedTemplateDir := filepath.Join(localStorageTest.directoryPath, "templates", fmt.Sprintf("%08d", templateID))
+
+	// Attempt to make a directory
+	err := localStorageTest.CreateTemplateDirectory(context.Background(), templateID)
+	require.NoError(t, err)
+	require.DirExists(t, expectedTemplateDir)
+
+	return templateID, expectedTemplateDir
+}
+
+func TestCreateDeleteTemplateDirectory(t *testing.T) {
+	templateID, expectedTemplateDir := createTemplateDirectory(t)
+
+	// Attempt to make the same directory again
+	err := localStorageTest.CreateTemplateDirectory(context.Background(), templateID)
+	require.Error(t, err)
+
+	// Attempt to delete the directory
+	err = localStorageTest.DeleteTemplateDirectory(context.Background(), templateID)
+	require.NoError(t, err)
+	require.NoDirExists(t, expectedTemplateDir)
+
+	// Attempt to delete the same directory again
+	err = localStorageTest.DeleteTemplateDirectory(context.Background(), templateID)
+	require.Error(t, err)
+}
+
+func TestSaveDeleteTemplateFiles(t *testing.T) {
+	templateID, expectedTemplateDir := createTemplateDirectory(t)
+	fileName1, fileName2 := util.RandomString(10), util.RandomString(10)
+	fileContents := storage.FileContents{
+		fileName1: []byte(util.RandomString(100)),
+		fileName2: []byte(util.RandomString(100)),
+	}
+
+	// Attempt to save the files
+	err := localStorageTest.SaveTemplateFiles(context.Background(), templateID, fileContents)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(expectedTemplateDir, fileName1))
+	require.FileExists(t, filepath.Join(expectedTemplateDir, fileName2))
+
+	// Attempt to save an existing file along with a new one; none of them gets saved
+	fileName3 := util.RandomString(10)
+	err = localStorageTest.SaveTemplateFiles(context.Background(), templateID, storage.FileContents{
+		fileName1: []byte(util.RandomString(100)),
+		fileName3: []byte(util.RandomString(100)),
+	})
+	require.Error(t, err)
+	require.NoFileExists(t, filepath.Join(expectedTemplateDir, fileName3))
+
+	// Attempt to delete a file
+	err = localStorageTest.DeleteTemplateFile(context.Background(), templateID, fileName1)
+	require.NoError(t, err)
+	require.NoFileExists(t, filepath.Join(expectedTemplateDir, fileName1))
+
+	// Attempt to delete the file again
+	err = localStorageTest.DeleteTemplateFile(context.Background(), templateID, fileName1)
+	require.Error(t, err)
+}
+
+func TestCopyTemplateFile(t *testing.T) {
+	templateID, _ := createTemplateDirectory(t)
+	todoID, expectedTodoDir := createTodoDirectory(t)
+	srcFileName, dstFileName := util.RandomString(10), util.RandomString(10)
+	fileContents := []byte(util.RandomString(100))
+
+	// Attempt to copy the file before it is saved
+	err := localStorageTest.CopyTemplateFile(context.Background(), templateID, srcFileName, todoID, dstFileName)
+	require.Error(t, err)
+
+	// Attempt to save the file
+	err = localStorageTest.SaveTemplateFiles(context.Background(), templateID, storage.FileContents{srcFileName: fileContents})
+	require.NoError(t, err)
+
+	// Attempt to copy the file
+	err = localStorageTest.CopyTemplateFile(context.Background(), templateID, srcFileName, todoID, dstFileName)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(expectedTodoDir, dstFileName))
+
+	bytes, err := localStorageTest.GetFileContents(context.Background(), todoID, dstFileName)
+	require.NoError(t, err)
+	require.Equal(t, fileContents, bytes)
+
+	// Attempt to copy the file again
+	err = localStorageTest.CopyTemplateFile(context.Background(), templateID, srcFileName, todoID, dstFileName)
+	require.Error(t, err)
+}
@@ -47,6 +47,48 @@ func (mr *MockStorageMockRecorder) CloseConnection(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseConnection", reflect.TypeOf((*MockStorage)(nil).CloseConnection), arg0)
 }
 
+// CopyFile mocks base method.
+func (m *MockStorage) CopyFile(arg0 context.Context, arg1 int64, arg2 string, arg3 int64, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyFile", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyFile indicates an expected call of CopyFile.
+func (mr *MockStorageMockRecorder) CopyFile(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyFile", reflect.TypeOf((*MockStorage)(nil).CopyFile), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CopyTemplateFile mocks base method.
+func (m *MockStorage) CopyTemplateFile(arg0 context.Context, arg1 int64, arg2 string, arg3 int64, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTemplateFile", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyTemplateFile indicates an expected call of CopyTemplateFile.
+func (mr *MockStorageMockRecorder) CopyTemplateFile(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTemplateFile", reflect.TypeOf((*MockStorage)(nil).CopyTemplateFile), arg0, arg1, arg2, arg3, arg4)
+}
+
+// CreateTemplateDirectory mocks base method.
+func (m *MockStorage) CreateTemplateDirectory(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplateDirectory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTemplateDirectory indicates an expected call of CreateTemplateDirectory.
+func (mr *MockStorageMockRecorder) CreateTemplateDirectory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplateDirectory", reflect.TypeOf((*MockStorage)(nil).CreateTemplateDirectory), arg0, arg1)
+}
+
 // CreateTodoDirectory mocks base method.
 func (m *MockStorage) CreateTodoDirectory(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -75,6 +117,34 @@ func (mr *MockStorageMockRecorder) DeleteFile(arg0, arg1, arg2 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFile", reflect.TypeOf((*MockStorage)(nil).DeleteFile), arg0, arg1, arg2)
 }
 
+// DeleteTemplateDirectory mocks base method.
+func (m *MockStorage) DeleteTemplateDirectory(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateDirectory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplateDirectory indicates an expected call of DeleteTemplateDirectory.
+func (mr *MockStorageMockRecorder) DeleteTemplateDirectory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateDirectory", reflect.TypeOf((*MockStorage)(nil).DeleteTemplateDirectory), arg0, arg1)
+}
+
+// DeleteTemplateFile mocks base method.
+func (m *MockStorage) DeleteTemplateFile(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateFile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplateFile indicates an expected call of DeleteTemplateFile.
+func (mr *MockStorageMockRecorder) DeleteTemplateFile(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateFile", reflect.TypeOf((*MockStorage)(nil).DeleteTemplateFile), arg0, arg1, arg2)
+}
+
 // DeleteTodoDirectory mocks base method.
 func (m *MockStorage) DeleteTodoDirectory(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -131,3 +201,17 @@ func (mr *MockStorageMockRecorder) SaveMultipleFilesSafely(arg0, arg1, arg2 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMultipleFilesSafely", reflect.TypeOf((*MockStorage)(nil).SaveMultipleFilesSafely), arg0, arg1, arg2)
 }
+
+// SaveTemplateFiles mocks base method.
+func (m *MockStorage) SaveTemplateFiles(arg0 context.Context, arg1 int64, arg2 storage.FileContents) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTemplateFiles", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTemplateFiles indicates an expected call of SaveTemplateFiles.
+func (mr *MockStorageMockRecorder) SaveTemplateFiles(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTemplateFiles", reflect.TypeOf((*MockStorage)(nil).SaveTemplateFiles), arg0, arg1, arg2)
+}
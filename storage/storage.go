@@ -10,7 +10,14 @@ type Storage interface {
 	SaveFile(ctx context.Context, todoID int64, fileName string, byte []byte) error
 	SaveMultipleFilesSafely(ctx context.Context, todoID int64, fileContents FileContents) error
 	DeleteFile(ctx context.Context, todoID int64, fileName string) error
+	CopyFile(ctx context.Context, srcTodoID int64, srcFileName string, dstTodoID int64, dstFileName string) error
 	// TODO: Pass a byte array rather than returning it, for better performance
 	GetFileContents(ctx context.Context, todoID int64, fileName string) ([]byte, error)
+	CreateTemplateDirectory(ctx context.Context, templateID int64) error
+	DeleteTemplateDirectory(ctx context.Context, templateID int64) error
+	SaveTemplateFiles(ctx context.Context, templateID int64, fileContents FileContents) error
+	DeleteTemplateFile(ctx context.Context, templateID int64, fileName string) error
+	// CopyTemplateFile copies a file of the template to the todo instantiated from it
+	CopyTemplateFile(ctx context.Context, templateID int64, srcFileName string, dstTodoID int64, dstFileName string) error
 	CloseConnection(ctx context.Context)
 }
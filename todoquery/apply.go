@@ -0,0 +1,229 @@
+package todoquery
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	db "github.com/jaingounchained/todo/db/sqlc"
+)
+
+// Fields of the query language
+const (
+	FieldStatus  = "status"
+	FieldTag     = "tag"
+	FieldCreated = "created"
+	FieldUpdated = "updated"
+	FieldFiles   = "files"
+	FieldIs      = "is"
+	FieldHas     = "has"
+)
+
+// Fields lists the fields of the query language
+var Fields = []string{FieldStatus, FieldTag, FieldCreated, FieldUpdated, FieldFiles, FieldIs, FieldHas}
+
+// Values of the 'is' and 'has' fields
+const (
+	IsOverdue      = "overdue"
+	HasAttachments = "attachments"
+)
+
+// dateLayout is the layout of the dates standing for a whole day, in UTC
+const dateLayout = "2006-01-02"
+
+// Apply narrows the list parameters down to the todos matching every term of the query:
+//   - status:<state> matches the todos in the state, and repeated status terms any of the states
+//   - tag:<name> matches the todos carrying the tag
+//   - created:<time> and updated:<time> compare the creation or update time with a date, standing for the whole day in
+//     UTC, or an RFC 3339 timestamp, using the operators '>', '>=', '<' and '<='
+//   - files:<count> compares the number of attachments in the same way
+//   - is:overdue and has:attachments match the overdue todos and the todos with attachments
+//   - words and quoted texts match the todos whose title or description contains them, ignoring case
+//
+// Terms negated by '-' match the other todos, except for the comparisons. The filters already set are combined with
+// the terms: the time ranges and attachment counts narrow each other down, while the other filters can't be set by
+// both
+func (query Query) Apply(arg *db.ListTodosParams) error {
+	var statuses []string
+	statusColumn := 0
+	for _, term := range query.Terms {
+		if term.Field == "" {
+			if term.Negated {
+				arg.ExcludedTextTerms = append(arg.ExcludedTextTerms, term.Value)
+			} else {
+				arg.TextTerms = append(arg.TextTerms, term.Value)
+			}
+			continue
+		}
+
+		if !slices.Contains(Fields, term.Field) {
+			return &SyntaxError{
+				Column: term.Column,
+				Msg:    fmt.Sprintf("unknown field '%s'; fields: %s", term.Field, strings.Join(Fields, ", ")),
+			}
+		}
+
+		switch term.Field {
+		case FieldCreated, FieldUpdated, FieldFiles:
+			if term.Negated {
+				return &SyntaxError{Column: term.Column, Msg: fmt.Sprintf("'%s' can't be negated; compare it the other way instead", term.Field)}
+			}
+		default:
+			if term.Operator != OperatorEqual {
+				return &SyntaxError{Column: term.Column, Msg: fmt.Sprintf("'%s' can't be compared with '%s'", term.Field, term.Operator)}
+			}
+		}
+
+		var err error
+		switch term.Field {
+		case FieldStatus:
+			if term.Negated {
+				arg.ExcludedStatuses = appendUnique(arg.ExcludedStatuses, term.Value)
+			} else {
+				if statusColumn == 0 {
+					statusColumn = term.Column
+				}
+				statuses = appendUnique(statuses, term.Value)
+			}
+		case FieldTag:
+			if term.Negated {
+				arg.ExcludedTagNames = appendUnique(arg.ExcludedTagNames, term.Value)
+			} else {
+				arg.TagNames = appendUnique(arg.TagNames, term.Value)
+			}
+		case FieldCreated:
+			err = applyTimeRange(term, &arg.CreatedFrom, &arg.CreatedTo)
+		case FieldUpdated:
+			err = applyTimeRange(term, &arg.UpdatedFrom, &arg.UpdatedTo)
+		case FieldFiles:
+			err = applyFileCount(term, arg)
+		case FieldIs:
+			err = applyFlag(term, IsOverdue, &arg.Overdue)
+		case FieldHas:
+			err = applyFlag(term, HasAttachments, &arg.HasAttachments)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(statuses) > 0 {
+		if len(arg.Statuses) > 0 {
+			return &SyntaxError{Column: statusColumn, Msg: "the statuses are already filtered outside of the query"}
+		}
+		arg.Statuses = statuses
+	}
+
+	return nil
+}
+
+func appendUnique(values []string, value string) []string {
+	if slices.Contains(values, value) {
+		return values
+	}
+
+	return append(values, value)
+}
+
+// applyFlag sets the flag filter from a term such as is:overdue, the value of which must be the name of the flag
+func applyFlag(term Term, name string, flag **bool) error {
+	if term.Value != name {
+		return &SyntaxError{Column: term.Column, Msg: fmt.Sprintf("unknown value '%s' of '%s'; values: %s", term.Value, term.Field, name)}
+	}
+	if *flag != nil {
+		return &SyntaxError{Column: term.Column, Msg: fmt.Sprintf("'%s:%s' is already filtered outside of the query", term.Field, name)}
+	}
+
+	value := !term.Negated
+	*flag = &value
+	return nil
+}
+
+// applyTimeRange narrows the [from, to) time range down to the times matching the comparison of the term
+func applyTimeRange(term Term, from, to **time.Time) error {
+	// The value stands for the [start, end) range of a day or of a single instant
+	var start, end time.Time
+	if day, err := time.Parse(dateLayout, term.Value); err == nil {
+		start, end = day, day.AddDate(0, 0, 1)
+	} else if instant, err := time.Parse(time.RFC3339Nano, term.Value); err == nil {
+		start, end = instant, instant.Add(time.Microsecond)
+	} else {
+		return &SyntaxError{
+			Column: term.Column,
+			Msg:    fmt.Sprintf("invalid time '%s' of '%s'; times are dates such as 2024-06-01 or RFC 3339 timestamps", term.Value, term.Field),
+		}
+	}
+
+	switch term.Operator {
+	case OperatorEqual:
+		narrowFrom(from, start)
+		narrowTo(to, end)
+	case OperatorGreater:
+		narrowFrom(from, end)
+	case OperatorGreaterOrEqual:
+		narrowFrom(from, start)
+	case OperatorLess:
+		narrowTo(to, start)
+	case OperatorLessOrEqual:
+		narrowTo(to, end)
+	}
+
+	return nil
+}
+
+func narrowFrom(from **time.Time, t time.Time) {
+	if *from == nil || t.After(**from) {
+		*from = &t
+	}
+}
+
+func narrowTo(to **time.Time, t time.Time) {
+	if *to == nil || t.Before(**to) {
+		*to = &t
+	}
+}
+
+// applyFileCount narrows the attachment count range down to the counts matching the comparison of the term
+func applyFileCount(term Term, arg *db.ListTodosParams) error {
+	count, err := strconv.ParseInt(term.Value, 10, 32)
+	if err != nil || count < 0 {
+		return &SyntaxError{Column: term.Column, Msg: fmt.Sprintf("invalid count '%s' of '%s'; counts are integers >= 0", term.Value, term.Field)}
+	}
+
+	// Neither bound may be pushed out of the range of the counts
+	n := int32(count)
+	if (term.Operator == OperatorGreater && n == math.MaxInt32) || (term.Operator == OperatorLess && n == 0) {
+		return &SyntaxError{Column: term.Column, Msg: fmt.Sprintf("no count of '%s' is %s %d", term.Field, term.Operator, n)}
+	}
+
+	switch term.Operator {
+	case OperatorEqual:
+		narrowMin(&arg.MinFileCount, n)
+		narrowMax(&arg.MaxFileCount, n)
+	case OperatorGreater:
+		narrowMin(&arg.MinFileCount, n+1)
+	case OperatorGreaterOrEqual:
+		narrowMin(&arg.MinFileCount, n)
+	case OperatorLess:
+		narrowMax(&arg.MaxFileCount, n-1)
+	case OperatorLessOrEqual:
+		narrowMax(&arg.MaxFileCount, n)
+	}
+
+	return nil
+}
+
+func narrowMin(bound **int32, n int32) {
+	if *bound == nil || n > **bound {
+		*bound = &n
+	}
+}
+
+func narrowMax(bound **int32, n int32) {
+	if *bound == nil || n < **bound {
+		*bound = &n
+	}
+}
@@ -0,0 +1,216 @@
+// Package todoquery parses the compact query language of the todo list, such as
+// `status:incomplete tag:work created:>2024-06-01 -tag:someday "quarterly report"`, and applies it to the filters
+// of the list, so that it runs through the same query builder as the other filters
+package todoquery
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Operators comparing the value of a field
+const (
+	OperatorEqual          = ""
+	OperatorGreater        = ">"
+	OperatorGreaterOrEqual = ">="
+	OperatorLess           = "<"
+	OperatorLessOrEqual    = "<="
+)
+
+// Term is a condition of a query, such as `-tag:someday`; terms without a field match the text of the todos
+type Term struct {
+	// Column is the 1-based position of the term within the query, in characters
+	Column   int
+	Negated  bool
+	Field    string
+	Operator string
+	Value    string
+}
+
+// Query is a parsed query; todos match it when they match every one of its terms
+type Query struct {
+	Terms []Term
+}
+
+// SyntaxError reports where in the query parsing or applying it failed
+type SyntaxError struct {
+	// Column is the 1-based position of the error within the query, in characters
+	Column int
+	Msg    string
+}
+
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("invalid query at column %d: %s", err.Column, err.Msg)
+}
+
+// parser walks the characters of a query
+type parser struct {
+	input []rune
+	pos   int
+}
+
+// Parse parses the query into its terms, separated by spaces: optionally negated by a leading '-', each term is either
+// a word or a quoted text, or a field followed by ':', an optional comparison operator and the value of the field
+func Parse(input string) (Query, error) {
+	p := parser{input: []rune(input)}
+
+	var query Query
+	for {
+		p.skipSpaces()
+		if p.done() {
+			return query, nil
+		}
+
+		term, err := p.term()
+		if err != nil {
+			return Query{}, err
+		}
+		query.Terms = append(query.Terms, term)
+	}
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) peek() rune {
+	return p.input[p.pos]
+}
+
+func (p *parser) column() int {
+	return p.pos + 1
+}
+
+func (p *parser) skipSpaces() {
+	for !p.done() && unicode.IsSpace(p.peek()) {
+		p.pos++
+	}
+}
+
+// atTermEnd reports whether the current term ends here
+func (p *parser) atTermEnd() bool {
+	return p.done() || unicode.IsSpace(p.peek())
+}
+
+func (p *parser) errorf(column int, format string, a ...any) *SyntaxError {
+	return &SyntaxError{Column: column, Msg: fmt.Sprintf(format, a...)}
+}
+
+func (p *parser) term() (Term, error) {
+	term := Term{Column: p.column()}
+
+	if p.peek() == '-' {
+		term.Negated = true
+		p.pos++
+		if p.atTermEnd() {
+			return Term{}, p.errorf(term.Column, "'-' must be followed by the term it negates")
+		}
+	}
+
+	if p.peek() == '"' {
+		value, err := p.quoted()
+		if err != nil {
+			return Term{}, err
+		}
+
+		term.Value = value
+		return term, nil
+	}
+
+	start := p.column()
+	word := p.word(func(r rune) bool { return r == ':' || r == '"' })
+	if p.atTermEnd() {
+		term.Value = word
+		return term, nil
+	}
+
+	if p.peek() == '"' {
+		return Term{}, p.errorf(p.column(), "unexpected '\"' within a word; quote the whole text instead")
+	}
+
+	// A field and its value
+	if word == "" {
+		return Term{}, p.errorf(start, "missing field name before ':'")
+	}
+	term.Field = strings.ToLower(word)
+	p.pos++
+
+	term.Operator = p.operator()
+	if p.atTermEnd() {
+		return Term{}, p.errorf(p.column(), "missing value after '%s:%s'", word, term.Operator)
+	}
+
+	if p.peek() == '"' {
+		value, err := p.quoted()
+		if err != nil {
+			return Term{}, err
+		}
+
+		term.Value = value
+		return term, nil
+	}
+
+	// Values may hold colons, as timestamps do
+	term.Value = p.word(func(r rune) bool { return r == '"' })
+	if !p.atTermEnd() {
+		return Term{}, p.errorf(p.column(), "unexpected '\"' within a value; quote the whole value instead")
+	}
+
+	return term, nil
+}
+
+// word reads the characters up to a space or to a character for which stop returns true
+func (p *parser) word(stop func(r rune) bool) string {
+	start := p.pos
+	for !p.atTermEnd() && !stop(p.peek()) {
+		p.pos++
+	}
+
+	return string(p.input[start:p.pos])
+}
+
+// operator reads the comparison operator at the start of a value, if any
+func (p *parser) operator() string {
+	for _, operator := range []string{OperatorGreaterOrEqual, OperatorLessOrEqual, OperatorGreater, OperatorLess} {
+		if strings.HasPrefix(string(p.input[p.pos:]), operator) {
+			p.pos += len(operator)
+			return operator
+		}
+	}
+
+	return OperatorEqual
+}
+
+// quoted reads a text between double quotes, in which '\' escapes a double quote or itself
+func (p *parser) quoted() (string, error) {
+	start := p.column()
+	p.pos++
+
+	var text strings.Builder
+	for {
+		if p.done() {
+			return "", p.errorf(start, "missing closing '\"' of the quoted text")
+		}
+
+		r := p.peek()
+		p.pos++
+		if r == '"' {
+			break
+		}
+		if r == '\\' && !p.done() && (p.peek() == '"' || p.peek() == '\\') {
+			r = p.peek()
+			p.pos++
+		}
+		text.WriteRune(r)
+	}
+
+	if !p.atTermEnd() {
+		return "", p.errorf(p.column(), "expected a space after the quoted text")
+	}
+	if strings.TrimSpace(text.String()) == "" {
+		return "", p.errorf(start, "empty quoted text")
+	}
+
+	return text.String(), nil
+}
@@ -0,0 +1,267 @@
+package todoquery
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	db "github.com/jaingounchained/todo/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tcs := []struct {
+		name     string
+		input    string
+		expected []Term
+		column   int
+	}{
+		{
+			name:  "Empty",
+			input: "   ",
+		},
+		{
+			name:  "Terms",
+			input: `status:incomplete tag:work created:>2024-06-01 -tag:someday "quarterly report"`,
+			expected: []Term{
+				{Column: 1, Field: "status", Value: "incomplete"},
+				{Column: 19, Field: "tag", Value: "work"},
+				{Column: 28, Field: "created", Operator: OperatorGreater, Value: "2024-06-01"},
+				{Column: 48, Negated: true, Field: "tag", Value: "someday"},
+				{Column: 61, Value: "quarterly report"},
+			},
+		},
+		{
+			name:  "FieldsIgnoreCase",
+			input: "Status:Blocked",
+			expected: []Term{
+				{Column: 1, Field: "status", Value: "Blocked"},
+			},
+		},
+		{
+			name:  "OperatorsAndTimestamps",
+			input: "updated:<=2024-06-01T10:30:00Z files:>=2",
+			expected: []Term{
+				{Column: 1, Field: "updated", Operator: OperatorLessOrEqual, Value: "2024-06-01T10:30:00Z"},
+				{Column: 32, Field: "files", Operator: OperatorGreaterOrEqual, Value: "2"},
+			},
+		},
+		{
+			name:  "QuotedValuesAndEscapes",
+			input: `tag:"on hold" -"say \"hi\"" naïve`,
+			expected: []Term{
+				{Column: 1, Field: "tag", Value: "on hold"},
+				{Column: 15, Negated: true, Value: `say "hi"`},
+				{Column: 29, Value: "naïve"},
+			},
+		},
+		{
+			name:   "UnterminatedQuote",
+			input:  `tag:work "quarterly report`,
+			column: 10,
+		},
+		{
+			name:   "MissingValue",
+			input:  "tag:work created:> ",
+			column: 19,
+		},
+		{
+			name:   "MissingField",
+			input:  "status:open :work",
+			column: 13,
+		},
+		{
+			name:   "LoneNegation",
+			input:  "tag:work - report",
+			column: 10,
+		},
+		{
+			name:   "QuoteWithinWord",
+			input:  `quarterly"report"`,
+			column: 10,
+		},
+		{
+			name:   "NoSpaceAfterQuote",
+			input:  `"quarterly"report`,
+			column: 12,
+		},
+		{
+			name:   "EmptyQuote",
+			input:  `tag:""`,
+			column: 5,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := Parse(tc.input)
+			if tc.column != 0 {
+				var syntaxErr *SyntaxError
+				require.ErrorAs(t, err, &syntaxErr)
+				require.Equal(t, tc.column, syntaxErr.Column, syntaxErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, query.Terms)
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	date := func(value string) *time.Time {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			panic(err)
+		}
+
+		return &t
+	}
+	count := func(n int32) *int32 { return &n }
+	flag := func(b bool) *bool { return &b }
+
+	tcs := []struct {
+		name     string
+		input    string
+		arg      db.ListTodosParams
+		expected db.ListTodosParams
+		column   int
+	}{
+		{
+			name:  "Terms",
+			input: `status:incomplete status:blocked -status:complete tag:work tag:urgent -tag:someday quarterly -"draft report"`,
+			expected: db.ListTodosParams{
+				Statuses:          []string{"incomplete", "blocked"},
+				ExcludedStatuses:  []string{"complete"},
+				TagNames:          []string{"work", "urgent"},
+				ExcludedTagNames:  []string{"someday"},
+				TextTerms:         []string{"quarterly"},
+				ExcludedTextTerms: []string{"draft report"},
+			},
+		},
+		{
+			name:  "Days",
+			input: "created:>2024-06-01 created:<=2024-06-30 updated:2024-07-01",
+			expected: db.ListTodosParams{
+				CreatedFrom: date("2024-06-02T00:00:00Z"),
+				CreatedTo:   date("2024-07-01T00:00:00Z"),
+				UpdatedFrom: date("2024-07-01T00:00:00Z"),
+				UpdatedTo:   date("2024-07-02T00:00:00Z"),
+			},
+		},
+		{
+			name:  "Timestamps",
+			input: "created:>=2024-06-01T10:00:00+02:00 created:<2024-06-01T12:00:00Z",
+			expected: db.ListTodosParams{
+				CreatedFrom: date("2024-06-01T10:00:00+02:00"),
+				CreatedTo:   date("2024-06-01T12:00:00Z"),
+			},
+		},
+		{
+			name:  "NarrowsFilters",
+			input: "created:>=2024-06-01 files:<3 files:>0",
+			arg: db.ListTodosParams{
+				CreatedFrom:  date("2024-06-15T00:00:00Z"),
+				CreatedTo:    date("2024-07-01T00:00:00Z"),
+				MaxFileCount: count(1),
+			},
+			expected: db.ListTodosParams{
+				CreatedFrom:  date("2024-06-15T00:00:00Z"),
+				CreatedTo:    date("2024-07-01T00:00:00Z"),
+				MinFileCount: count(1),
+				MaxFileCount: count(1),
+			},
+		},
+		{
+			name:  "Flags",
+			input: "is:overdue -has:attachments",
+			expected: db.ListTodosParams{
+				Overdue:        flag(true),
+				HasAttachments: flag(false),
+			},
+		},
+		{
+			name:   "UnknownField",
+			input:  "tag:work stauts:open",
+			column: 10,
+		},
+		{
+			name:   "NegatedComparison",
+			input:  "-created:>2024-06-01",
+			column: 1,
+		},
+		{
+			name:   "ComparedTag",
+			input:  "tag:>work",
+			column: 1,
+		},
+		{
+			name:   "InvalidTime",
+			input:  "created:>yesterday",
+			column: 1,
+		},
+		{
+			name:   "InvalidCount",
+			input:  "files:-1",
+			column: 1,
+		},
+		{
+			name:   "CountOutOfRange",
+			input:  "files:>=0 files:<0",
+			column: 11,
+		},
+		{
+			name:   "CountOverflow",
+			input:  "files:>2147483647",
+			column: 1,
+		},
+		{
+			name:   "CountTooLarge",
+			input:  "files:2147483648",
+			column: 1,
+		},
+		{
+			name:  "LargestCount",
+			input: "files:>2147483646",
+			expected: db.ListTodosParams{
+				MinFileCount: count(math.MaxInt32),
+			},
+		},
+		{
+			name:   "UnknownFlag",
+			input:  "is:late",
+			column: 1,
+		},
+		{
+			name:   "FlagFilteredTwice",
+			input:  "tag:work is:overdue",
+			arg:    db.ListTodosParams{Overdue: flag(false)},
+			column: 10,
+		},
+		{
+			name:   "StatusesFilteredTwice",
+			input:  "tag:work status:open",
+			arg:    db.ListTodosParams{Statuses: []string{"blocked"}},
+			column: 10,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := Parse(tc.input)
+			require.NoError(t, err)
+
+			arg := tc.arg
+			err = query.Apply(&arg)
+			if tc.column != 0 {
+				var syntaxErr *SyntaxError
+				require.ErrorAs(t, err, &syntaxErr)
+				require.Equal(t, tc.column, syntaxErr.Column, syntaxErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, arg)
+		})
+	}
+}
@@ -1,15 +1,28 @@
 package util
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	DBDriver              string `mapstructure:"DB_DRIVER"`
-	DBSource              string `mapstructure:"DB_SOURCE"`
-	ServerAddress         string `mapstructure:"SERVER_ADDRESS"`
-	StorageType           string `mapstructure:"STORAGE_TYPE"`
-	LocalStorageDirectory string `mapstructure:"LOCAL_STORAGE_DIRECTORY"`
+	DBDriver                string        `mapstructure:"DB_DRIVER"`
+	DBSource                string        `mapstructure:"DB_SOURCE"`
+	ServerAddress           string        `mapstructure:"SERVER_ADDRESS"`
+	StorageType             string        `mapstructure:"STORAGE_TYPE"`
+	LocalStorageDirectory   string        `mapstructure:"LOCAL_STORAGE_DIRECTORY"`
+	NotifierType            string        `mapstructure:"NOTIFIER_TYPE"`
+	NotifierWebhookURL      string        `mapstructure:"NOTIFIER_WEBHOOK_URL"`
+	ReminderInterval        time.Duration `mapstructure:"REMINDER_INTERVAL"`
+	RequireCompleteSubtasks bool          `mapstructure:"REQUIRE_COMPLETE_SUBTASKS"`
+	TrashRetention          time.Duration `mapstructure:"TRASH_RETENTION"`
+	PurgeInterval           time.Duration `mapstructure:"PURGE_INTERVAL"`
+	AutoArchiveAfter        time.Duration `mapstructure:"AUTO_ARCHIVE_AFTER"`
+	ArchiveInterval         time.Duration `mapstructure:"ARCHIVE_INTERVAL"`
+	MaxPageSize             int32         `mapstructure:"MAX_PAGE_SIZE"`
+	SearchLanguage          string        `mapstructure:"SEARCH_LANGUAGE"`
+	DuplicateThreshold      float32       `mapstructure:"DUPLICATE_THRESHOLD"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -1,15 +1,7 @@
 package util
 
+// States of the default workflow
 const (
 	complete   = "complete"
 	incomplete = "incomplete"
 )
-
-func IsSupportedTodoStatus(todoStatus string) bool {
-	switch todoStatus {
-	case complete, incomplete:
-		return true
-	}
-
-	return false
-}